@@ -1,7 +1,12 @@
 package main
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
+	"os"
+	"strings"
+	"vcassist-backend/lib/mail"
 	"vcassist-backend/lib/sqliteutil"
 	"vcassist-backend/lib/telemetry"
 	"vcassist-backend/proto/vcassist/services/auth/v1/authv1connect"
@@ -15,29 +20,115 @@ type AuthSmtpConfig struct {
 	Port         int    `json:"port"`
 	EmailAddress string `json:"email_address"`
 	Password     string `json:"password"`
+	// "starttls", "tls" (implicit tls) or empty to use STARTTLS only if the
+	// server supports it
+	Security string `json:"security"`
+	FromName string `json:"from_name"`
+}
+
+type AuthGoogleConfig struct {
+	// the oauth client ids of the apps that can sign in with google, the
+	// first one is used to exchange authorization codes
+	ClientIds    []string `json:"client_ids"`
+	ClientSecret string   `json:"client_secret"`
+}
+
+type AuthBrandingConfig struct {
+	Name    string `json:"name"`
+	Color   string `json:"color"`
+	LogoUrl string `json:"logo_url"`
 }
 
 type AuthConfig struct {
-	Smtp           AuthSmtpConfig `json:"smtp"`
-	Database       string         `json:"database"`
-	AllowedDomains []string       `json:"allowed_domains"`
+	Smtp AuthSmtpConfig `json:"smtp"`
+	// if this is specified, emails are written to this maildir instead of
+	// being sent
+	Maildir        string             `json:"maildir"`
+	Branding       AuthBrandingConfig `json:"branding"`
+	Google         AuthGoogleConfig   `json:"google"`
+	Database       string             `json:"database"`
+	AllowedDomains []string           `json:"allowed_domains"`
 	// this is an email address that will have a verification code bypass
 	// for app reviewers and testers
 	TestEmail            string `json:"test_email"`
 	TestVerificationCode string `json:"test_verification_code"`
+	// set this to the number of reverse proxies in front of the server so
+	// rate limits apply to the client ip in X-Forwarded-For instead of the
+	// proxy's
+	TrustedProxies int `json:"trusted_proxies"`
+	// the id of the key that access tokens are signed with
+	CurrentAccessTokenKeyId string `json:"current_access_token_key_id"`
+	// a map of key id -> base64 encoded key (at least 32 bytes), access
+	// tokens are disabled if this is empty
+	AccessTokenKeys map[string]string `json:"access_token_keys"`
 }
 
-func InitAuth(mux *http.ServeMux, cfg AuthConfig) (verifier.Verifier, error) {
+const accessTokenKeyEnvPrefix = "AUTH_ACCESS_TOKEN_KEY_"
+const accessTokenCurrentKeyIdEnv = "AUTH_CURRENT_ACCESS_TOKEN_KEY_ID"
+
+// like the keychain keys, access token keys can also be provided through
+// the environment, ex. AUTH_ACCESS_TOKEN_KEY_<id>=<base64 key> and
+// AUTH_CURRENT_ACCESS_TOKEN_KEY_ID=<id>
+func readAccessTokenKeys(cfg AuthConfig) (verifier.KeySet, error) {
+	keys := make(map[string]string)
+	for id, key := range cfg.AccessTokenKeys {
+		keys[id] = key
+	}
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, accessTokenKeyEnvPrefix) {
+			continue
+		}
+		keys[strings.TrimPrefix(name, accessTokenKeyEnvPrefix)] = value
+	}
+
+	currentId := cfg.CurrentAccessTokenKeyId
+	envId, ok := os.LookupEnv(accessTokenCurrentKeyIdEnv)
+	if ok {
+		currentId = envId
+	}
+
+	return verifier.ParseKeySet(currentId, keys)
+}
+
+// InitMailer creates the mailer used by every service that sends emails.
+func InitMailer(cfg AuthConfig) (mail.Mailer, error) {
+	if cfg.Maildir != "" {
+		slog.Info("emails will be written to a maildir", "path", cfg.Maildir)
+		return mail.NewMaildirMailer(cfg.Maildir)
+	}
+	return mail.NewSmtpMailer(mail.SmtpConfig{
+		Server:       cfg.Smtp.Server,
+		Port:         cfg.Smtp.Port,
+		EmailAddress: cfg.Smtp.EmailAddress,
+		Password:     cfg.Smtp.Password,
+		Security:     mail.Security(cfg.Smtp.Security),
+		FromName:     cfg.Smtp.FromName,
+	})
+}
+
+func InitAuth(ctx context.Context, mux *http.ServeMux, cfg AuthConfig, mailer mail.Mailer) (verifier.Verifier, error) {
 	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
 	if err != nil {
 		return verifier.Verifier{}, err
 	}
+	accessTokenKeys, err := readAccessTokenKeys(cfg)
+	if err != nil {
+		return verifier.Verifier{}, err
+	}
 
-	service := auth.NewService(database, auth.Options{
+	service := auth.NewService(ctx, database, auth.Options{
+		Mailer:               mailer,
+		Branding:             auth.Branding(cfg.Branding),
 		AllowedDomains:       cfg.AllowedDomains,
-		Smtp:                 auth.SmtpConfig(cfg.Smtp),
 		TestEmail:            cfg.TestEmail,
 		TestVerificationCode: cfg.TestVerificationCode,
+		TrustedProxies:       cfg.TrustedProxies,
+		AccessTokenKeys:      accessTokenKeys,
+		Google: auth.GoogleOptions{
+			ClientIds:    cfg.Google.ClientIds,
+			ClientSecret: cfg.Google.ClientSecret,
+		},
 	})
 
 	authv1connect.AuthServiceTracer = telemetry.Tracer("auth")
@@ -47,5 +138,5 @@ func InitAuth(mux *http.ServeMux, cfg AuthConfig) (verifier.Verifier, error) {
 		),
 	))
 
-	return verifier.NewVerifier(database), nil
+	return verifier.NewVerifier(database, accessTokenKeys), nil
 }
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"vcassist-backend/lib/sqliteutil"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/proto/vcassist/services/events/v1/eventsv1connect"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/events"
+	"vcassist-backend/services/events/db"
+
+	"connectrpc.com/connect"
+)
+
+type EventsConfig struct {
+	Database string `json:"database"`
+}
+
+func InitEvents(ctx context.Context, mux *http.ServeMux, verify verifier.Verifier, cfg EventsConfig) error {
+	db, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
+	if err != nil {
+		return err
+	}
+	eventsv1connect.EventsServiceTracer = telemetry.Tracer("events")
+
+	service := events.NewService(ctx, db)
+	mux.Handle(eventsv1connect.NewEventsServiceHandler(
+		eventsv1connect.NewInstrumentedEventsServiceClient(service),
+		connect.WithInterceptors(
+			verifier.NewAuthInterceptor(verify),
+		),
+	))
+	// calendar apps cannot attach an authorization header when subscribing
+	// to a feed, this is fine since the school calendar is public anyways
+	mux.HandleFunc("GET /events.ics", service.ServeICalendar)
+	return nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"vcassist-backend/lib/gradestore/db"
+	"vcassist-backend/lib/serviceutil"
+	"vcassist-backend/lib/sqliteutil"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/proto/vcassist/services/gradesnapshots/v1/gradesnapshotsv1connect"
+	"vcassist-backend/services/gradesnapshots"
+
+	"connectrpc.com/connect"
+)
+
+type GradeSnapshotsConfig struct {
+	Database    string `json:"database"`
+	AccessToken string `json:"access_token"`
+}
+
+func InitGradeSnapshots(mux *http.ServeMux, cfg GradeSnapshotsConfig) error {
+	db, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
+	if err != nil {
+		return err
+	}
+	gradesnapshotsv1connect.GradeSnapshotsServiceTracer = telemetry.Tracer("gradesnapshots")
+
+	service := gradesnapshotsv1connect.NewInstrumentedGradeSnapshotsServiceClient(
+		gradesnapshots.NewService(db),
+	)
+	mux.Handle(gradesnapshotsv1connect.NewGradeSnapshotsServiceHandler(
+		service,
+		connect.WithInterceptors(
+			serviceutil.VerifyAccessTokenInterceptor(cfg.AccessToken),
+		),
+	))
+	return nil
+}
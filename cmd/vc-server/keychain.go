@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"os"
+	"strings"
 	"vcassist-backend/lib/sqliteutil"
 	"vcassist-backend/lib/telemetry"
 	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
@@ -9,8 +11,41 @@ import (
 	"vcassist-backend/services/keychain/db"
 )
 
+const keychainKeyEnvPrefix = "KEYCHAIN_KEY_"
+const keychainCurrentKeyIdEnv = "KEYCHAIN_CURRENT_KEY_ID"
+
 type KeychainConfig struct {
 	Database string `json:"database"`
+	// the id of the key that secrets are encrypted with, changing this
+	// will re-encrypt all secrets under the new key on startup
+	CurrentKeyId string `json:"current_key_id"`
+	// a map of key id -> base64 encoded 32 byte key
+	EncryptionKeys map[string]string `json:"encryption_keys"`
+}
+
+// keys can also be provided through the environment so they don't have to
+// be written into the config file, ex. KEYCHAIN_KEY_<id>=<base64 key>
+// and KEYCHAIN_CURRENT_KEY_ID=<id>
+func readKeychainKeyring(cfg KeychainConfig) (keychain.Keyring, error) {
+	keys := make(map[string]string)
+	for id, key := range cfg.EncryptionKeys {
+		keys[id] = key
+	}
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, keychainKeyEnvPrefix) {
+			continue
+		}
+		keys[strings.TrimPrefix(name, keychainKeyEnvPrefix)] = value
+	}
+
+	currentId := cfg.CurrentKeyId
+	envId, ok := os.LookupEnv(keychainCurrentKeyIdEnv)
+	if ok {
+		currentId = envId
+	}
+
+	return keychain.ParseKeyring(currentId, keys)
 }
 
 func InitKeychain(ctx context.Context, cfg KeychainConfig) (keychainv1connect.InstrumentedKeychainServiceClient, error) {
@@ -19,8 +54,19 @@ func InitKeychain(ctx context.Context, cfg KeychainConfig) (keychainv1connect.In
 		return keychainv1connect.NewInstrumentedKeychainServiceClient(nil), err
 	}
 
+	keyring, err := readKeychainKeyring(cfg)
+	if err != nil {
+		return keychainv1connect.NewInstrumentedKeychainServiceClient(nil), err
+	}
+	// this encrypts any rows that are still in plaintext and re-encrypts
+	// rows which are under a key other than the current one
+	err = keychain.Rotate(ctx, db, keyring)
+	if err != nil {
+		return keychainv1connect.NewInstrumentedKeychainServiceClient(nil), err
+	}
+
 	keychainv1connect.KeychainServiceTracer = telemetry.Tracer("keychain")
-	service := keychain.NewService(ctx, db)
+	service := keychain.NewService(ctx, db, keyring)
 	instrumented := keychainv1connect.NewInstrumentedKeychainServiceClient(service)
 	return instrumented, nil
 }
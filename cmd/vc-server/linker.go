@@ -1,6 +1,7 @@
 package main
 
 import (
+	"log/slog"
 	"net/http"
 	"vcassist-backend/lib/serviceutil"
 	"vcassist-backend/lib/sqliteutil"
@@ -8,6 +9,7 @@ import (
 	"vcassist-backend/proto/vcassist/services/linker/v1/linkerv1connect"
 	"vcassist-backend/services/linker"
 	"vcassist-backend/services/linker/db"
+	newlinker "vcassist-backend/services/newlinker"
 
 	"connectrpc.com/connect"
 )
@@ -15,6 +17,9 @@ import (
 type LinkerConfig struct {
 	Database    string `json:"database"`
 	AccessToken string `json:"access_token"`
+	// "newlinker" to use the trainable linker engine, anything else uses
+	// the default linker
+	Engine string `json:"engine"`
 }
 
 func InitLinker(mux *http.ServeMux, cfg LinkerConfig) (linkerv1connect.InstrumentedLinkerServiceClient, error) {
@@ -24,9 +29,13 @@ func InitLinker(mux *http.ServeMux, cfg LinkerConfig) (linkerv1connect.Instrumen
 	}
 	linkerv1connect.LinkerServiceTracer = telemetry.Tracer("linker")
 
-	service := linkerv1connect.NewInstrumentedLinkerServiceClient(
-		linker.NewService(db),
-	)
+	var impl linkerv1connect.LinkerServiceClient = linker.NewService(db)
+	if cfg.Engine == "newlinker" {
+		slog.Info("using newlinker engine")
+		impl = newlinker.NewService(newlinker.ServiceOptions{Database: db})
+	}
+
+	service := linkerv1connect.NewInstrumentedLinkerServiceClient(impl)
 	mux.Handle(linkerv1connect.NewLinkerServiceHandler(
 		service,
 		connect.WithInterceptors(
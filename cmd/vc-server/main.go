@@ -2,16 +2,21 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"net/http"
 	"vcassist-backend/lib/configutil"
 	"vcassist-backend/lib/serviceutil"
+	"vcassist-backend/services/notifications"
 )
 
 type Config struct {
 	Auth            AuthConfig            `json:"auth"`
 	Keychain        KeychainConfig        `json:"keychain"`
 	Linker          LinkerConfig          `json:"linker"`
+	GradeSnapshots  GradeSnapshotsConfig  `json:"gradesnapshots"`
 	VCSis           VCSisConfig           `json:"vcsis"`
+	Events          EventsConfig          `json:"events"`
+	Notifications   NotificationsConfig   `json:"notifications"`
 	VCMoodleScraper VCMoodleScraperConfig `json:"vcmoodle_scraper"`
 	VCMoodleServer  VCMoodleServerConfig  `json:"vcmoodle_server"`
 }
@@ -19,8 +24,18 @@ type Config struct {
 func main() {
 	verbose := flag.Bool("v", false, "Enable verbose logging/instrumentation.")
 	initialScrape := flag.Bool("scrape", false, "Trigger scraping immediately on run.")
+	genVapidKey := flag.Bool("gen-vapid-key", false, "Generate a VAPID private key for web push and exit.")
 	flag.Parse()
 
+	if *genVapidKey {
+		key, err := notifications.GenerateVapidKey()
+		if err != nil {
+			serviceutil.Fatal("generate vapid key", err)
+		}
+		fmt.Println(key)
+		return
+	}
+
 	ctx := serviceutil.SignalContext()
 
 	InitTelemetry(ctx, *verbose)
@@ -32,7 +47,11 @@ func main() {
 
 	mux := http.NewServeMux()
 
-	verify, err := InitAuth(mux, cfg.Auth)
+	mailer, err := InitMailer(cfg.Auth)
+	if err != nil {
+		serviceutil.Fatal("init mailer", err)
+	}
+	verify, err := InitAuth(ctx, mux, cfg.Auth, mailer)
 	if err != nil {
 		serviceutil.Fatal("init auth", err)
 	}
@@ -40,22 +59,35 @@ func main() {
 	if err != nil {
 		serviceutil.Fatal("init linker", err)
 	}
+	err = InitGradeSnapshots(mux, cfg.GradeSnapshots)
+	if err != nil {
+		serviceutil.Fatal("init gradesnapshots", err)
+	}
 	keychain, err := InitKeychain(ctx, cfg.Keychain)
 	if err != nil {
 		serviceutil.Fatal("init keychain", err)
 	}
 
+	notifier, err := InitNotifications(ctx, mux, verify, cfg.Notifications, mailer)
+	if err != nil {
+		serviceutil.Fatal("init notifications", err)
+	}
+	sis, err := InitVCSis(mux, verify, cfg.VCSis, keychain, linker, notifier)
+	if err != nil {
+		serviceutil.Fatal("init vcsis", err)
+	}
+
 	err = InitVCMoodleScraper(ctx, cfg.VCMoodleScraper, initialScrape)
 	if err != nil {
 		serviceutil.Fatal("init vcmoodle scraper", err)
 	}
-	err = InitVCMoodleServer(mux, verify, cfg.VCMoodleServer, keychain)
+	err = InitVCMoodleServer(ctx, mux, verify, cfg.VCMoodleServer, keychain, sis)
 	if err != nil {
 		serviceutil.Fatal("init vcmoodle server", err)
 	}
-	err = InitVCSis(mux, verify, cfg.VCSis, keychain, linker)
+	err = InitEvents(ctx, mux, verify, cfg.Events)
 	if err != nil {
-		serviceutil.Fatal("init vcsis", err)
+		serviceutil.Fatal("init events", err)
 	}
 
 	go serviceutil.StartHttpServer(8000, mux)
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"vcassist-backend/lib/mail"
+	"vcassist-backend/lib/sqliteutil"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/proto/vcassist/services/notifications/v1/notificationsv1connect"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/notifications"
+	"vcassist-backend/services/notifications/db"
+
+	"connectrpc.com/connect"
+)
+
+type NotificationsConfig struct {
+	Database string `json:"database"`
+	// base64url encoded VAPID private key, generate one with
+	// `vc-server -gen-vapid-key`, web push is disabled if this is empty
+	VapidPrivateKey string `json:"vapid_private_key"`
+	// a mailto: or https: url push services can use to contact us
+	VapidSubject string `json:"vapid_subject"`
+	// email digests are sent with the mailer configured in auth
+	EmailDigest bool `json:"email_digest"`
+	// if this is specified, notifications are written to this file
+	// instead of being sent
+	LogFile string `json:"log_file"`
+}
+
+func InitNotifications(
+	ctx context.Context,
+	mux *http.ServeMux,
+	verify verifier.Verifier,
+	cfg NotificationsConfig,
+	mailer mail.Mailer,
+) (notifications.Service, error) {
+	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
+	if err != nil {
+		return notifications.Service{}, err
+	}
+
+	opts := notifications.ServiceOptions{Database: database}
+	if cfg.LogFile != "" {
+		slog.Info("notifications will be written to a file", "path", cfg.LogFile)
+		logSender := notifications.NewLogSender(cfg.LogFile)
+		opts.Push = logSender
+		opts.Digest = logSender
+	} else {
+		if cfg.VapidPrivateKey != "" {
+			webpush, err := notifications.NewWebPushSender(cfg.VapidPrivateKey, cfg.VapidSubject)
+			if err != nil {
+				return notifications.Service{}, err
+			}
+			opts.Push = webpush
+			opts.VapidPublicKey = webpush.PublicKey()
+		}
+		if cfg.EmailDigest {
+			opts.Digest = notifications.NewEmailSender(mailer)
+		}
+	}
+
+	notificationsv1connect.NotificationsServiceTracer = telemetry.Tracer("notifications")
+	service := notifications.NewService(ctx, opts)
+	mux.Handle(notificationsv1connect.NewNotificationsServiceHandler(
+		notificationsv1connect.NewInstrumentedNotificationsServiceClient(service),
+		connect.WithInterceptors(
+			verifier.NewAuthInterceptor(verify),
+		),
+	))
+	return service, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"log/slog"
 	"time"
+	"vcassist-backend/lib/blobstore"
 	"vcassist-backend/lib/scrapers/moodle/core"
 	"vcassist-backend/lib/scrapers/moodle/view"
 	"vcassist-backend/lib/sqliteutil"
@@ -14,9 +15,13 @@ import (
 )
 
 type VCMoodleScraperConfig struct {
+	Enabled  bool   `json:"enabled"`
 	Database string `json:"database"`
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// file resources are mirrored into this directory if it is set
+	MirrorDir           string `json:"mirror_dir"`
+	MirrorMaxFileSizeMB int64  `json:"mirror_max_file_size_mb"`
 }
 
 func createMoodleClient(username, password string) (view.Client, error) {
@@ -41,7 +46,7 @@ func createMoodleClient(username, password string) (view.Client, error) {
 	return client, nil
 }
 
-func vcmoodleScrapeWorker(ctx context.Context, db *sql.DB, username, password string) {
+func vcmoodleScrapeWorker(ctx context.Context, db *sql.DB, mirror *blobstore.Store, username, password string) {
 	ticker := time.NewTicker(time.Hour)
 	defer ticker.Stop()
 	for {
@@ -59,28 +64,47 @@ func vcmoodleScrapeWorker(ctx context.Context, db *sql.DB, username, password st
 				slog.ErrorContext(ctx, "create moodle client", "err", err)
 				continue
 			}
-			scraper.Scrape(ctx, db, client)
+			scraper.Scrape(ctx, db, client, mirror)
 		}
 	}
 }
 
 func InitVCMoodleScraper(ctx context.Context, cfg VCMoodleScraperConfig, initialScrape *bool) error {
-	return nil
+	if !cfg.Enabled {
+		slog.Info("vcmoodle scraper is disabled, skipping...")
+		return nil
+	}
 
-	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
+	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database, db.SearchTables)
 	if err != nil {
 		return err
 	}
-
-	client, err := createMoodleClient(cfg.Username, cfg.Password)
+	_, err = database.Exec(db.SearchSchema)
 	if err != nil {
 		return err
 	}
+
+	var mirror *blobstore.Store
+	if cfg.MirrorDir != "" {
+		store, err := blobstore.Open(cfg.MirrorDir, cfg.MirrorMaxFileSizeMB*1024*1024)
+		if err != nil {
+			return err
+		}
+		mirror = &store
+	}
+
 	if *initialScrape {
-		slog.Info("scraping moodle on start")
-		go scraper.Scrape(ctx, database, client)
+		// a failed login shouldn't take down the whole server, the
+		// previously scraped data will continue to be served
+		client, err := createMoodleClient(cfg.Username, cfg.Password)
+		if err != nil {
+			slog.ErrorContext(ctx, "create moodle client", "err", err)
+		} else {
+			slog.Info("scraping moodle on start")
+			go scraper.Scrape(ctx, database, client, mirror)
+		}
 	}
-	go vcmoodleScrapeWorker(ctx, database, cfg.Username, cfg.Password)
+	go vcmoodleScrapeWorker(ctx, database, mirror, cfg.Username, cfg.Password)
 
 	return nil
 }
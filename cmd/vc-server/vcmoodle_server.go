@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"net/http"
+	"time"
+	"vcassist-backend/lib/blobstore"
 	"vcassist-backend/lib/sqliteutil"
 	"vcassist-backend/lib/telemetry"
 	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
@@ -14,25 +17,59 @@ import (
 )
 
 type VCMoodleServerConfig struct {
-	Database string `json:"database"`
+	Database      string `json:"database"`
+	EnableCourses bool   `json:"enable_courses"`
+	// the directory the scraper mirrors files into
+	MirrorDir string `json:"mirror_dir"`
+	// how often the moodle sessions of users are touched so they don't
+	// expire, this defaults to 10 minutes
+	SessionKeepaliveMinutes int `json:"session_keepalive_minutes"`
 }
 
 func InitVCMoodleServer(
+	ctx context.Context,
 	mux *http.ServeMux,
 	verify verifier.Verifier,
 	cfg VCMoodleServerConfig,
 	keychain keychainv1connect.KeychainServiceClient,
+	sis server.SISData,
 ) error {
-	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database)
+	database, err := sqliteutil.OpenDB(db.Schema, cfg.Database, db.SearchTables)
 	if err != nil {
 		return err
 	}
+	_, err = database.Exec(db.SearchSchema)
+	if err != nil {
+		return err
+	}
+
+	var mirror *blobstore.Store
+	if cfg.MirrorDir != "" {
+		// the server only reads from the mirror so there is no size limit
+		store, err := blobstore.Open(cfg.MirrorDir, 0)
+		if err != nil {
+			return err
+		}
+		mirror = &store
+	}
+
+	service := server.NewService(server.ServiceOptions{
+		Keychain:      keychain,
+		Database:      database,
+		EnableCourses: cfg.EnableCourses,
+		SIS:           sis,
+		Mirror:        mirror,
+	})
+
+	keepalive := time.Minute * 10
+	if cfg.SessionKeepaliveMinutes > 0 {
+		keepalive = time.Minute * time.Duration(cfg.SessionKeepaliveMinutes)
+	}
+	go service.KeepSessionsAlive(ctx, keepalive)
 
 	vcmoodlev1connect.MoodleServiceTracer = telemetry.Tracer("vcmoodle_server")
 	mux.Handle(vcmoodlev1connect.NewMoodleServiceHandler(
-		vcmoodlev1connect.NewInstrumentedMoodleServiceClient(
-			server.NewService(keychain, database),
-		),
+		vcmoodlev1connect.NewInstrumentedMoodleServiceClient(service),
 		connect.WithInterceptors(
 			verifier.NewAuthInterceptor(verify),
 		),
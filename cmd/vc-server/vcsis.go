@@ -28,6 +28,7 @@ type VCSisConfig struct {
 	PowerschoolBaseUrl string           `json:"powerschool_base_url"`
 	PowerschoolOAuth   VCSisOAuthConfig `json:"powerschool_oauth"`
 	WeightsFile        string           `json:"weights_file"`
+	GPABumpsFile       string           `json:"gpa_bumps_file"`
 }
 
 func InitVCSis(
@@ -36,44 +37,60 @@ func InitVCSis(
 	cfg VCSisConfig,
 	keychain keychainv1connect.KeychainServiceClient,
 	linker linkerv1connect.LinkerServiceClient,
-) error {
+	notifier vcsis.Notifier,
+) (sisv1connect.InstrumentedSIServiceClient, error) {
 	database, err := sqliteutil.OpenDB(
 		vcsisdb.Schema+"\n"+gradestoredb.Schema,
 		cfg.Database,
 	)
 	if err != nil {
-		return err
+		return sisv1connect.InstrumentedSIServiceClient{}, err
 	}
 
 	var weights vcsis.WeightData
 	if cfg.WeightsFile != "" {
 		buff, err := os.ReadFile(cfg.WeightsFile)
 		if err != nil {
-			return err
+			return sisv1connect.InstrumentedSIServiceClient{}, err
 		}
 		err = json.Unmarshal(buff, &weights)
 		if err != nil {
-			return err
+			return sisv1connect.InstrumentedSIServiceClient{}, err
+		}
+	}
+
+	var gpaBumps vcsis.GPABumps
+	if cfg.GPABumpsFile != "" {
+		buff, err := os.ReadFile(cfg.GPABumpsFile)
+		if err != nil {
+			return sisv1connect.InstrumentedSIServiceClient{}, err
+		}
+		err = json.Unmarshal(buff, &gpaBumps)
+		if err != nil {
+			return sisv1connect.InstrumentedSIServiceClient{}, err
 		}
 	}
 
 	sisv1connect.SIServiceTracer = telemetry.Tracer("vcsis")
-	mux.Handle(sisv1connect.NewSIServiceHandler(
-		sisv1connect.NewInstrumentedSIServiceClient(
-			vcsis.NewService(
-				vcsis.ServiceOptions{
-					Database:   database,
-					Keychain:   keychain,
-					Linker:     linker,
-					BaseUrl:    cfg.PowerschoolBaseUrl,
-					OAuth:      vcsis.OAuthConfig(cfg.PowerschoolOAuth),
-					WeightData: weights,
-				},
-			),
+	service := sisv1connect.NewInstrumentedSIServiceClient(
+		vcsis.NewService(
+			vcsis.ServiceOptions{
+				Database:   database,
+				Keychain:   keychain,
+				Linker:     linker,
+				BaseUrl:    cfg.PowerschoolBaseUrl,
+				OAuth:      vcsis.OAuthConfig(cfg.PowerschoolOAuth),
+				WeightData: weights,
+				GPABumps:   gpaBumps,
+				Notifier:   notifier,
+			},
 		),
+	)
+	mux.Handle(sisv1connect.NewSIServiceHandler(
+		service,
 		connect.WithInterceptors(
 			verifier.NewAuthInterceptor(verify),
 		),
 	))
-	return nil
+	return service, nil
 }
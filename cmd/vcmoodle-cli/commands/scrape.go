@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"time"
+	"vcassist-backend/lib/blobstore"
 	"vcassist-backend/lib/configutil"
 	"vcassist-backend/lib/restyutil"
 	"vcassist-backend/lib/scrapers/moodle/core"
@@ -23,9 +24,11 @@ type Config struct {
 }
 
 var scrapeDb *string
+var scrapeMirror *string
 
 func init() {
 	scrapeDb = scrapeCmd.Flags().String("db", "results.db", "The database to write scrape results to.")
+	scrapeMirror = scrapeCmd.Flags().String("mirror", "", "The directory to mirror file resources into, files are not mirrored if this is empty.")
 	rootCmd.AddCommand(scrapeCmd)
 }
 
@@ -54,7 +57,7 @@ func createClient(username, password string) view.Client {
 }
 
 var scrapeCmd = &cobra.Command{
-	Use:   "scrape [--db <path/to/output.db>]",
+	Use:   "scrape [--db <path/to/output.db>] [--mirror <path/to/dir>]",
 	Short: "Scrapes moodle according to a config and writes to a database.",
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := configutil.ReadConfig[Config]("config.json5")
@@ -65,14 +68,27 @@ var scrapeCmd = &cobra.Command{
 		slog.Info("scraping using user", "username", cfg.Username)
 		client := createClient(cfg.Username, cfg.Password)
 
-		out, err := sqliteutil.OpenDB(db.Schema, *scrapeDb)
+		out, err := sqliteutil.OpenDB(db.Schema, *scrapeDb, db.SearchTables)
 		if err != nil {
 			serviceutil.Fatal("failed to open db", err)
 		}
+		_, err = out.Exec(db.SearchSchema)
+		if err != nil {
+			serviceutil.Fatal("failed to create search index", err)
+		}
 		defer out.Close()
 
+		var mirror *blobstore.Store
+		if *scrapeMirror != "" {
+			store, err := blobstore.Open(*scrapeMirror, 0)
+			if err != nil {
+				serviceutil.Fatal("failed to open mirror", err)
+			}
+			mirror = &store
+		}
+
 		t1 := time.Now()
-		scraper.Scrape(context.Background(), out, client)
+		scraper.Scrape(context.Background(), out, client, mirror)
 		t2 := time.Now()
 
 		slog.Info("scraping time", "seconds", t2.Sub(t1).Seconds())
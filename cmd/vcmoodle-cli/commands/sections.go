@@ -0,0 +1,512 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"vcassist-backend/lib/configutil"
+	"vcassist-backend/lib/scrapers/moodle/edit"
+	"vcassist-backend/lib/serviceutil"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/spf13/cobra"
+)
+
+var sectionsCourse *int
+var sectionsApply *bool
+
+var generateTemplate *string
+var generateStart *string
+var generateWeeks *int
+var generateAfter *int
+
+var renameCsv *string
+
+func init() {
+	sectionsCourse = sectionsCmd.PersistentFlags().Int("course", 0, "The id of the course to edit.")
+	sectionsApply = sectionsCmd.PersistentFlags().Bool("apply", false, "Apply the changes instead of only previewing them.")
+	sectionsCmd.MarkPersistentFlagRequired("course")
+
+	generateTemplate = generateCmd.Flags().String("template", "sections.json5", "The template to generate sections from.")
+	generateStart = generateCmd.Flags().String("start", "", "The first day of the first week, in the form YYYY-MM-DD.")
+	generateWeeks = generateCmd.Flags().Int("weeks", 18, "The number of weeks to generate sections for.")
+	generateAfter = generateCmd.Flags().Int("after", -1, "The section number to add the generated sections after, defaults to the last section.")
+	generateCmd.MarkFlagRequired("start")
+
+	renameCsv = renameCmd.Flags().String("csv", "sections.csv", "The csv file with the changes to make to each section.")
+
+	sectionsCmd.AddCommand(generateCmd)
+	sectionsCmd.AddCommand(renameCmd)
+	rootCmd.AddCommand(sectionsCmd)
+}
+
+var sectionsCmd = &cobra.Command{
+	Use:   "sections --course <course_id> [--apply]",
+	Short: "Bulk edits the sections of a course, changes are only previewed unless --apply is given.",
+}
+
+// SectionTemplate describes the sections generated for each week, Name and
+// Summary are text/templates executed with a sectionWeek.
+type SectionTemplate struct {
+	Name    string `json:"name"`
+	Summary string `json:"summary"`
+	Hidden  bool   `json:"hidden"`
+	// weeks that start on one of these dates (YYYY-MM-DD) don't get a
+	// section, ex. spring break
+	Skip []string `json:"skip"`
+}
+
+type sectionWeek struct {
+	// this starts from 1 and does not count skipped weeks
+	Week int
+	// the monday and friday of the week
+	Start time.Time
+	End   time.Time
+}
+
+type plannedSection struct {
+	Name    string
+	Summary string
+	Visible bool
+}
+
+const dateLayout = "2006-01-02"
+
+var templateFuncs = template.FuncMap{
+	"date": func(t time.Time, layout string) string {
+		return t.Format(layout)
+	},
+}
+
+func planWeeks(tmpl SectionTemplate, start time.Time, weeks int) ([]plannedSection, error) {
+	name, err := template.New("name").Funcs(templateFuncs).Parse(tmpl.Name)
+	if err != nil {
+		return nil, fmt.Errorf("parse name template: %w", err)
+	}
+	summary, err := template.New("summary").Funcs(templateFuncs).Parse(tmpl.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("parse summary template: %w", err)
+	}
+
+	skip := make(map[string]bool)
+	for _, date := range tmpl.Skip {
+		skip[date] = true
+	}
+
+	// weeks always start on monday even if the first day of school doesn't
+	monday := start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+
+	var planned []plannedSection
+	for week := monday; len(planned) < weeks; week = week.AddDate(0, 0, 7) {
+		if skip[week.Format(dateLayout)] {
+			continue
+		}
+		data := sectionWeek{
+			Week:  len(planned) + 1,
+			Start: week,
+			End:   week.AddDate(0, 0, 4),
+		}
+
+		nameOut := &strings.Builder{}
+		err = name.Execute(nameOut, data)
+		if err != nil {
+			return nil, err
+		}
+		summaryOut := &strings.Builder{}
+		err = summary.Execute(summaryOut, data)
+		if err != nil {
+			return nil, err
+		}
+
+		planned = append(planned, plannedSection{
+			Name:    nameOut.String(),
+			Summary: summaryOut.String(),
+			Visible: !tmpl.Hidden,
+		})
+	}
+	return planned, nil
+}
+
+func openCourse(cmd *cobra.Command) (edit.Course, []edit.Section) {
+	cfg, err := configutil.ReadConfig[Config]("config.json5")
+	if err != nil {
+		serviceutil.Fatal("failed to read config", err)
+	}
+	client := createClient(cfg.Username, cfg.Password)
+
+	course, err := edit.NewCourse(cmd.Context(), *sectionsCourse, client.Core)
+	if err != nil {
+		serviceutil.Fatal("failed to open course", err)
+	}
+	sections, err := course.ListSections(cmd.Context())
+	if err != nil {
+		serviceutil.Fatal("failed to list sections", err)
+	}
+	return course, sections
+}
+
+var generateCmd = &cobra.Command{
+	Use:   "generate --start <YYYY-MM-DD> [--template <path/to/template.json5>] [--weeks <count>] [--after <section_number>]",
+	Short: "Generates a section for every week of a semester from a template.",
+	Run: func(cmd *cobra.Command, args []string) {
+		tmpl, err := configutil.ReadConfig[SectionTemplate](*generateTemplate)
+		if err != nil {
+			serviceutil.Fatal("failed to read template", err)
+		}
+		start, err := time.ParseInLocation(dateLayout, *generateStart, timezone.Location)
+		if err != nil {
+			serviceutil.Fatal("failed to parse start date", err)
+		}
+		planned, err := planWeeks(tmpl, start, *generateWeeks)
+		if err != nil {
+			serviceutil.Fatal("failed to generate sections", err)
+		}
+
+		course, existing := openCourse(cmd)
+		after := *generateAfter
+		if after < 0 || after >= len(existing) {
+			after = len(existing) - 1
+		}
+
+		fmt.Printf("%d sections will be added after section %d (%s)\n", len(planned), after, existing[after].Name)
+		for i, p := range planned {
+			fmt.Printf("+ section %d: %s\n", after+1+i, p.Name)
+			if p.Summary != "" {
+				fmt.Printf("+   summary: %s\n", p.Summary)
+			}
+			if !p.Visible {
+				fmt.Println("+   hidden")
+			}
+		}
+		if !*sectionsApply {
+			fmt.Println("\nrun again with --apply to make these changes")
+			return
+		}
+
+		ctx := cmd.Context()
+		all, err := course.CreateSections(ctx, existing[after].Id, len(planned))
+		if err != nil {
+			serviceutil.Fatal("failed to create sections", err)
+		}
+		if len(all) != len(existing)+len(planned) || after+1+len(planned) > len(all) {
+			serviceutil.Fatal("failed to create sections", fmt.Errorf("expected %d sections, got %d", len(existing)+len(planned), len(all)))
+		}
+		// new sections are inserted right after the target so they take
+		// up the positions after it
+		created := all[after+1 : after+1+len(planned)]
+
+		var renames []edit.RenameEntry
+		var hidden []string
+		for i, p := range planned {
+			renames = append(renames, edit.RenameEntry{SectionId: created[i].Id, NewName: p.Name})
+			if !p.Visible {
+				hidden = append(hidden, created[i].Id)
+			}
+		}
+		err = course.RenameSections(ctx, renames)
+		if err != nil {
+			serviceutil.Fatal("failed to rename sections", err)
+		}
+		for i, p := range planned {
+			if p.Summary == "" {
+				continue
+			}
+			err = course.SetSectionSummary(ctx, created[i].Id, p.Summary)
+			if err != nil {
+				serviceutil.Fatal("failed to set section summary", err)
+			}
+		}
+		if len(hidden) > 0 {
+			err = course.SetSectionsVisible(ctx, hidden, false)
+			if err != nil {
+				serviceutil.Fatal("failed to hide sections", err)
+			}
+		}
+
+		fmt.Printf("added %d sections\n", len(planned))
+	},
+}
+
+// sectionEdit is a row of the rename csv, nil fields are left unchanged
+type sectionEdit struct {
+	Section  int
+	Name     *string
+	Summary  *string
+	Visible  *bool
+	Position *int
+}
+
+// readSectionEdits reads a csv with a header row, the "section" column is
+// required and is the current number of the section to edit. the "name",
+// "summary", "visible" and "position" columns are optional and an empty
+// cell leaves that part of the section unchanged.
+func readSectionEdits(r io.Reader) ([]sectionEdit, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("csv is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	if _, ok := columns["section"]; !ok {
+		return nil, fmt.Errorf("csv must have a section column")
+	}
+	cell := func(row []string, column string) (string, bool) {
+		i, ok := columns[column]
+		if !ok || i >= len(row) || row[i] == "" {
+			return "", false
+		}
+		return row[i], true
+	}
+
+	var edits []sectionEdit
+	for line, row := range rows[1:] {
+		// the header is line 1
+		line += 2
+
+		section, _ := cell(row, "section")
+		number, err := strconv.Atoi(strings.TrimSpace(section))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid section number: %w", line, err)
+		}
+		e := sectionEdit{Section: number}
+
+		if name, ok := cell(row, "name"); ok {
+			e.Name = &name
+		}
+		if summary, ok := cell(row, "summary"); ok {
+			e.Summary = &summary
+		}
+		if visible, ok := cell(row, "visible"); ok {
+			parsed, err := strconv.ParseBool(strings.TrimSpace(visible))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid visible: %w", line, err)
+			}
+			e.Visible = &parsed
+		}
+		if position, ok := cell(row, "position"); ok {
+			parsed, err := strconv.Atoi(strings.TrimSpace(position))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid position: %w", line, err)
+			}
+			e.Position = &parsed
+		}
+		edits = append(edits, e)
+	}
+	return edits, nil
+}
+
+// planMoves returns the moves that put the sections in the given positions,
+// positions maps the current number of a section to the number it should
+// have once all the moves are done. the first section (the general
+// section) cannot be moved.
+func planMoves(ids []string, positions map[int]int) ([]edit.MoveEntry, error) {
+	var moved []int
+	for from, to := range positions {
+		if from <= 0 || from >= len(ids) || to <= 0 || to >= len(ids) {
+			return nil, fmt.Errorf("cannot move section %d to %d, sections can only be moved between 1 and %d", from, to, len(ids)-1)
+		}
+		moved = append(moved, from)
+	}
+	slices.SortFunc(moved, func(a, b int) int {
+		return positions[a] - positions[b]
+	})
+	for i := 1; i < len(moved); i++ {
+		if positions[moved[i]] == positions[moved[i-1]] {
+			return nil, fmt.Errorf("sections %d and %d cannot both be moved to %d", moved[i-1], moved[i], positions[moved[i]])
+		}
+	}
+
+	var final []string
+	for i, id := range ids {
+		if _, ok := positions[i]; !ok {
+			final = append(final, id)
+		}
+	}
+	for _, from := range moved {
+		final = slices.Insert(final, positions[from], ids[from])
+	}
+
+	// sections are only ever moved up so that they always end up before
+	// the section they are moved to
+	current := slices.Clone(ids)
+	var entries []edit.MoveEntry
+	for pos := 1; pos < len(current); pos++ {
+		if current[pos] == final[pos] {
+			continue
+		}
+		from := slices.Index(current, final[pos])
+		entries = append(entries, edit.MoveEntry{
+			SectionId:       final[pos],
+			TargetSectionId: current[pos],
+		})
+		current = slices.Delete(current, from, from+1)
+		current = slices.Insert(current, pos, final[pos])
+	}
+	return entries, nil
+}
+
+// sectionChanges are the changes to make to the sections of a course,
+// diff describes them in the order they should be printed.
+type sectionChanges struct {
+	renames   []edit.RenameEntry
+	summaries map[string]string
+	shown     []string
+	hidden    []string
+	moves     []edit.MoveEntry
+	diff      []string
+}
+
+func (c sectionChanges) empty() bool {
+	return len(c.renames) == 0 &&
+		len(c.summaries) == 0 &&
+		len(c.shown) == 0 &&
+		len(c.hidden) == 0 &&
+		len(c.moves) == 0
+}
+
+// planSectionEdits returns the changes the edits make to the existing
+// sections of a course, edits that don't change anything are skipped.
+func planSectionEdits(existing []edit.Section, edits []sectionEdit) (sectionChanges, error) {
+	ids := make([]string, len(existing))
+	for i, s := range existing {
+		ids[i] = s.Id
+	}
+
+	changes := sectionChanges{summaries: make(map[string]string)}
+	positions := make(map[int]int)
+	edited := make(map[int]bool)
+
+	for _, e := range edits {
+		if e.Section < 0 || e.Section >= len(existing) {
+			return sectionChanges{}, fmt.Errorf("section %d does not exist, the course has %d sections", e.Section, len(existing))
+		}
+		if edited[e.Section] {
+			return sectionChanges{}, fmt.Errorf("section %d is edited more than once", e.Section)
+		}
+		edited[e.Section] = true
+		section := existing[e.Section]
+
+		changed := false
+		change := func(field, before, after string) {
+			if !changed {
+				changes.diff = append(changes.diff, fmt.Sprintf("@@ section %d (%s) @@", e.Section, section.Name))
+				changed = true
+			}
+			changes.diff = append(
+				changes.diff,
+				fmt.Sprintf("- %s: %s", field, before),
+				fmt.Sprintf("+ %s: %s", field, after),
+			)
+		}
+
+		if e.Name != nil && *e.Name != section.Name {
+			change("name", section.Name, *e.Name)
+			changes.renames = append(changes.renames, edit.RenameEntry{SectionId: section.Id, NewName: *e.Name})
+		}
+		if e.Summary != nil && *e.Summary != section.Summary {
+			change("summary", section.Summary, *e.Summary)
+			changes.summaries[section.Id] = *e.Summary
+		}
+		if e.Visible != nil && *e.Visible != section.Visible {
+			change("visible", strconv.FormatBool(section.Visible), strconv.FormatBool(*e.Visible))
+			if *e.Visible {
+				changes.shown = append(changes.shown, section.Id)
+			} else {
+				changes.hidden = append(changes.hidden, section.Id)
+			}
+		}
+		if e.Position != nil && *e.Position != e.Section {
+			change("position", strconv.Itoa(e.Section), strconv.Itoa(*e.Position))
+			positions[e.Section] = *e.Position
+		}
+	}
+
+	moves, err := planMoves(ids, positions)
+	if err != nil {
+		return sectionChanges{}, err
+	}
+	changes.moves = moves
+	return changes, nil
+}
+
+var renameCmd = &cobra.Command{
+	Use:   "rename [--csv <path/to/sections.csv>]",
+	Short: "Renames, moves and sets the summary and visibility of sections from a csv.",
+	Run: func(cmd *cobra.Command, args []string) {
+		f, err := os.Open(*renameCsv)
+		if err != nil {
+			serviceutil.Fatal("failed to open csv", err)
+		}
+		edits, err := readSectionEdits(f)
+		f.Close()
+		if err != nil {
+			serviceutil.Fatal("failed to read csv", err)
+		}
+
+		course, existing := openCourse(cmd)
+		changes, err := planSectionEdits(existing, edits)
+		if err != nil {
+			serviceutil.Fatal("invalid csv", err)
+		}
+		for _, line := range changes.diff {
+			fmt.Println(line)
+		}
+
+		if changes.empty() {
+			fmt.Println("nothing to change")
+			return
+		}
+		if !*sectionsApply {
+			fmt.Println("\nrun again with --apply to make these changes")
+			return
+		}
+
+		ctx := cmd.Context()
+		if len(changes.renames) > 0 {
+			err = course.RenameSections(ctx, changes.renames)
+			if err != nil {
+				serviceutil.Fatal("failed to rename sections", err)
+			}
+		}
+		for id, summary := range changes.summaries {
+			err = course.SetSectionSummary(ctx, id, summary)
+			if err != nil {
+				serviceutil.Fatal("failed to set section summary", err)
+			}
+		}
+		if len(changes.shown) > 0 {
+			err = course.SetSectionsVisible(ctx, changes.shown, true)
+			if err != nil {
+				serviceutil.Fatal("failed to show sections", err)
+			}
+		}
+		if len(changes.hidden) > 0 {
+			err = course.SetSectionsVisible(ctx, changes.hidden, false)
+			if err != nil {
+				serviceutil.Fatal("failed to hide sections", err)
+			}
+		}
+		// sections are identified by id so moving them last doesn't affect
+		// the other changes
+		if len(changes.moves) > 0 {
+			err = course.MoveSections(ctx, changes.moves)
+			if err != nil {
+				serviceutil.Fatal("failed to move sections", err)
+			}
+		}
+
+		fmt.Println("applied changes")
+	},
+}
@@ -0,0 +1,277 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/scrapers/moodle/edit"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWeeks(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, err := time.ParseInLocation(dateLayout, value, timezone.Location)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	cases := []struct {
+		name     string
+		tmpl     SectionTemplate
+		start    string
+		weeks    int
+		expected []plannedSection
+		err      bool
+	}{
+		{
+			name:  "starts midweek",
+			tmpl:  SectionTemplate{Name: `Week {{.Week}} ({{date .Start "Jan 2"}} - {{date .End "Jan 2"}})`},
+			start: "2024-08-21",
+			weeks: 2,
+			expected: []plannedSection{
+				{Name: "Week 1 (Aug 19 - Aug 23)", Visible: true},
+				{Name: "Week 2 (Aug 26 - Aug 30)", Visible: true},
+			},
+		},
+		{
+			name: "crosses a year boundary",
+			tmpl: SectionTemplate{
+				Name:    `{{date .Start "Jan 2, 2006"}} - {{date .End "Jan 2, 2006"}}`,
+				Summary: `Week {{.Week}}`,
+				Hidden:  true,
+				Skip:    []string{"2024-12-23", "2024-12-30"},
+			},
+			start: "2024-12-16",
+			weeks: 3,
+			expected: []plannedSection{
+				{Name: "Dec 16, 2024 - Dec 20, 2024", Summary: "Week 1"},
+				{Name: "Jan 6, 2025 - Jan 10, 2025", Summary: "Week 2"},
+				{Name: "Jan 13, 2025 - Jan 17, 2025", Summary: "Week 3"},
+			},
+		},
+		{
+			name:  "week spans the new year",
+			tmpl:  SectionTemplate{Name: `{{date .Start "2006-01-02"}} - {{date .End "2006-01-02"}}`},
+			start: "2025-12-31",
+			weeks: 1,
+			expected: []plannedSection{
+				{Name: "2025-12-29 - 2026-01-02", Visible: true},
+			},
+		},
+		{
+			name:  "invalid template",
+			tmpl:  SectionTemplate{Name: `{{.Week`},
+			start: "2024-08-19",
+			weeks: 1,
+			err:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			planned, err := planWeeks(c.tmpl, date(c.start), c.weeks)
+			if c.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, planned)
+		})
+	}
+}
+
+func TestReadSectionEdits(t *testing.T) {
+	str := func(value string) *string { return &value }
+	boolean := func(value bool) *bool { return &value }
+	integer := func(value int) *int { return &value }
+
+	cases := []struct {
+		name     string
+		csv      string
+		expected []sectionEdit
+		err      string
+	}{
+		{
+			name: "all columns",
+			csv: "Section,Name,Summary,Visible,Position\n" +
+				"1,Unit 1,\"Intro, and review\",false,3\n",
+			expected: []sectionEdit{
+				{Section: 1, Name: str("Unit 1"), Summary: str("Intro, and review"), Visible: boolean(false), Position: integer(3)},
+			},
+		},
+		{
+			name: "empty cells are unchanged",
+			csv:  "section,name,visible\n2,,\n3,Unit 3,true\n",
+			expected: []sectionEdit{
+				{Section: 2},
+				{Section: 3, Name: str("Unit 3"), Visible: boolean(true)},
+			},
+		},
+		{
+			name: "missing section column",
+			csv:  "name\nUnit 1\n",
+			err:  "section column",
+		},
+		{
+			name: "invalid section",
+			csv:  "section,name\none,Unit 1\n",
+			err:  "line 2",
+		},
+		{
+			name: "invalid visible",
+			csv:  "section,visible\n1,yes\n",
+			err:  "invalid visible",
+		},
+		{
+			name: "empty",
+			csv:  "",
+			err:  "empty",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			edits, err := readSectionEdits(strings.NewReader(c.csv))
+			if c.err != "" {
+				require.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, edits)
+		})
+	}
+}
+
+// applyMoves moves sections the same way moodle does, see edit.MoveEntry
+func applyMoves(ids []string, moves []edit.MoveEntry) []string {
+	out := append([]string{}, ids...)
+	for _, m := range moves {
+		from := -1
+		to := -1
+		for i, id := range out {
+			if id == m.SectionId {
+				from = i
+			}
+			if id == m.TargetSectionId {
+				to = i
+			}
+		}
+		id := out[from]
+		out = append(out[:from], out[from+1:]...)
+		out = append(out[:to], append([]string{id}, out[to:]...)...)
+	}
+	return out
+}
+
+func TestPlanMoves(t *testing.T) {
+	ids := []string{"general", "a", "b", "c", "d", "e"}
+
+	cases := []struct {
+		name      string
+		positions map[int]int
+		expected  []string
+		err       string
+	}{
+		{
+			name:     "no moves",
+			expected: ids,
+		},
+		{
+			name:      "move down",
+			positions: map[int]int{1: 4},
+			expected:  []string{"general", "b", "c", "d", "a", "e"},
+		},
+		{
+			name:      "move up",
+			positions: map[int]int{5: 1},
+			expected:  []string{"general", "e", "a", "b", "c", "d"},
+		},
+		{
+			name:      "swap",
+			positions: map[int]int{2: 4, 4: 2},
+			expected:  []string{"general", "a", "d", "c", "b", "e"},
+		},
+		{
+			name:      "reverse",
+			positions: map[int]int{1: 5, 2: 4, 4: 2, 5: 1},
+			expected:  []string{"general", "e", "d", "c", "b", "a"},
+		},
+		{
+			name:      "duplicate positions",
+			positions: map[int]int{1: 3, 2: 3},
+			err:       "cannot both be moved to 3",
+		},
+		{
+			name:      "general section",
+			positions: map[int]int{0: 2},
+			err:       "cannot move section 0",
+		},
+		{
+			name:      "out of range",
+			positions: map[int]int{1: 6},
+			err:       "cannot move section 1 to 6",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			moves, err := planMoves(ids, c.positions)
+			if c.err != "" {
+				require.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, applyMoves(ids, moves))
+		})
+	}
+}
+
+func TestPlanSectionEdits(t *testing.T) {
+	existing := []edit.Section{
+		{Id: "0", Name: "General", Visible: true},
+		{Id: "1", Name: "Week 1", Summary: "old", Visible: true},
+		{Id: "2", Name: "Week 2", Visible: false},
+	}
+	str := func(value string) *string { return &value }
+	boolean := func(value bool) *bool { return &value }
+	integer := func(value int) *int { return &value }
+
+	changes, err := planSectionEdits(existing, []sectionEdit{
+		{Section: 1, Name: str("Unit 1"), Summary: str("old"), Visible: boolean(true), Position: integer(2)},
+		{Section: 2, Visible: boolean(true)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []edit.RenameEntry{{SectionId: "1", NewName: "Unit 1"}}, changes.renames)
+	require.Empty(t, changes.summaries)
+	require.Equal(t, []string{"2"}, changes.shown)
+	require.Empty(t, changes.hidden)
+	require.Equal(t, []string{"0", "2", "1"}, applyMoves([]string{"0", "1", "2"}, changes.moves))
+	require.Equal(t, []string{
+		"@@ section 1 (Week 1) @@",
+		"- name: Week 1",
+		"+ name: Unit 1",
+		"- position: 1",
+		"+ position: 2",
+		"@@ section 2 (Week 2) @@",
+		"- visible: false",
+		"+ visible: true",
+	}, changes.diff)
+
+	changes, err = planSectionEdits(existing, []sectionEdit{{Section: 1, Name: str("Week 1")}})
+	require.NoError(t, err)
+	require.True(t, changes.empty())
+
+	_, err = planSectionEdits(existing, []sectionEdit{{Section: 3, Name: str("Week 3")}})
+	require.ErrorContains(t, err, "section 3 does not exist")
+
+	_, err = planSectionEdits(existing, []sectionEdit{{Section: 1, Name: str("a")}, {Section: 1, Name: str("b")}})
+	require.ErrorContains(t, err, "more than once")
+
+	_, err = planSectionEdits(append(existing, edit.Section{Id: "3", Name: "Week 3"}), []sectionEdit{
+		{Section: 1, Position: integer(3)},
+		{Section: 2, Position: integer(3)},
+	})
+	require.ErrorContains(t, err, "cannot both be moved to 3")
+}
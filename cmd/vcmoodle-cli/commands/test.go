@@ -34,10 +34,14 @@ var testCmd = &cobra.Command{
 	Use:   "test [--db <path/to/output.db>] [--chapter <chapter_id>]",
 	Short: "Validates the result of a moodle scrape.",
 	Run: func(cmd *cobra.Command, args []string) {
-		database, err := sqliteutil.OpenDB(db.Schema, *targetDb)
+		database, err := sqliteutil.OpenDB(db.Schema, *targetDb, db.SearchTables)
 		if err != nil {
 			serviceutil.Fatal("failed to open db", err)
 		}
+		_, err = database.Exec(db.SearchSchema)
+		if err != nil {
+			serviceutil.Fatal("failed to create search index", err)
+		}
 		defer database.Close()
 		qry := db.New(database)
 
@@ -0,0 +1,189 @@
+package blobstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrTooLarge is returned by Put when a blob is larger than the size limit
+// of the store
+var ErrTooLarge = errors.New("blob is larger than the size limit")
+
+// ErrInvalidHash is returned when a hash is not a hex encoded sha256 hash,
+// this keeps arbitrary input from being used as a path
+var ErrInvalidHash = errors.New("invalid blob hash")
+
+// Store is a content-addressed store of blobs on the local disk, each blob
+// is stored once under the sha256 hash of its contents.
+type Store struct {
+	dir     string
+	maxSize int64
+}
+
+type Blob struct {
+	// hex encoded sha256 hash of the contents
+	Hash string
+	Size int64
+	// sniffed from the first 512 bytes of the contents
+	ContentType string
+}
+
+// Open creates a store in the given directory, blobs larger than maxSize
+// bytes are rejected. a maxSize <= 0 means there is no limit.
+func Open(dir string, maxSize int64) (Store, error) {
+	err := os.MkdirAll(filepath.Join(dir, "tmp"), 0777)
+	if err != nil {
+		return Store{}, err
+	}
+	return Store{dir: dir, maxSize: maxSize}, nil
+}
+
+func validHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// path shards blobs by the first 2 characters of their hash so that no
+// single directory gets too large
+func (s Store) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+// Put reads r until EOF and stores its contents, putting contents that
+// are already stored is a no-op.
+func (s Store) Put(r io.Reader) (Blob, error) {
+	tmp, err := os.CreateTemp(filepath.Join(s.dir, "tmp"), "blob-*")
+	if err != nil {
+		return Blob{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if s.maxSize > 0 {
+		// read one byte past the limit to tell if it was exceeded
+		r = io.LimitReader(r, s.maxSize+1)
+	}
+
+	hash := sha256.New()
+	sniff := &sniffWriter{}
+	size, err := io.Copy(io.MultiWriter(tmp, hash, sniff), r)
+	if err != nil {
+		return Blob{}, err
+	}
+	if s.maxSize > 0 && size > s.maxSize {
+		return Blob{}, ErrTooLarge
+	}
+	err = tmp.Close()
+	if err != nil {
+		return Blob{}, err
+	}
+
+	blob := Blob{
+		Hash:        hex.EncodeToString(hash.Sum(nil)),
+		Size:        size,
+		ContentType: http.DetectContentType(sniff.buff),
+	}
+
+	path := s.path(blob.Hash)
+	err = os.MkdirAll(filepath.Dir(path), 0777)
+	if err != nil {
+		return Blob{}, err
+	}
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return Blob{}, fmt.Errorf("move blob into place: %w", err)
+	}
+
+	return blob, nil
+}
+
+// Open opens the blob with the given hash for reading, it returns an error
+// satisfying errors.Is(err, fs.ErrNotExist) if the blob is not stored.
+func (s Store) Open(hash string) (*os.File, error) {
+	if !validHash(hash) {
+		return nil, ErrInvalidHash
+	}
+	return os.Open(s.path(hash))
+}
+
+// Has returns true if the blob with the given hash is stored
+func (s Store) Has(hash string) bool {
+	if !validHash(hash) {
+		return false
+	}
+	_, err := os.Stat(s.path(hash))
+	return err == nil
+}
+
+// Delete removes the blob with the given hash, deleting a blob that isn't
+// stored is a no-op.
+func (s Store) Delete(hash string) error {
+	if !validHash(hash) {
+		return ErrInvalidHash
+	}
+	err := os.Remove(s.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Walk calls fn with the hash and last modification time of every stored
+// blob, it stops at the first error returned by fn.
+func (s Store) Walk(fn func(hash string, modTime time.Time) error) error {
+	shards, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if !shard.IsDir() || len(shard.Name()) != 2 {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(s.dir, shard.Name()))
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !validHash(entry.Name()) {
+				continue
+			}
+			info, err := entry.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			err = fn(entry.Name(), info.ModTime())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sniffWriter keeps the first 512 bytes written to it, which is all
+// http.DetectContentType considers
+type sniffWriter struct {
+	buff []byte
+}
+
+func (w *sniffWriter) Write(p []byte) (int, error) {
+	remaining := 512 - len(w.buff)
+	if remaining > 0 {
+		w.buff = append(w.buff, p[:min(remaining, len(p))]...)
+	}
+	return len(p), nil
+}
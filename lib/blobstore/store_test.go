@@ -0,0 +1,65 @@
+package blobstore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	store, err := Open(t.TempDir(), 16)
+	require.NoError(t, err)
+
+	content := []byte("%PDF-1.4 small")
+	sum := sha256.Sum256(content)
+	expectedHash := hex.EncodeToString(sum[:])
+
+	blob, err := store.Put(bytes.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, expectedHash, blob.Hash)
+	require.Equal(t, int64(len(content)), blob.Size)
+	require.Equal(t, "application/pdf", blob.ContentType)
+	require.True(t, store.Has(blob.Hash))
+
+	// putting the same content again is a no-op
+	again, err := store.Put(bytes.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, blob, again)
+
+	f, err := store.Open(blob.Hash)
+	require.NoError(t, err)
+	read, err := io.ReadAll(f)
+	f.Close()
+	require.NoError(t, err)
+	require.Equal(t, content, read)
+
+	_, err = store.Put(strings.NewReader("this is more than sixteen bytes"))
+	require.ErrorIs(t, err, ErrTooLarge)
+
+	_, err = store.Open(strings.Repeat("0", 64))
+	require.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = store.Open("../../etc/passwd")
+	require.ErrorIs(t, err, ErrInvalidHash)
+	require.False(t, store.Has("../../etc/passwd"))
+
+	var walked []string
+	err = store.Walk(func(hash string, modTime time.Time) error {
+		walked = append(walked, hash)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{blob.Hash}, walked)
+
+	require.NoError(t, store.Delete(blob.Hash))
+	require.False(t, store.Has(blob.Hash))
+	// deleting a blob that isn't stored is a no-op
+	require.NoError(t, store.Delete(blob.Hash))
+	require.ErrorIs(t, store.Delete("../../etc/passwd"), ErrInvalidHash)
+}
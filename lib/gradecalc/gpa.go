@@ -0,0 +1,82 @@
+package gradecalc
+
+import (
+	"strings"
+)
+
+// GradePoints converts a percentage (0-100) into points on the standard
+// unweighted 4.0 scale.
+func GradePoints(percent float64) float64 {
+	switch {
+	case percent >= 90:
+		return 4
+	case percent >= 80:
+		return 3
+	case percent >= 70:
+		return 2
+	case percent >= 60:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func words(text string) []string {
+	return strings.Fields(strings.ToLower(text))
+}
+
+func containsWords(haystack, needle []string) bool {
+	if len(needle) == 0 {
+		return false
+	}
+outer:
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		for j, w := range needle {
+			if haystack[i+j] != w {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// MatchBump returns the GPA bump of a course given a map of keyword ->
+// bump, keywords are matched case-insensitively against whole words in the
+// course name (so "AP" matches "AP Biology" but not "Chapel"). if multiple
+// keywords match, the largest bump is used.
+func MatchBump(bumps map[string]float32, courseName string) float64 {
+	name := words(courseName)
+	var bump float64
+	for keyword, value := range bumps {
+		if containsWords(name, words(keyword)) && float64(value) > bump {
+			bump = float64(value)
+		}
+	}
+	return bump
+}
+
+type GPATerm struct {
+	// this is a value from 0-100
+	Percent float64
+	// the points added to the grade points of this term for the weighted
+	// GPA, ex. 1 for AP courses
+	Bump float64
+}
+
+// GPA computes the weighted and unweighted GPA of a set of terms, bumps
+// are not applied to failing grades.
+func GPA(terms []GPATerm) (weighted, unweighted float64) {
+	if len(terms) == 0 {
+		return 0, 0
+	}
+	for _, t := range terms {
+		points := GradePoints(t.Percent)
+		unweighted += points
+		if points > 0 {
+			points += t.Bump
+		}
+		weighted += points
+	}
+	return weighted / float64(len(terms)), unweighted / float64(len(terms))
+}
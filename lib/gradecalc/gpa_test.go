@@ -0,0 +1,36 @@
+package gradecalc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchBump(t *testing.T) {
+	bumps := map[string]float32{
+		"AP":      1,
+		"Honors":  0.5,
+		"AP Lang": 0.75,
+	}
+	require.Equal(t, float64(1), MatchBump(bumps, "AP Biology"))
+	require.Equal(t, float64(1), MatchBump(bumps, "ap lang and composition"))
+	require.Equal(t, float64(0.5), MatchBump(bumps, "Chemistry Honors"))
+	require.Equal(t, float64(0), MatchBump(bumps, "Chapel"))
+	require.Equal(t, float64(0), MatchBump(nil, "AP Biology"))
+}
+
+func TestGPA(t *testing.T) {
+	weighted, unweighted := GPA([]GPATerm{
+		{Percent: 95, Bump: 1},
+		{Percent: 85},
+		{Percent: 72, Bump: 0.5},
+		// bumps don't apply to failing grades
+		{Percent: 40, Bump: 1},
+	})
+	require.InDelta(t, (4+3+2+0)/4.0, unweighted, 0.0001)
+	require.InDelta(t, (5+3+2.5+0)/4.0, weighted, 0.0001)
+
+	weighted, unweighted = GPA(nil)
+	require.Equal(t, float64(0), weighted)
+	require.Equal(t, float64(0), unweighted)
+}
@@ -0,0 +1,194 @@
+package gradecalc
+
+import (
+	"fmt"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+)
+
+type Category struct {
+	Name string
+	// this is a value from 0-1, it is 0 for categories which don't have
+	// a weight
+	Weight         float64
+	PointsEarned   float64
+	PointsPossible float64
+}
+
+// Graded reports whether anything in the category has been graded.
+func (c Category) Graded() bool {
+	return c.PointsPossible > 0
+}
+
+// Grade returns the grade of the category from 0-100.
+func (c Category) Grade() float64 {
+	if !c.Graded() {
+		return 0
+	}
+	return c.PointsEarned / c.PointsPossible * 100
+}
+
+type Result struct {
+	// these are values from 0-100, the weighted grade is the same as the
+	// unweighted grade if the course doesn't have category weights
+	Weighted   float64
+	Unweighted float64
+	// false if nothing in the course has been graded yet
+	Graded     bool
+	Categories []Category
+}
+
+// Score returns the points an assignment contributes to the grade, ok is
+// false if the assignment should not be counted at all.
+//
+// - exempt and incomplete assignments are never counted.
+// - collected assignments have been turned in but not graded yet, so they
+// are only counted once they have a score.
+// - missing assignments without a score count as a zero.
+// - assignments with 0 points possible are extra credit.
+func Score(a *sisv1.AssignmentData) (earned, possible float64, ok bool) {
+	if a.GetIsExempt() || a.GetIsIncomplete() {
+		return 0, 0, false
+	}
+	if a.PointsPossible == nil {
+		return 0, 0, false
+	}
+	possible = float64(a.GetPointsPossible())
+
+	if a.PointsEarned == nil {
+		if a.GetIsCollected() {
+			return 0, 0, false
+		}
+		if a.GetIsMissing() {
+			return 0, possible, true
+		}
+		return 0, 0, false
+	}
+	return float64(a.GetPointsEarned()), possible, true
+}
+
+func hasWeights(categories []*sisv1.AssignmentCategory) bool {
+	for _, c := range categories {
+		if c.GetWeight() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Calculate computes the grade of a course given its category weights and
+// assignments. categories with weights are listed first in the order they
+// were given, followed by categories that only appear in assignments.
+func Calculate(categories []*sisv1.AssignmentCategory, assignments []*sisv1.AssignmentData) Result {
+	var out []Category
+	index := make(map[string]int)
+	for _, c := range categories {
+		if _, ok := index[c.GetName()]; ok {
+			continue
+		}
+		index[c.GetName()] = len(out)
+		out = append(out, Category{
+			Name:   c.GetName(),
+			Weight: float64(c.GetWeight()),
+		})
+	}
+
+	var totalEarned, totalPossible float64
+	for _, a := range assignments {
+		earned, possible, ok := Score(a)
+		if !ok {
+			continue
+		}
+		totalEarned += earned
+		totalPossible += possible
+
+		i, ok := index[a.GetCategory()]
+		if !ok {
+			i = len(out)
+			index[a.GetCategory()] = i
+			out = append(out, Category{Name: a.GetCategory()})
+		}
+		out[i].PointsEarned += earned
+		out[i].PointsPossible += possible
+	}
+
+	result := Result{
+		Graded:     totalPossible > 0,
+		Categories: out,
+	}
+	if !result.Graded {
+		return result
+	}
+	result.Unweighted = totalEarned / totalPossible * 100
+	result.Weighted = result.Unweighted
+
+	if !hasWeights(categories) {
+		return result
+	}
+
+	// the weights of categories that have nothing graded in them are
+	// distributed among the other categories, which is what powerschool
+	// does as well
+	var weightedSum, weightTotal float64
+	for _, c := range out {
+		if c.Weight <= 0 || !c.Graded() {
+			continue
+		}
+		weightedSum += c.Weight * c.Grade()
+		weightTotal += c.Weight
+	}
+	if weightTotal > 0 {
+		result.Weighted = weightedSum / weightTotal
+	}
+	return result
+}
+
+// NeededPoints returns the points that need to be earned on a future
+// assignment worth pointsPossible in the given category for the weighted
+// grade of the course to become target (0-100).
+//
+// the result can be more than pointsPossible if the target cannot be
+// reached or negative if the target will be reached regardless.
+func NeededPoints(
+	categories []*sisv1.AssignmentCategory,
+	assignments []*sisv1.AssignmentData,
+	category string,
+	pointsPossible float64,
+	target float64,
+) (float64, error) {
+	if pointsPossible <= 0 {
+		return 0, fmt.Errorf("points possible must be greater than 0")
+	}
+
+	result := Calculate(categories, assignments)
+
+	if !hasWeights(categories) {
+		var earned, possible float64
+		for _, c := range result.Categories {
+			earned += c.PointsEarned
+			possible += c.PointsPossible
+		}
+		return target/100*(possible+pointsPossible) - earned, nil
+	}
+
+	var cat *Category
+	var otherSum, otherWeight float64
+	for i, c := range result.Categories {
+		if c.Name == category {
+			cat = &result.Categories[i]
+			continue
+		}
+		if c.Weight <= 0 || !c.Graded() {
+			continue
+		}
+		otherSum += c.Weight * c.Grade()
+		otherWeight += c.Weight
+	}
+	if cat == nil || cat.Weight <= 0 {
+		return 0, fmt.Errorf("category '%s' does not have a weight, so it does not affect the grade", category)
+	}
+
+	// target = (otherSum + w * 100 * (earned + x) / (possible + p)) / (otherWeight + w)
+	w := cat.Weight
+	categoryGrade := (target*(otherWeight+w) - otherSum) / w
+	return categoryGrade/100*(cat.PointsPossible+pointsPossible) - cat.PointsEarned, nil
+}
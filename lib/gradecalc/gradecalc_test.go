@@ -0,0 +1,85 @@
+package gradecalc
+
+import (
+	"testing"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func points(value float32) *float32 {
+	return &value
+}
+
+func TestCalculate(t *testing.T) {
+	categories := []*sisv1.AssignmentCategory{
+		{Name: "Tests", Weight: 0.6},
+		{Name: "Homework", Weight: 0.4},
+		{Name: "Final", Weight: 0.2},
+	}
+	assignments := []*sisv1.AssignmentData{
+		{Title: "Test 1", Category: "Tests", PointsEarned: points(45), PointsPossible: points(50)},
+		{Title: "Test 2", Category: "Tests", PointsEarned: points(35), PointsPossible: points(50)},
+		{Title: "HW 1", Category: "Homework", PointsEarned: points(10), PointsPossible: points(10)},
+		// missing without a score counts as a zero
+		{Title: "HW 2", Category: "Homework", PointsPossible: points(10), IsMissing: true},
+		// none of these are counted
+		{Title: "HW 3", Category: "Homework", PointsEarned: points(0), PointsPossible: points(10), IsExempt: true},
+		{Title: "HW 4", Category: "Homework", PointsEarned: points(2), PointsPossible: points(10), IsIncomplete: true},
+		{Title: "HW 5", Category: "Homework", PointsPossible: points(10), IsCollected: true},
+		{Title: "HW 6", Category: "Homework", PointsPossible: points(10)},
+		// extra credit
+		{Title: "Bonus", Category: "Homework", PointsEarned: points(2), PointsPossible: points(0)},
+		// has no weight, only affects the unweighted grade
+		{Title: "Survey", Category: "Other", PointsEarned: points(5), PointsPossible: points(5)},
+	}
+
+	result := Calculate(categories, assignments)
+	require.True(t, result.Graded)
+	require.InDelta(t, 97.0/125.0*100, result.Unweighted, 0.001)
+	// tests: 80%, homework: 60%, final is ungraded so its weight is dropped
+	require.InDelta(t, (0.6*80+0.4*60)/1.0, result.Weighted, 0.001)
+
+	require.Len(t, result.Categories, 4)
+	require.Equal(t, "Tests", result.Categories[0].Name)
+	require.Equal(t, "Final", result.Categories[2].Name)
+	require.False(t, result.Categories[2].Graded())
+	require.Equal(t, "Other", result.Categories[3].Name)
+	require.Equal(t, float64(0), result.Categories[3].Weight)
+	require.InDelta(t, 12, result.Categories[1].PointsEarned, 0.001)
+	require.InDelta(t, 20, result.Categories[1].PointsPossible, 0.001)
+
+	empty := Calculate(categories, nil)
+	require.False(t, empty.Graded)
+}
+
+func TestNeededPoints(t *testing.T) {
+	categories := []*sisv1.AssignmentCategory{
+		{Name: "Tests", Weight: 0.5},
+		{Name: "Homework", Weight: 0.5},
+	}
+	assignments := []*sisv1.AssignmentData{
+		{Title: "Test 1", Category: "Tests", PointsEarned: points(70), PointsPossible: points(100)},
+		{Title: "HW 1", Category: "Homework", PointsEarned: points(10), PointsPossible: points(10)},
+	}
+
+	needed, err := NeededPoints(categories, assignments, "Tests", 100, 90)
+	require.NoError(t, err)
+	// tests needs to be at 80%, which is 160/200
+	require.InDelta(t, 90, needed, 0.001)
+
+	withFuture := append(assignments, &sisv1.AssignmentData{
+		Title:          "Test 2",
+		Category:       "Tests",
+		PointsEarned:   points(float32(needed)),
+		PointsPossible: points(100),
+	})
+	require.InDelta(t, 90, Calculate(categories, withFuture).Weighted, 0.001)
+
+	_, err = NeededPoints(categories, assignments, "Other", 100, 90)
+	require.Error(t, err)
+
+	needed, err = NeededPoints(nil, assignments, "Tests", 90, 50)
+	require.NoError(t, err)
+	require.InDelta(t, 20, needed, 0.001)
+}
@@ -0,0 +1,152 @@
+package htmlutil
+
+import (
+	"regexp"
+	"strings"
+)
+
+// tags, runs of whitespace and words are each a single token
+var diffToken = regexp.MustCompile(`<[^>]*>|\s+|[^<\s]+`)
+
+// the number of cells in the lcs table above which the differing middle
+// section is treated as having been entirely replaced
+const maxDiffCells = 4_000_000
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+type diffEdit struct {
+	op    diffOp
+	token string
+}
+
+func isTag(token string) bool {
+	return strings.HasPrefix(token, "<")
+}
+
+// Diff returns the html of after with words removed since before wrapped
+// in <del> and words added wrapped in <ins>. tags are not diffed as text,
+// removed tags are dropped and added tags are kept so that the result is
+// still structured like after.
+func Diff(before, after string) string {
+	a := diffToken.FindAllString(before, -1)
+	b := diffToken.FindAllString(after, -1)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []diffEdit
+	for _, t := range a[:prefix] {
+		edits = append(edits, diffEdit{op: diffEqual, token: t})
+	}
+	edits = append(edits, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, t := range a[len(a)-suffix:] {
+		edits = append(edits, diffEdit{op: diffEqual, token: t})
+	}
+
+	return renderDiff(edits)
+}
+
+func diffMiddle(a, b []string) []diffEdit {
+	var edits []diffEdit
+	if len(a)*len(b) > maxDiffCells {
+		for _, t := range a {
+			edits = append(edits, diffEdit{op: diffDelete, token: t})
+		}
+		for _, t := range b {
+			edits = append(edits, diffEdit{op: diffInsert, token: t})
+		}
+		return edits
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, diffEdit{op: diffEqual, token: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, diffEdit{op: diffDelete, token: a[i]})
+			i++
+		default:
+			edits = append(edits, diffEdit{op: diffInsert, token: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, diffEdit{op: diffDelete, token: a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, diffEdit{op: diffInsert, token: b[j]})
+	}
+	return edits
+}
+
+func renderDiff(edits []diffEdit) string {
+	out := strings.Builder{}
+	open := diffEqual
+
+	closeOpen := func() {
+		switch open {
+		case diffDelete:
+			out.WriteString("</del>")
+		case diffInsert:
+			out.WriteString("</ins>")
+		}
+		open = diffEqual
+	}
+
+	for _, e := range edits {
+		if isTag(e.token) {
+			if e.op == diffDelete {
+				continue
+			}
+			closeOpen()
+			out.WriteString(e.token)
+			continue
+		}
+		if e.op != open {
+			closeOpen()
+			switch e.op {
+			case diffDelete:
+				out.WriteString("<del>")
+			case diffInsert:
+				out.WriteString("<ins>")
+			}
+			open = e.op
+		}
+		out.WriteString(e.token)
+	}
+	closeOpen()
+
+	return out.String()
+}
@@ -0,0 +1,47 @@
+package htmlutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		before   string
+		after    string
+		expected string
+	}{
+		{
+			name:     "unchanged",
+			before:   "<p>read chapter 4</p>",
+			after:    "<p>read chapter 4</p>",
+			expected: "<p>read chapter 4</p>",
+		},
+		{
+			name:     "replaced word",
+			before:   "<p>quiz on friday</p>",
+			after:    "<p>quiz on monday</p>",
+			expected: "<p>quiz on <del>friday</del><ins>monday</ins></p>",
+		},
+		{
+			name:     "added paragraph",
+			before:   "<p>homework: p. 12</p>",
+			after:    "<p>homework: p. 12</p><p>bring a calculator</p>",
+			expected: "<p>homework: p. 12</p><p><ins>bring a calculator</ins></p>",
+		},
+		{
+			name:     "removed tags are dropped",
+			before:   "<p>notes <b>due</b> today</p>",
+			after:    "<p>notes today</p>",
+			expected: "<p>notes <del>due </del>today</p>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Diff(tc.before, tc.after))
+		})
+	}
+}
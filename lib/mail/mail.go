@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jordan-wright/email"
+)
+
+// Message is an email to send, Html is optional and is sent as an
+// alternative to Text for clients that can display it.
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	Html    string
+}
+
+// Mailer delivers emails, implementations may either send them or store
+// them somewhere for inspection.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// the display name emails are sent from if no other name is given
+const DefaultFromName = "VC Assist"
+
+func formatFrom(name, address string) string {
+	if name == "" {
+		name = DefaultFromName
+	}
+	return fmt.Sprintf("%s <%s>", name, address)
+}
+
+func (m Message) email(from string) *email.Email {
+	mail := email.NewEmail()
+	mail.From = from
+	mail.To = m.To
+	mail.Subject = m.Subject
+	mail.Text = []byte(m.Text)
+	if m.Html != "" {
+		mail.HTML = []byte(m.Html)
+	}
+	return mail
+}
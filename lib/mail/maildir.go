@@ -0,0 +1,77 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// MaildirMailer writes emails into a maildir instead of sending them, so
+// they can be read with any mail client that supports maildirs (ex.
+// `mutt -f <dir>`). it is meant for local development and tests.
+type MaildirMailer struct {
+	dir string
+}
+
+func NewMaildirMailer(dir string) (MaildirMailer, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		err := os.MkdirAll(filepath.Join(dir, sub), 0777)
+		if err != nil {
+			return MaildirMailer{}, err
+		}
+	}
+	return MaildirMailer{dir: dir}, nil
+}
+
+// uniqueName follows the maildir naming convention of
+// <time>.<unique>.<host>
+func uniqueName() (string, error) {
+	buf := make([]byte, 8)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	return fmt.Sprintf("%d.%s.%s", time.Now().UnixNano(), hex.EncodeToString(buf), host), nil
+}
+
+func (m MaildirMailer) Send(ctx context.Context, msg Message) error {
+	contents, err := msg.email(formatFrom("", "noreply@localhost")).Bytes()
+	if err != nil {
+		return err
+	}
+	name, err := uniqueName()
+	if err != nil {
+		return err
+	}
+
+	// messages are written to tmp first so readers never see a partially
+	// written message in new
+	tmp := filepath.Join(m.dir, "tmp", name)
+	err = os.WriteFile(tmp, contents, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(m.dir, "new", name))
+}
+
+// Delivered returns the paths of the messages in the maildir that haven't
+// been moved to cur by a mail client yet, oldest first.
+func (m MaildirMailer) Delivered() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(m.dir, "new"))
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(entries))
+	for i, e := range entries {
+		paths[i] = filepath.Join(m.dir, "new", e.Name())
+	}
+	return paths, nil
+}
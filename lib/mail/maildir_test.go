@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jordan-wright/email"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaildir(t *testing.T) {
+	mailer, err := NewMaildirMailer(t.TempDir())
+	require.NoError(t, err)
+
+	for _, subject := range []string{"first", "second"} {
+		err = mailer.Send(context.Background(), Message{
+			To:      []string{"student@example.com"},
+			Subject: subject,
+			Text:    "plain body",
+			Html:    "<p>html body</p>",
+		})
+		require.NoError(t, err)
+	}
+
+	paths, err := mailer.Delivered()
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+
+	f, err := os.Open(paths[0])
+	require.NoError(t, err)
+	defer f.Close()
+	parsed, err := email.NewEmailFromReader(f)
+	require.NoError(t, err)
+	require.Equal(t, "first", parsed.Subject)
+	require.Equal(t, []string{"<student@example.com>"}, parsed.To)
+	require.Equal(t, "plain body", string(parsed.Text))
+	require.Equal(t, "<p>html body</p>", string(parsed.HTML))
+}
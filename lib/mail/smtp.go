@@ -0,0 +1,160 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+	"vcassist-backend/lib/telemetry"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var tracer = telemetry.Tracer("vcassist.lib.mail")
+
+// Security is how the connection to the smtp server is encrypted.
+type Security string
+
+const (
+	// upgrade the connection with STARTTLS if the server supports it, this
+	// is what net/smtp does by default
+	SecurityOpportunistic Security = ""
+	// require the connection to be upgraded with STARTTLS, usually on port
+	// 587
+	SecurityStartTls Security = "starttls"
+	// connect with tls from the start (implicit tls), usually on port 465
+	SecurityTls Security = "tls"
+)
+
+type SmtpConfig struct {
+	Server       string
+	Port         int
+	EmailAddress string
+	Password     string
+	Security     Security
+	// the display name of the sender, defaults to DefaultFromName
+	FromName string
+}
+
+// SmtpMailer sends emails through an smtp server, authenticating with
+// PLAIN auth if the server supports it.
+type SmtpMailer struct {
+	config SmtpConfig
+}
+
+func NewSmtpMailer(config SmtpConfig) (SmtpMailer, error) {
+	switch config.Security {
+	case SecurityOpportunistic, SecurityStartTls, SecurityTls:
+	default:
+		return SmtpMailer{}, fmt.Errorf("unknown smtp security '%s'", config.Security)
+	}
+	return SmtpMailer{config: config}, nil
+}
+
+// the longest a single smtp conversation can take, a server that accepts
+// the connection and then stops responding would otherwise block sending
+// forever
+const smtpTimeout = 30 * time.Second
+
+func (m SmtpMailer) send(ctx context.Context, msg Message) error {
+	from := m.config.EmailAddress
+	raw, err := msg.email(formatFrom(m.config.FromName, from)).Bytes()
+	if err != nil {
+		return err
+	}
+	addr := net.JoinHostPort(m.config.Server, strconv.Itoa(m.config.Port))
+	tlsConfig := &tls.Config{ServerName: m.config.Server}
+
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(smtpTimeout)
+	ctxDeadline, ok := ctx.Deadline()
+	if ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		return err
+	}
+	// closing the connection interrupts whatever the client is waiting on
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if m.config.Security == SecurityTls {
+		conn = tls.Client(conn, tlsConfig)
+	}
+	client, err := smtp.NewClient(conn, m.config.Server)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if m.config.Security != SecurityTls {
+		ok, _ := client.Extension("STARTTLS")
+		if ok {
+			err = client.StartTLS(tlsConfig)
+			if err != nil {
+				return err
+			}
+		} else if m.config.Security == SecurityStartTls {
+			return fmt.Errorf("smtp server doesn't support STARTTLS")
+		}
+	}
+
+	// some servers (ex. relays on a private network) don't take any
+	// authentication
+	ok, _ = client.Extension("AUTH")
+	if ok {
+		err = client.Auth(smtp.PlainAuth("", m.config.EmailAddress, m.config.Password, m.config.Server))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(from)
+	if err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		err = client.Rcpt(to)
+		if err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (m SmtpMailer) Send(ctx context.Context, msg Message) error {
+	ctx, span := tracer.Start(ctx, "SmtpMailer.Send")
+	defer span.End()
+	span.SetAttributes(attribute.String("security", string(m.config.Security)))
+
+	err := m.send(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to send email")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/telemetry"
+
+	"github.com/jordan-wright/email"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSmtp accepts connections on a local port and hands each of them to
+// handle.
+func fakeSmtp(t testing.TB, handle func(conn net.Conn)) SmtpConfig {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return SmtpConfig{
+		Server:       "127.0.0.1",
+		Port:         addr.Port,
+		EmailAddress: "noreply@example.com",
+		Password:     "password",
+	}
+}
+
+// serveSmtp speaks just enough smtp to receive a single email without
+// authentication, the contents of the email are sent to received.
+func serveSmtp(conn net.Conn, received chan<- []byte) {
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 fake smtp")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO":
+			text.PrintfLine("250 fake smtp")
+		case "MAIL", "RCPT":
+			text.PrintfLine("250 ok")
+		case "DATA":
+			text.PrintfLine("354 go ahead")
+			body, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			received <- body
+			text.PrintfLine("250 ok")
+		case "QUIT":
+			text.PrintfLine("221 bye")
+			return
+		default:
+			text.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSmtpSend(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:mail")
+	defer cleanup()
+
+	received := make(chan []byte, 1)
+	config := fakeSmtp(t, func(conn net.Conn) { serveSmtp(conn, received) })
+	mailer, err := NewSmtpMailer(config)
+	require.NoError(t, err)
+
+	err = mailer.Send(context.Background(), Message{
+		To:      []string{"student@example.com"},
+		Subject: "code",
+		Text:    "plain body",
+	})
+	require.NoError(t, err)
+
+	parsed, err := email.NewEmailFromReader(bufio.NewReader(bytes.NewReader(<-received)))
+	require.NoError(t, err)
+	require.Equal(t, "code", parsed.Subject)
+	require.Equal(t, "plain body", strings.TrimSpace(string(parsed.Text)))
+}
+
+func TestSmtpStalledServer(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:mail")
+	defer cleanup()
+
+	// the server accepts the connection and never says anything
+	config := fakeSmtp(t, func(conn net.Conn) {
+		conn.Read(make([]byte, 1))
+	})
+	mailer, err := NewSmtpMailer(config)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = mailer.Send(ctx, Message{
+		To:      []string{"student@example.com"},
+		Subject: "code",
+		Text:    "plain body",
+	})
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,327 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	GoogleJwksUrl  = "https://www.googleapis.com/oauth2/v3/certs"
+	GoogleTokenUrl = "https://oauth2.googleapis.com/token"
+)
+
+// the values of the iss claim in id tokens issued by google
+var GoogleIssuers = []string{"https://accounts.google.com", "accounts.google.com"}
+
+// InvalidIdToken is returned when an id token is malformed, has an invalid
+// signature or has claims that can't be accepted.
+var InvalidIdToken = fmt.Errorf("invalid id token")
+
+// audience is the aud claim, which can either be a single string or a list
+// of strings
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if json.Unmarshal(data, &single) == nil {
+		*a = []string{single}
+		return nil
+	}
+	var list []string
+	err := json.Unmarshal(data, &list)
+	*a = list
+	return err
+}
+
+// looseBool is a bool that may also be encoded as a string, some older
+// google tokens set email_verified to "true"
+type looseBool bool
+
+func (b *looseBool) UnmarshalJSON(data []byte) error {
+	var value bool
+	if json.Unmarshal(data, &value) == nil {
+		*b = looseBool(value)
+		return nil
+	}
+	var str string
+	err := json.Unmarshal(data, &str)
+	if err != nil {
+		return err
+	}
+	value, err = strconv.ParseBool(str)
+	*b = looseBool(value)
+	return err
+}
+
+// IdTokenClaims are the claims of an OpenID Connect id token.
+type IdTokenClaims struct {
+	Issuer        string    `json:"iss"`
+	Subject       string    `json:"sub"`
+	Audience      audience  `json:"aud"`
+	ExpiresAt     int64     `json:"exp"`
+	IssuedAt      int64     `json:"iat"`
+	Nonce         string    `json:"nonce"`
+	Email         string    `json:"email"`
+	EmailVerified looseBool `json:"email_verified"`
+	// the google workspace domain of the user, this is empty for personal
+	// google accounts
+	HostedDomain string `json:"hd"`
+}
+
+// KeySet is a cache of the signing keys published by an OpenID provider as
+// a JWKS, keys are refetched when the cache expires or a token is signed
+// with a key that isn't in the cache (which happens when keys are rotated).
+type KeySet struct {
+	url       string
+	client    *resty.Client
+	lock      *sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expiresAt time.Time
+	fetchedAt time.Time
+}
+
+// how long keys are cached for if the provider doesn't say
+const defaultKeySetTtl = time.Hour
+
+// unknown key ids can't make the key set be refetched more often than this,
+// so tokens with made up key ids can't be used to spam the provider
+const minKeySetRefresh = time.Minute
+
+func NewKeySet(url string, client *resty.Client) *KeySet {
+	if client == nil {
+		client = resty.New()
+	}
+	return &KeySet{
+		url:    url,
+		client: client,
+		lock:   &sync.Mutex{},
+	}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("rsa exponent is too large")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+var maxAgeRegex = regexp.MustCompile(`max-age=(\d+)`)
+
+func (k *KeySet) fetch(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "KeySet.fetch")
+	defer span.End()
+
+	res, err := k.client.R().
+		SetContext(ctx).
+		Get(k.url)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch jwks")
+		return err
+	}
+	if res.IsError() {
+		err := fmt.Errorf("fetch jwks: %s", res.Status())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch jwks")
+		return err
+	}
+
+	var body struct {
+		Keys []jwk `json:"keys"`
+	}
+	err = json.Unmarshal(res.Body(), &body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to parse jwks")
+		return err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range body.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		parsed, err := key.rsaKey()
+		if err != nil {
+			span.RecordError(err)
+			continue
+		}
+		keys[key.Kid] = parsed
+	}
+	span.SetAttributes(attribute.Int("keys", len(keys)))
+
+	ttl := defaultKeySetTtl
+	match := maxAgeRegex.FindStringSubmatch(res.Header().Get("Cache-Control"))
+	if len(match) == 2 {
+		seconds, err := strconv.Atoi(match[1])
+		if err == nil {
+			ttl = time.Duration(seconds) * time.Second
+		}
+	}
+
+	now := timezone.Now()
+	k.keys = keys
+	k.fetchedAt = now
+	k.expiresAt = now.Add(ttl)
+	return nil
+}
+
+// Key returns the public key with the given key id.
+func (k *KeySet) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	now := timezone.Now()
+	key, ok := k.keys[kid]
+	if ok && now.Before(k.expiresAt) {
+		return key, nil
+	}
+
+	if !now.Before(k.expiresAt) || now.Sub(k.fetchedAt) >= minKeySetRefresh {
+		err := k.fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, ok = k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key id '%s'", InvalidIdToken, kid)
+	}
+	return key, nil
+}
+
+// IdTokenVerifier verifies RS256 signed id tokens.
+type IdTokenVerifier struct {
+	Keys *KeySet
+	// the accepted values of the iss claim
+	Issuers []string
+	// the oauth client ids that tokens may be issued to, a token is
+	// accepted if any of its audiences is one of these
+	ClientIds []string
+}
+
+// tokens are accepted this long after they expire to allow for clock skew
+const clockLeeway = time.Minute
+
+func decodeSegment(segment string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// Verify checks the signature and claims of an id token, if nonce is not
+// empty the token must also have been issued with that nonce.
+func (v IdTokenVerifier) Verify(ctx context.Context, token, nonce string) (IdTokenClaims, error) {
+	ctx, span := tracer.Start(ctx, "IdTokenVerifier.Verify")
+	defer span.End()
+
+	claims, err := v.verify(ctx, token, nonce)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to verify id token")
+		return IdTokenClaims{}, err
+	}
+	return claims, nil
+}
+
+func (v IdTokenVerifier) verify(ctx context.Context, token, nonce string) (IdTokenClaims, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return IdTokenClaims{}, fmt.Errorf("%w: malformed token", InvalidIdToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	err := decodeSegment(segments[0], &header)
+	if err != nil {
+		return IdTokenClaims{}, fmt.Errorf("%w: malformed header: %s", InvalidIdToken, err)
+	}
+	// the algorithm is never taken from the token, otherwise "none" or
+	// an hmac keyed with the public key could be used
+	if header.Alg != "RS256" {
+		return IdTokenClaims{}, fmt.Errorf("%w: unsupported algorithm '%s'", InvalidIdToken, header.Alg)
+	}
+
+	key, err := v.Keys.Key(ctx, header.Kid)
+	if err != nil {
+		return IdTokenClaims{}, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil {
+		return IdTokenClaims{}, fmt.Errorf("%w: malformed signature", InvalidIdToken)
+	}
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	if err != nil {
+		return IdTokenClaims{}, fmt.Errorf("%w: bad signature", InvalidIdToken)
+	}
+
+	var claims IdTokenClaims
+	err = decodeSegment(segments[1], &claims)
+	if err != nil {
+		return IdTokenClaims{}, fmt.Errorf("%w: malformed claims: %s", InvalidIdToken, err)
+	}
+
+	now := timezone.Now()
+	if !slices.Contains(v.Issuers, claims.Issuer) {
+		return IdTokenClaims{}, fmt.Errorf("%w: unexpected issuer '%s'", InvalidIdToken, claims.Issuer)
+	}
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool {
+		return slices.Contains(v.ClientIds, aud)
+	}) {
+		return IdTokenClaims{}, fmt.Errorf("%w: unexpected audience", InvalidIdToken)
+	}
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(clockLeeway)) {
+		return IdTokenClaims{}, fmt.Errorf("%w: token has expired", InvalidIdToken)
+	}
+	if time.Unix(claims.IssuedAt, 0).After(now.Add(clockLeeway)) {
+		return IdTokenClaims{}, fmt.Errorf("%w: token was issued in the future", InvalidIdToken)
+	}
+	if nonce != "" && claims.Nonce != nonce {
+		return IdTokenClaims{}, fmt.Errorf("%w: nonce does not match", InvalidIdToken)
+	}
+
+	return claims, nil
+}
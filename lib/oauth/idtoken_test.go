@@ -0,0 +1,119 @@
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/oauth/oauthtest"
+	"vcassist-backend/lib/telemetry"
+
+	"github.com/stretchr/testify/require"
+)
+
+func validClaims() map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":            "https://accounts.google.com",
+		"sub":            "1234",
+		"aud":            "client-id",
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"email":          "student@example.com",
+		"email_verified": true,
+		"nonce":          "nonce",
+	}
+}
+
+func TestVerifyIdToken(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:oauth")
+	defer cleanup()
+	ctx := context.Background()
+
+	jwks := oauthtest.NewJwks()
+	jwks.AddKey(t, "key-1")
+	verifier := IdTokenVerifier{
+		Keys:      NewKeySet(jwks.Serve(t), nil),
+		Issuers:   GoogleIssuers,
+		ClientIds: []string{"other-client-id", "client-id"},
+	}
+
+	claims, err := verifier.Verify(ctx, jwks.Sign(t, "key-1", "RS256", validClaims()), "nonce")
+	require.NoError(t, err)
+	require.Equal(t, "student@example.com", claims.Email)
+	require.True(t, bool(claims.EmailVerified))
+
+	// keys are cached
+	_, err = verifier.Verify(ctx, jwks.Sign(t, "key-1", "RS256", validClaims()), "")
+	require.NoError(t, err)
+	require.Equal(t, 1, jwks.FetchCount())
+
+	legacy := validClaims()
+	legacy["email_verified"] = "true"
+	legacy["aud"] = []string{"client-id"}
+	claims, err = verifier.Verify(ctx, jwks.Sign(t, "key-1", "RS256", legacy), "")
+	require.NoError(t, err)
+	require.True(t, bool(claims.EmailVerified))
+
+	invalid := map[string]func(claims map[string]any){
+		"issuer":   func(c map[string]any) { c["iss"] = "https://evil.example.com" },
+		"audience": func(c map[string]any) { c["aud"] = "someone-else" },
+		"expired":  func(c map[string]any) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"future":   func(c map[string]any) { c["iat"] = time.Now().Add(time.Hour).Unix() },
+		"nonce":    func(c map[string]any) { c["nonce"] = "replayed" },
+	}
+	for name, modify := range invalid {
+		claims := validClaims()
+		modify(claims)
+		_, err = verifier.Verify(ctx, jwks.Sign(t, "key-1", "RS256", claims), "nonce")
+		require.ErrorIs(t, err, InvalidIdToken, name)
+	}
+
+	// the signature must match the contents of the token
+	token := jwks.Sign(t, "key-1", "RS256", validClaims())
+	segments := strings.Split(token, ".")
+	tampered := validClaims()
+	tampered["email"] = "admin@example.com"
+	payload, err := json.Marshal(tampered)
+	require.NoError(t, err)
+	segments[1] = base64.RawURLEncoding.EncodeToString(payload)
+	_, err = verifier.Verify(ctx, strings.Join(segments, "."), "")
+	require.ErrorIs(t, err, InvalidIdToken)
+
+	_, err = verifier.Verify(ctx, jwks.Sign(t, "key-1", "HS256", validClaims()), "")
+	require.ErrorIs(t, err, InvalidIdToken)
+	_, err = verifier.Verify(ctx, "not a token", "")
+	require.ErrorIs(t, err, InvalidIdToken)
+}
+
+func TestKeyRotation(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:oauth")
+	defer cleanup()
+	ctx := context.Background()
+
+	jwks := oauthtest.NewJwks()
+	jwks.AddKey(t, "key-1")
+	keys := NewKeySet(jwks.Serve(t), nil)
+	verifier := IdTokenVerifier{
+		Keys:      keys,
+		Issuers:   GoogleIssuers,
+		ClientIds: []string{"client-id"},
+	}
+
+	_, err := verifier.Verify(ctx, jwks.Sign(t, "key-1", "RS256", validClaims()), "")
+	require.NoError(t, err)
+
+	// unknown key ids don't refetch the keys right after they were fetched
+	jwks.AddKey(t, "key-2")
+	_, err = verifier.Verify(ctx, jwks.Sign(t, "key-2", "RS256", validClaims()), "")
+	require.True(t, errors.Is(err, InvalidIdToken))
+	require.Equal(t, 1, jwks.FetchCount())
+
+	keys.fetchedAt = keys.fetchedAt.Add(-minKeySetRefresh)
+	_, err = verifier.Verify(ctx, jwks.Sign(t, "key-2", "RS256", validClaims()), "")
+	require.NoError(t, err)
+	require.Equal(t, 2, jwks.FetchCount())
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"vcassist-backend/lib/telemetry"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/codes"
 )
 
 var tracer = telemetry.Tracer("vcassist.lib.oauth")
@@ -73,3 +78,54 @@ func GenerateCodeVerifier() (string, error) {
 	}
 	return hex.EncodeToString(nonce), nil
 }
+
+// ExchangeCode exchanges an authorization code for tokens at the token
+// endpoint of the provider, clientSecret can be empty for public clients
+// that use PKCE instead.
+func ExchangeCode(ctx context.Context, client *resty.Client, tokenUrl string, req TokenRequest, clientSecret string) (OpenIdToken, error) {
+	ctx, span := tracer.Start(ctx, "ExchangeCode")
+	defer span.End()
+
+	form := url.Values{}
+	form.Add("grant_type", "authorization_code")
+	form.Add("code", req.AuthCode)
+	form.Add("client_id", req.ClientId)
+	form.Add("redirect_uri", req.RedirectUri)
+	if req.CodeVerifier != "" {
+		form.Add("code_verifier", req.CodeVerifier)
+	}
+	if clientSecret != "" {
+		form.Add("client_secret", clientSecret)
+	}
+
+	res, err := client.R().
+		SetContext(ctx).
+		SetBody(form.Encode()).
+		SetHeader("content-type", "application/x-www-form-urlencoded").
+		Post(tokenUrl)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to request token")
+		return OpenIdToken{}, err
+	}
+	if res.IsError() {
+		var body struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		json.Unmarshal(res.Body(), &body)
+		err := fmt.Errorf("exchange code: %s: %s %s", res.Status(), body.Error, body.ErrorDescription)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "token endpoint returned an error")
+		return OpenIdToken{}, err
+	}
+
+	var token OpenIdToken
+	err = json.Unmarshal(res.Body(), &token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to parse token")
+		return OpenIdToken{}, err
+	}
+	return token, nil
+}
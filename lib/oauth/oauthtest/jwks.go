@@ -0,0 +1,95 @@
+// Package oauthtest provides helpers for testing code that verifies
+// tokens signed by an OAuth provider.
+package oauthtest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Jwks serves a JSON web key set of RSA keys and signs tokens with them,
+// it can be mounted on a mux or served on its own with Serve.
+type Jwks struct {
+	lock    sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+}
+
+func NewJwks() *Jwks {
+	return &Jwks{keys: map[string]*rsa.PrivateKey{}}
+}
+
+// Serve starts a server for the key set that is closed when the test
+// finishes and returns its url.
+func (j *Jwks) Serve(t testing.TB) string {
+	server := httptest.NewServer(j)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// AddKey generates a new key with the given key id and adds it to the set.
+func (j *Jwks) AddKey(t testing.TB, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.keys[kid] = key
+}
+
+// Sign returns a JWT with the given claims signed by the key with the
+// given key id, alg is only put in the header so it can lie about how
+// the token was signed.
+func (j *Jwks) Sign(t testing.TB, kid string, alg string, claims any) string {
+	j.lock.Lock()
+	key := j.keys[kid]
+	j.lock.Unlock()
+	require.NotNil(t, key, "unknown key id %q", kid)
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// FetchCount returns the number of times the key set has been served.
+func (j *Jwks) FetchCount() int {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	return j.fetches
+}
+
+func (j *Jwks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.fetches++
+
+	keys := []map[string]string{}
+	for kid, key := range j.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": kid,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+}
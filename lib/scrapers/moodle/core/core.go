@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http/cookiejar"
@@ -22,6 +23,15 @@ import (
 
 var LoginFailed = fmt.Errorf("failed to login to your account")
 
+// SessionExpired is returned by requests made through a client whose
+// session moodle no longer accepts, it means the client has to login again.
+var SessionExpired = fmt.Errorf("moodle session has expired")
+
+// ResourceUnavailable is returned by requests that were redirected to the
+// login page even though the session is still valid, moodle does this
+// for pages and files the user isn't allowed to access.
+var ResourceUnavailable = fmt.Errorf("moodle resource is not accessible")
+
 type Client struct {
 	BaseUrl *url.URL
 	Http    *resty.Client
@@ -63,15 +73,52 @@ func NewClient(ctx context.Context, opts ClientOptions) (*Client, error) {
 		return nil
 	})
 
-	restyutil.InstrumentClient(client, tracer, restyInstrumentOutput)
-
 	c := &Client{
 		BaseUrl: baseUrl,
 		Http:    client,
 	}
+
+	// resty skips this for requests that asked for the response to not be
+	// parsed, those have to call CheckLoginRedirect themselves
+	client.OnAfterResponse(func(_ *resty.Client, res *resty.Response) error {
+		return c.CheckLoginRedirect(res)
+	})
+
+	restyutil.InstrumentClient(client, tracer, restyInstrumentOutput)
+
 	return c, nil
 }
 
+// CheckLoginRedirect returns SessionExpired or ResourceUnavailable if the
+// request of res was redirected to the login page. moodle does this both
+// when the session has expired and for resources the user can't access,
+// so the session is touched to tell which one it was.
+func (c *Client) CheckLoginRedirect(res *resty.Response) error {
+	if !isLoginRedirect(res) {
+		return nil
+	}
+	err := c.Touch(res.Request.Context())
+	if errors.Is(err, SessionExpired) {
+		return SessionExpired
+	}
+	if err != nil {
+		return fmt.Errorf("check session after login redirect: %w", err)
+	}
+	return ResourceUnavailable
+}
+
+// isLoginRedirect returns true if a request for a page other than the login
+// page ended up on the login page, which is what moodle does when the
+// session of the client has expired or the page can't be accessed.
+func isLoginRedirect(res *resty.Response) bool {
+	if res.RawResponse == nil || res.RawResponse.Request == nil || res.Request.RawRequest == nil {
+		return false
+	}
+	requested := res.Request.RawRequest.URL.Path
+	landed := res.RawResponse.Request.URL.Path
+	return strings.HasPrefix(landed, "/login/") && !strings.HasPrefix(requested, "/login/")
+}
+
 var moodleConfigRegex = regexp.MustCompile(`(?m)M\.cfg *= *(.+?);`)
 
 func getSesskey(ctx context.Context, doc *goquery.Document) string {
@@ -146,6 +193,10 @@ func (c *Client) LoginUsernamePassword(ctx context.Context, username, password s
 	res, err = c.Http.R().
 		SetContext(ctx).
 		Get("/")
+	if errors.Is(err, SessionExpired) {
+		slog.WarnContext(ctx, "login failed, redirected back to login page")
+		return LoginFailed
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to request dashboard after login", "err", err)
 		return wrapLoginError(err)
@@ -164,3 +215,54 @@ func (c *Client) LoginUsernamePassword(ctx context.Context, username, password s
 	c.Sesskey = getSesskey(ctx, doc)
 	return nil
 }
+
+// Touch extends the session of the client without loading a page, it
+// returns SessionExpired if the session has already expired.
+func (c *Client) Touch(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "Touch")
+	defer span.End()
+
+	if c.Sesskey == "" {
+		return SessionExpired
+	}
+
+	res, err := c.Http.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sesskey": c.Sesskey,
+			"info":    "core_session_touch",
+		}).
+		SetBody([]map[string]any{
+			{
+				"index":      0,
+				"methodname": "core_session_touch",
+				"args":       map[string]any{},
+			},
+		}).
+		Post("/lib/ajax/service.php")
+	if err != nil {
+		return err
+	}
+
+	var results []struct {
+		Error     bool `json:"error"`
+		Exception struct {
+			ErrorCode string `json:"errorcode"`
+		} `json:"exception"`
+	}
+	err = json.Unmarshal(res.Body(), &results)
+	if err != nil {
+		return fmt.Errorf("touch session: %w", err)
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("touch session: empty response")
+	}
+	if results[0].Error {
+		switch results[0].Exception.ErrorCode {
+		case "servicerequireslogin", "requireloginerror", "invalidsesskey":
+			return SessionExpired
+		}
+		return fmt.Errorf("touch session: %s", results[0].Exception.ErrorCode)
+	}
+	return nil
+}
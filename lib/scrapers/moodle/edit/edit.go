@@ -84,6 +84,9 @@ func (c Course) ensureEditing(ctx context.Context) error {
 type Section struct {
 	Name string
 	Id   string
+	// these are only set by ListSections
+	Summary string
+	Visible bool
 }
 
 func (c Course) ListSections(ctx context.Context) ([]Section, error) {
@@ -120,9 +123,12 @@ func (c Course) ListSections(ctx context.Context) ([]Section, error) {
 		}
 		nameAnchor := s.Find("h3 a[title]")
 		name := strings.Trim(nameAnchor.Text(), " \n\t")
+		summary, _ := s.Find(".summarytext").First().Html()
 		sections = append(sections, Section{
-			Id:   id,
-			Name: name,
+			Id:      id,
+			Name:    name,
+			Summary: strings.TrimSpace(summary),
+			Visible: !s.HasClass("hidden"),
 		})
 	})
 
@@ -345,3 +351,161 @@ func (c Course) DeleteSections(ctx context.Context, sectionIds []string) error {
 	}
 	return nil
 }
+
+type MoveEntry struct {
+	SectionId string
+	// the section is moved to the position of this section, like in the
+	// course editor this section ends up after the moved section if the
+	// moved section comes after it and before the moved section otherwise
+	TargetSectionId string
+}
+
+// MoveSections moves sections in the order given, the positions of the
+// sections change after each move.
+func (c Course) MoveSections(ctx context.Context, entries []MoveEntry) error {
+	ctx, span := tracer.Start(ctx, "MoveSections")
+	defer span.End()
+
+	actList := make(actionList, len(entries))
+	for i, e := range entries {
+		actList[i] = action{
+			Args: cdActionArgs{
+				Action:          "section_move",
+				CourseId:        strconv.Itoa(c.Id),
+				Ids:             []string{e.SectionId},
+				TargetSectionId: e.TargetSectionId,
+			},
+			Index:      0,
+			MethodName: "core_courseformat_update_course",
+		}
+	}
+	_, err := actList.do(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to do action")
+		return err
+	}
+	return nil
+}
+
+// SetSectionsVisible shows or hides sections from students
+func (c Course) SetSectionsVisible(ctx context.Context, sectionIds []string, visible bool) error {
+	ctx, span := tracer.Start(ctx, "SetSectionsVisible")
+	defer span.End()
+
+	actionName := "section_hide"
+	if visible {
+		actionName = "section_show"
+	}
+	act := action{
+		Args: cdActionArgs{
+			Action:   actionName,
+			CourseId: strconv.Itoa(c.Id),
+			Ids:      sectionIds,
+		},
+		Index:      0,
+		MethodName: "core_courseformat_update_course",
+	}
+	_, err := actionList{act}.do(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to do action")
+		return err
+	}
+	return nil
+}
+
+// formValues returns the values a browser would submit for a form without
+// any changes made to it
+func formValues(form *goquery.Selection) url.Values {
+	values := url.Values{}
+	form.Find("input[name]").Each(func(_ int, input *goquery.Selection) {
+		name := input.AttrOr("name", "")
+		switch input.AttrOr("type", "text") {
+		case "checkbox", "radio":
+			if _, checked := input.Attr("checked"); !checked {
+				return
+			}
+		case "submit", "button", "image", "reset":
+			return
+		}
+		values.Add(name, input.AttrOr("value", ""))
+	})
+	form.Find("textarea[name]").Each(func(_ int, textarea *goquery.Selection) {
+		values.Add(textarea.AttrOr("name", ""), textarea.Text())
+	})
+	form.Find("select[name]").Each(func(_ int, sel *goquery.Selection) {
+		option := sel.Find("option[selected]").First()
+		if option.Length() == 0 {
+			option = sel.Find("option").First()
+		}
+		if option.Length() == 0 {
+			return
+		}
+		values.Add(sel.AttrOr("name", ""), option.AttrOr("value", option.Text()))
+	})
+	return values
+}
+
+// SetSectionSummary sets the summary of a section, summaryHtml is saved as
+// html. there is no ajax action for this so it submits the edit section
+// form instead.
+func (c Course) SetSectionSummary(ctx context.Context, sectionId, summaryHtml string) error {
+	ctx, span := tracer.Start(ctx, "SetSectionSummary")
+	defer span.End()
+
+	res, err := c.Core.Http.R().
+		SetContext(ctx).
+		SetQueryParam("id", sectionId).
+		Get("/course/editsection.php")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch edit form")
+		return err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewBuffer(res.Body()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to parse html")
+		return err
+	}
+
+	form := doc.Find("form.mform").First()
+	if form.Length() == 0 {
+		err := fmt.Errorf("could not find edit section form for section %s", sectionId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	values := formValues(form)
+	values.Set("summary_editor[text]", summaryHtml)
+	// 1 is FORMAT_HTML
+	values.Set("summary_editor[format]", "1")
+	values.Set("submitbutton", "Save changes")
+
+	action, err := res.Request.RawRequest.URL.Parse(form.AttrOr("action", ""))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to resolve form action")
+		return err
+	}
+
+	res, err = c.Core.Http.R().
+		SetContext(ctx).
+		SetFormDataFromValues(values).
+		Post(action.String())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to submit edit form")
+		return err
+	}
+	// the form is shown again with errors instead of redirecting back to
+	// the course if it could not be saved
+	if strings.Contains(res.RawResponse.Request.URL.Path, "editsection.php") {
+		err := fmt.Errorf("failed to save summary of section %s", sectionId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
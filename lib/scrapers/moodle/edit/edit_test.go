@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net/url"
+	"strings"
 	"testing"
 	"vcassist-backend/lib/configutil"
 	"vcassist-backend/lib/scrapers/moodle/core"
 	"vcassist-backend/lib/scrapers/moodle/view"
 	"vcassist-backend/lib/telemetry"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/stretchr/testify/require"
 )
 
@@ -160,6 +163,25 @@ expected:
 		)
 	}
 
+	err = course.SetSectionsVisible(ctx, addedSectionIds, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = course.SetSectionSummary(ctx, addedSectionIds[0], "<p>summary</p>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	afterEdit, err := course.ListSections(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range afterEdit {
+		if s.Id == addedSectionIds[0] {
+			require.False(t, s.Visible)
+			require.Contains(t, s.Summary, "summary")
+		}
+	}
+
 	err = course.DeleteSections(ctx, addedSectionIds)
 	if err != nil {
 		t.Fatal(err)
@@ -186,3 +208,29 @@ expected:
 		}
 	}
 }
+
+func TestFormValues(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<form class="mform" action="editsection.php">
+	<input type="hidden" name="id" value="12">
+	<input type="hidden" name="sesskey" value="abc">
+	<input type="checkbox" name="name[customize]" value="1" checked>
+	<input type="checkbox" name="visible" value="1">
+	<input type="text" name="name[value]" value="Week 1">
+	<textarea name="summary_editor[text]">&lt;p&gt;old&lt;/p&gt;</textarea>
+	<select name="summary_editor[format]"><option value="0">Moodle</option><option value="1" selected>HTML</option></select>
+	<input type="submit" name="cancel" value="Cancel">
+</form>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := formValues(doc.Find("form"))
+	require.Equal(t, url.Values{
+		"id":                     {"12"},
+		"sesskey":                {"abc"},
+		"name[customize]":        {"1"},
+		"name[value]":            {"Week 1"},
+		"summary_editor[text]":   {"<p>old</p>"},
+		"summary_editor[format]": {"1"},
+	}, values)
+}
@@ -0,0 +1,362 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"vcassist-backend/lib/htmlutil"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// the formats moodle displays dates in, depending on whether the user
+// prefers 12 or 24 hour time
+var dateLayouts = []string{
+	"Monday, 2 January 2006, 3:04 PM",
+	"Monday, 2 January 2006, 15:04",
+}
+
+// parseDate parses a date as displayed in the tables of moodle, it returns
+// the zero time if the date could not be parsed (ex. if it is "-")
+func parseDate(text string) time.Time {
+	for _, layout := range dateLayouts {
+		t, err := time.ParseInLocation(layout, text, timezone.Location)
+		if err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func cleanText(sel *goquery.Selection) string {
+	return strings.Join(strings.Fields(sel.Text()), " ")
+}
+
+// ParseGrade parses a grade like "85.00 / 100.00", ok is false if the grade
+// is not in that form (ex. it is "-" because it hasn't been graded yet)
+func ParseGrade(grade string) (earned, possible float64, ok bool) {
+	earnedStr, possibleStr, found := strings.Cut(grade, "/")
+	if !found {
+		return 0, 0, false
+	}
+	earned, err := strconv.ParseFloat(strings.TrimSpace(earnedStr), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	possible, err = strconv.ParseFloat(strings.TrimSpace(possibleStr), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return earned, possible, true
+}
+
+// activityRow is a row in the table on the index page of an activity
+// module (ex. /mod/assign/index.php)
+type activityRow struct {
+	Section string
+	Link    htmlutil.Anchor
+	headers []string
+	cells   []*goquery.Selection
+}
+
+// column returns the text of the first cell whose header contains name
+func (r activityRow) column(name string) string {
+	for i, h := range r.headers {
+		if strings.Contains(h, name) && i < len(r.cells) {
+			return cleanText(r.cells[i])
+		}
+	}
+	return ""
+}
+
+func parseActivityTable(baseUrl *url.URL, doc *goquery.Document, module string) []activityRow {
+	table := doc.Find("table.generaltable").First()
+
+	var headers []string
+	table.Find("thead th").Each(func(_ int, th *goquery.Selection) {
+		headers = append(headers, strings.ToLower(cleanText(th)))
+	})
+
+	viewPath := fmt.Sprintf("/mod/%s/view.php", module)
+
+	var rows []activityRow
+	section := ""
+	table.Find("tbody tr").Each(func(_ int, tr *goquery.Selection) {
+		row := activityRow{headers: headers}
+		tr.Find("td").Each(func(_ int, td *goquery.Selection) {
+			row.cells = append(row.cells, td)
+		})
+
+		var link *goquery.Selection
+		tr.Find("td a").EachWithBreak(func(_ int, a *goquery.Selection) bool {
+			href, _ := a.Attr("href")
+			if strings.Contains(href, viewPath) {
+				link = a
+				return false
+			}
+			return true
+		})
+		// separator rows between sections don't link to an activity
+		if link == nil {
+			return
+		}
+		anchors := htmlutil.GetAnchors(baseUrl, link)
+		if len(anchors) == 0 {
+			return
+		}
+		row.Link = anchors[0]
+
+		// the section is only shown on the first row of each section,
+		// unless the section column is the one with the link (which
+		// happens when the course has no sections)
+		if len(row.cells) > 0 && row.cells[0].Find("a").Length() == 0 {
+			if name := cleanText(row.cells[0]); name != "" {
+				section = name
+			}
+		}
+		row.Section = section
+
+		rows = append(rows, row)
+	})
+	return rows
+}
+
+func (c Client) fetchDocument(ctx context.Context, endpoint string) (*goquery.Document, *url.URL, error) {
+	res, err := c.Core.Http.R().
+		SetContext(ctx).
+		Get(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewBuffer(res.Body()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, res.Request.RawRequest.URL, nil
+}
+
+// activityIndex returns the url of the index page of an activity module
+// for the given course
+func activityIndex(course Course, module string) (string, error) {
+	if course.Url == nil {
+		return "", fmt.Errorf("course url is nil")
+	}
+	id, err := course.Id()
+	if err != nil {
+		return "", err
+	}
+	index := course.Url.ResolveReference(&url.URL{
+		Path:     fmt.Sprintf("/mod/%s/index.php", module),
+		RawQuery: url.Values{"id": {strconv.FormatInt(id, 10)}}.Encode(),
+	})
+	return index.String(), nil
+}
+
+type Assignment struct {
+	Name    string
+	Url     *url.URL
+	Section string
+	// this is the zero time if the assignment has no due date
+	Due time.Time
+	// the submission status as it is shown to the user, ex. "Submitted
+	// for grading" or "No submission"
+	Submission string
+	// this is empty if the grade is not visible, see ParseGrade
+	Grade string
+}
+
+func (a Assignment) Id() (int64, error) {
+	return parseIdFromUrl(a.Url, "id")
+}
+
+// Submitted returns true if the submission status says the assignment has
+// been submitted
+func (a Assignment) Submitted() bool {
+	return strings.HasPrefix(strings.ToLower(a.Submission), "submitted")
+}
+
+func assignmentsFromDocument(baseUrl *url.URL, doc *goquery.Document) []Assignment {
+	var assignments []Assignment
+	for _, row := range parseActivityTable(baseUrl, doc, "assign") {
+		grade := row.column("grade")
+		if grade == "-" {
+			grade = ""
+		}
+		assignments = append(assignments, Assignment{
+			Name:       row.Link.Name,
+			Url:        row.Link.Url,
+			Section:    row.Section,
+			Due:        parseDate(row.column("due")),
+			Submission: row.column("submission"),
+			Grade:      grade,
+		})
+	}
+	return assignments
+}
+
+func (c Client) Assignments(ctx context.Context, course Course) ([]Assignment, error) {
+	ctx, span := tracer.Start(ctx, "Assignments")
+	defer span.End()
+
+	endpoint, err := activityIndex(course, "assign")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid course")
+		return nil, err
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "url",
+		Value: attribute.StringValue(endpoint),
+	})
+
+	doc, baseUrl, err := c.fetchDocument(ctx, endpoint)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch")
+		return nil, err
+	}
+
+	return assignmentsFromDocument(baseUrl, doc), nil
+}
+
+type Quiz struct {
+	Name    string
+	Url     *url.URL
+	Section string
+	// this is the zero time if the quiz does not close
+	Closes time.Time
+	// this is empty if the quiz hasn't been attempted or the grade is
+	// not visible, see ParseGrade
+	Grade string
+}
+
+func (q Quiz) Id() (int64, error) {
+	return parseIdFromUrl(q.Url, "id")
+}
+
+func quizzesFromDocument(baseUrl *url.URL, doc *goquery.Document) []Quiz {
+	var quizzes []Quiz
+	for _, row := range parseActivityTable(baseUrl, doc, "quiz") {
+		grade := row.column("grade")
+		if grade == "-" {
+			grade = ""
+		}
+		quizzes = append(quizzes, Quiz{
+			Name:    row.Link.Name,
+			Url:     row.Link.Url,
+			Section: row.Section,
+			Closes:  parseDate(row.column("close")),
+			Grade:   grade,
+		})
+	}
+	return quizzes
+}
+
+func (c Client) Quizzes(ctx context.Context, course Course) ([]Quiz, error) {
+	ctx, span := tracer.Start(ctx, "Quizzes")
+	defer span.End()
+
+	endpoint, err := activityIndex(course, "quiz")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid course")
+		return nil, err
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "url",
+		Value: attribute.StringValue(endpoint),
+	})
+
+	doc, baseUrl, err := c.fetchDocument(ctx, endpoint)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch")
+		return nil, err
+	}
+
+	return quizzesFromDocument(baseUrl, doc), nil
+}
+
+type Event struct {
+	Id       int64
+	Name     string
+	CourseId int64
+	// the module the event comes from, ex. "mod_assign", this is empty
+	// for events that don't belong to an activity
+	Component string
+	// ex. "due", "open", "close", "course" or "user"
+	Type string
+	Time time.Time
+	// the activity the event belongs to, this is nil if there isn't one
+	Url *url.URL
+}
+
+func eventsFromDocument(baseUrl *url.URL, doc *goquery.Document) []Event {
+	var events []Event
+	doc.Find("div.eventlist div.event[data-event-id]").Each(func(_ int, sel *goquery.Selection) {
+		id, err := strconv.ParseInt(sel.AttrOr("data-event-id", ""), 10, 64)
+		if err != nil {
+			return
+		}
+		courseId, _ := strconv.ParseInt(sel.AttrOr("data-course-id", ""), 10, 64)
+
+		event := Event{
+			Id:        id,
+			Name:      sel.AttrOr("data-event-title", ""),
+			CourseId:  courseId,
+			Component: sel.AttrOr("data-event-component", ""),
+			Type:      sel.AttrOr("data-event-eventtype", ""),
+		}
+		if event.Name == "" {
+			event.Name = cleanText(sel.Find(".name").First())
+		}
+
+		// the date is displayed as text relative to the current day
+		// (ex. "Today, 11:59 PM") but it also links to the day view
+		// with the exact time of the event
+		sel.Find(`.description a[href*="view=day"]`).EachWithBreak(func(_ int, a *goquery.Selection) bool {
+			href, _ := a.Attr("href")
+			link, err := baseUrl.Parse(href)
+			if err != nil {
+				return true
+			}
+			unix, err := strconv.ParseInt(link.Query().Get("time"), 10, 64)
+			if err != nil {
+				return true
+			}
+			event.Time = time.Unix(unix, 0).In(timezone.Location)
+			return false
+		})
+
+		activity := htmlutil.GetAnchors(baseUrl, sel.Find(".card-footer a.card-link").First())
+		if len(activity) > 0 {
+			event.Url = activity[0].Url
+		}
+
+		events = append(events, event)
+	})
+	return events
+}
+
+// UpcomingEvents returns the events on the upcoming events page of the
+// calendar, this includes the deadlines of activities in all courses.
+func (c Client) UpcomingEvents(ctx context.Context) ([]Event, error) {
+	ctx, span := tracer.Start(ctx, "UpcomingEvents")
+	defer span.End()
+
+	doc, baseUrl, err := c.fetchDocument(ctx, "/calendar/view.php?view=upcoming")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch")
+		return nil, err
+	}
+
+	return eventsFromDocument(baseUrl, doc), nil
+}
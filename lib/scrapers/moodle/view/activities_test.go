@@ -0,0 +1,140 @@
+package view
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTestDocument(t testing.TB, page string) (*url.URL, *goquery.Document) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	require.NoError(t, err)
+	baseUrl, err := url.Parse("https://learn.vcs.net/mod/assign/index.php?id=10")
+	require.NoError(t, err)
+	return baseUrl, doc
+}
+
+const assignIndexPage = `<table class="generaltable mod_index">
+<thead><tr>
+	<th class="header c0">Topic</th>
+	<th class="header c1">Assignments</th>
+	<th class="header c2">Due date</th>
+	<th class="header c3">Submission</th>
+	<th class="header c4 lastcol">Grade</th>
+</tr></thead>
+<tbody>
+<tr>
+	<td class="cell c0">Unit 1</td>
+	<td class="cell c1"><a href="https://learn.vcs.net/mod/assign/view.php?id=101">Essay</a></td>
+	<td class="cell c2">Friday, 6 September 2024, 11:59 PM</td>
+	<td class="cell c3">Submitted for grading</td>
+	<td class="cell c4 lastcol">85.00 / 100.00</td>
+</tr>
+<tr>
+	<td class="cell c0"></td>
+	<td class="cell c1"><a href="/mod/assign/view.php?id=102">Reflection</a></td>
+	<td class="cell c2">-</td>
+	<td class="cell c3">No submission</td>
+	<td class="cell c4 lastcol">-</td>
+</tr>
+<tr class="lastrow"><td colspan="5"><div class="tabledivider"></div></td></tr>
+<tr>
+	<td class="cell c0">Unit 2</td>
+	<td class="cell c1"><a href="/mod/assign/view.php?id=103">Lab report</a></td>
+	<td class="cell c2">Monday, 9 September 2024, 08:00</td>
+	<td class="cell c3">Draft (not submitted)</td>
+	<td class="cell c4 lastcol">-</td>
+</tr>
+</tbody>
+</table>`
+
+func TestAssignmentsFromDocument(t *testing.T) {
+	baseUrl, doc := parseTestDocument(t, assignIndexPage)
+	assignments := assignmentsFromDocument(baseUrl, doc)
+	require.Len(t, assignments, 3)
+
+	require.Equal(t, "Essay", assignments[0].Name)
+	require.Equal(t, "Unit 1", assignments[0].Section)
+	require.Equal(t, time.Date(2024, 9, 6, 23, 59, 0, 0, timezone.Location), assignments[0].Due)
+	require.True(t, assignments[0].Submitted())
+	earned, possible, ok := ParseGrade(assignments[0].Grade)
+	require.True(t, ok)
+	require.Equal(t, 85.0, earned)
+	require.Equal(t, 100.0, possible)
+	id, err := assignments[0].Id()
+	require.NoError(t, err)
+	require.Equal(t, int64(101), id)
+
+	require.Equal(t, "Unit 1", assignments[1].Section)
+	require.True(t, assignments[1].Due.IsZero())
+	require.False(t, assignments[1].Submitted())
+	require.Equal(t, "", assignments[1].Grade)
+	require.Equal(t, "https://learn.vcs.net/mod/assign/view.php?id=102", assignments[1].Url.String())
+
+	require.Equal(t, "Unit 2", assignments[2].Section)
+	require.Equal(t, time.Date(2024, 9, 9, 8, 0, 0, 0, timezone.Location), assignments[2].Due)
+	require.False(t, assignments[2].Submitted())
+}
+
+func TestQuizzesFromDocument(t *testing.T) {
+	baseUrl, doc := parseTestDocument(t, `<table class="generaltable mod_index">
+<thead><tr><th>Topic</th><th>Name</th><th>Quiz closes</th><th>Grade</th></tr></thead>
+<tbody>
+<tr>
+	<td>Unit 1</td>
+	<td><a href="/mod/quiz/view.php?id=201">Vocab quiz</a></td>
+	<td>Friday, 6 September 2024, 3:00 PM</td>
+	<td>9.00 / 10.00</td>
+</tr>
+</tbody>
+</table>`)
+	quizzes := quizzesFromDocument(baseUrl, doc)
+	require.Len(t, quizzes, 1)
+	require.Equal(t, "Vocab quiz", quizzes[0].Name)
+	require.Equal(t, time.Date(2024, 9, 6, 15, 0, 0, 0, timezone.Location), quizzes[0].Closes)
+	require.Equal(t, "9.00 / 10.00", quizzes[0].Grade)
+}
+
+func TestEventsFromDocument(t *testing.T) {
+	baseUrl, doc := parseTestDocument(t, `<div class="eventlist my-1">
+<div data-type="event" data-course-id="10" data-event-id="501" class="event mt-3"
+	data-event-component="mod_assign" data-event-eventtype="due" data-event-title="Essay is due">
+	<div class="card rounded">
+		<div class="box card-header"><h3 class="name d-inline-block">Essay is due</h3></div>
+		<div class="description card-body">
+			<div class="row"><div class="col-11">
+				<a href="https://learn.vcs.net/calendar/view.php?view=day&amp;time=1725692340">Friday, 6 September</a>, 11:59 PM
+			</div></div>
+		</div>
+		<div class="card-footer"><a href="https://learn.vcs.net/mod/assign/view.php?id=101" class="card-link">Go to activity</a></div>
+	</div>
+</div>
+<div data-type="event" data-course-id="0" data-event-id="502" class="event mt-3" data-event-eventtype="user">
+	<div class="card rounded">
+		<div class="box card-header"><h3 class="name d-inline-block">Study group</h3></div>
+		<div class="description card-body">
+			<a href="/calendar/view.php?view=day&amp;time=1725750000">Tomorrow</a>, 4:00 PM
+		</div>
+	</div>
+</div>
+</div>`)
+	events := eventsFromDocument(baseUrl, doc)
+	require.Len(t, events, 2)
+
+	require.Equal(t, int64(501), events[0].Id)
+	require.Equal(t, "Essay is due", events[0].Name)
+	require.Equal(t, int64(10), events[0].CourseId)
+	require.Equal(t, "mod_assign", events[0].Component)
+	require.Equal(t, "due", events[0].Type)
+	require.Equal(t, int64(1725692340), events[0].Time.Unix())
+	require.Equal(t, "https://learn.vcs.net/mod/assign/view.php?id=101", events[0].Url.String())
+
+	require.Equal(t, "Study group", events[1].Name)
+	require.Equal(t, int64(1725750000), events[1].Time.Unix())
+	require.Nil(t, events[1].Url)
+}
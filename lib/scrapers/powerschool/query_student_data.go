@@ -52,14 +52,15 @@ fragment finalGradeData on FinalGradeType {
 }`
 
 type FinalGrade struct {
-	Percent          int  `json:"percent"`
+	Percent          *int `json:"percent"`
 	InProgressStatus bool `json:"inProgressStatus"`
 }
 
 type TermData struct {
-	Start      string     `json:"start"`
-	End        string     `json:"end"`
-	FinalGrade FinalGrade `json:"finalGrade"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// this is nil for terms that haven't been graded
+	FinalGrade *FinalGrade `json:"finalGrade"`
 }
 
 type AssignmentData struct {
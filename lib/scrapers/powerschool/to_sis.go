@@ -35,6 +35,7 @@ func toSisCourses(ctx context.Context, input []CourseData) []*sisv1.CourseData {
 
 		now := timezone.Now().Unix()
 		var overallGrade int64 = -1
+		var terms []*sisv1.TermGrade
 		for _, term := range course.Terms {
 			start, err := DecodeTimestamp(term.Start)
 			if err != nil {
@@ -47,9 +48,21 @@ func toSisCourses(ctx context.Context, input []CourseData) []*sisv1.CourseData {
 				continue
 			}
 
-			if now >= start.Unix() && now < end.Unix() {
-				overallGrade = int64(term.FinalGrade.Percent)
-				break
+			grade := &sisv1.TermGrade{
+				Start: start.Unix(),
+				End:   end.Unix(),
+			}
+			if term.FinalGrade != nil {
+				grade.InProgress = term.FinalGrade.InProgressStatus
+				if term.FinalGrade.Percent != nil {
+					percent := float32(*term.FinalGrade.Percent)
+					grade.Percent = &percent
+				}
+			}
+			terms = append(terms, grade)
+
+			if overallGrade < 0 && grade.Percent != nil && now >= start.Unix() && now < end.Unix() {
+				overallGrade = int64(grade.GetPercent())
 			}
 		}
 
@@ -98,6 +111,7 @@ func toSisCourses(ctx context.Context, input []CourseData) []*sisv1.CourseData {
 			DayName:        currentDay,
 			OverallGrade:   float32(overallGrade),
 			HomeworkPasses: int32(homeworkPasses),
+			Terms:          terms,
 		}
 	}
 
@@ -66,11 +66,20 @@ type Event struct {
 
 var client = resty.New()
 
+const calendarUrl = "https://www.vcs.net/fs/elements/39337"
+
+// FetchEvents fetches the events of the current school year, if any page of
+// the calendar fails the events of the other pages are returned along with
+// the error.
 func FetchEvents(ctx context.Context, tz *time.Location) ([]Event, error) {
+	return fetchEvents(ctx, calendarUrl, tz)
+}
+
+func fetchEvents(ctx context.Context, calendar string, tz *time.Location) ([]Event, error) {
 	ctx, span := tracer.Start(ctx, "FetchEvents")
 	defer span.End()
 
-	link, err := url.Parse("https://www.vcs.net/fs/elements/39337")
+	link, err := url.Parse(calendar)
 	if err != nil {
 		return nil, err
 	}
@@ -105,14 +114,19 @@ func FetchEvents(ctx context.Context, tz *time.Location) ([]Event, error) {
 			currentDate.Day(),
 		))
 		link.RawQuery = query.Encode()
+		// the link is modified for the next page before this one is
+		// fetched, so each goroutine needs its own copy
+		page := link.String()
 
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 
-			events, err := parseCalendar(ctx, link.String(), tz)
+			events, err := parseCalendar(ctx, page, tz)
 			if err != nil {
 				slog.ErrorContext(ctx, "failed to parse calendar page", "err", err)
+				resultLock.Lock()
+				defer resultLock.Unlock()
 				errList = append(errList, err)
 				return
 			}
@@ -162,6 +176,12 @@ func parseCalendar(ctx context.Context, link string, tz *time.Location) ([]Event
 		span.SetStatus(codes.Error, "failed to fetch calendar page")
 		return nil, err
 	}
+	if res.IsError() {
+		err = fmt.Errorf("fetch calendar page: %s", res.Status())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch calendar page")
+		return nil, err
+	}
 
 	doc, err := goquery.NewDocumentFromReader(bytes.NewBuffer(res.Body()))
 	if err != nil {
@@ -2,6 +2,11 @@ package vcsnet
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 	"vcassist-backend/lib/telemetry"
@@ -25,6 +30,68 @@ func TestFetchEvents(t *testing.T) {
 	require.Greater(t, len(events), 0)
 }
 
+// fakeCalendar serves a calendar page with one event on the first of the
+// month in cal_date, requests for months in failMonths fail.
+func fakeCalendar(t testing.TB, failMonths ...time.Month) (*httptest.Server, *sync.Map) {
+	requested := &sync.Map{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date, err := time.Parse(time.DateOnly, r.URL.Query().Get("cal_date"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requested.Store(date.Format(time.DateOnly), true)
+		for _, month := range failMonths {
+			if date.Month() == month {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+		fmt.Fprintf(
+			w,
+			`<div class="fsCalendarDaybox"><div class="fsCalendarDate" data-year="%d" data-month="%d" data-day="1"></div><a class="fsCalendarEventLink">%s</a></div>`,
+			date.Year(), int(date.Month()), date.Month().String(),
+		)
+	}))
+	t.Cleanup(server.Close)
+	return server, requested
+}
+
+func TestFetchEventsPages(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:scrapers/vcsnet")
+	defer cleanup()
+	ctx := context.Background()
+
+	server, requested := fakeCalendar(t)
+	events, err := fetchEvents(ctx, server.URL, timezone.Location)
+	require.NoError(t, err)
+
+	var names []string
+	for _, e := range events {
+		names = append(names, e.Name)
+	}
+	require.Equal(t, []string{
+		"September", "October", "November", "December", "January",
+		"February", "March", "April", "May", "June",
+	}, names)
+
+	pages := 0
+	requested.Range(func(_, _ any) bool {
+		pages++
+		return true
+	})
+	require.Equal(t, 10, pages)
+
+	// a failed page is reported along with the events of the others
+	server, _ = fakeCalendar(t, time.March)
+	events, err = fetchEvents(ctx, server.URL, timezone.Location)
+	require.Error(t, err)
+	require.Len(t, events, 9)
+	for _, e := range events {
+		require.False(t, strings.EqualFold(e.Name, "march"))
+	}
+}
+
 func TestGetSchoolYear(t *testing.T) {
 	tz := timezone.Location
 
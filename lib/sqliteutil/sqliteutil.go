@@ -28,7 +28,9 @@ func openSqlite(path string) (*sql.DB, error) {
 	return db, nil
 }
 
-func OpenDB(schema, path string) (*sql.DB, error) {
+// OpenDB opens the database at path and migrates it to schema, tables
+// matching the exclude glob patterns are left as they are by migrations.
+func OpenDB(schema, path string, exclude ...string) (*sql.DB, error) {
 	db, err := openSqlite(path)
 	if err != nil {
 		return nil, err
@@ -58,12 +60,16 @@ func OpenDB(schema, path string) (*sql.DB, error) {
 		Scheme: "sqlite",
 		Path:   path,
 	}
-	cmd := exec.Command(
-		"atlas", "schema", "apply",
+	args := []string{
+		"schema", "apply",
 		"--url", dbUrl.String(),
 		"--to", "file://temp_migration_schema.sql",
 		"--dev-url", "sqlite://file?mode=memory",
-	)
+	}
+	for _, pattern := range exclude {
+		args = append(args, "--exclude", pattern)
+	}
+	cmd := exec.Command("atlas", args...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -10,6 +10,7 @@ import (
 	"github.com/lmittmann/tint"
 	slogotel "github.com/remychantenay/slog-otel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/trace"
 	oteltrace "go.opentelemetry.io/otel/trace"
 )
@@ -72,6 +73,12 @@ func Tracer(libraryName string) TracerLike {
 	return &wrappedTracer{libraryName: libraryName}
 }
 
+// Meter returns a meter from the global meter provider, instruments
+// created before Setup is called start reporting once it is.
+func Meter(libraryName string) metric.Meter {
+	return otel.Meter(libraryName)
+}
+
 func Setup(ctx context.Context, serviceName string, config config) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*15)
 	defer cancel()
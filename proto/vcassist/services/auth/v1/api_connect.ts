@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConsumeVerificationCodeRequest, ConsumeVerificationCodeResponse, StartLoginRequest, StartLoginResponse, VerifyTokenRequest, VerifyTokenResponse } from "./api_pb.js";
+import { ConsumeVerificationCodeRequest, ConsumeVerificationCodeResponse, ListSessionsRequest, ListSessionsResponse, LoginWithGoogleRequest, LoginWithGoogleResponse, LogoutRequest, LogoutResponse, RefreshAccessTokenRequest, RefreshAccessTokenResponse, RevokeAllSessionsRequest, RevokeAllSessionsResponse, StartLoginRequest, StartLoginResponse, VerifyTokenRequest, VerifyTokenResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -30,6 +30,30 @@ export const AuthService = {
       O: ConsumeVerificationCodeResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * logs in with a google account instead of an emailed verification code
+     *
+     * @generated from rpc vcassist.services.auth.v1.AuthService.LoginWithGoogle
+     */
+    loginWithGoogle: {
+      name: "LoginWithGoogle",
+      I: LoginWithGoogleRequest,
+      O: LoginWithGoogleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * issues a new access token for a session token, logging out or
+     * revoking the session stops new access tokens from being issued but
+     * access tokens that were already issued stay valid until they expire
+     *
+     * @generated from rpc vcassist.services.auth.v1.AuthService.RefreshAccessToken
+     */
+    refreshAccessToken: {
+      name: "RefreshAccessToken",
+      I: RefreshAccessTokenRequest,
+      O: RefreshAccessTokenResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc vcassist.services.auth.v1.AuthService.VerifyToken
      */
@@ -39,6 +63,33 @@ export const AuthService = {
       O: VerifyTokenResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc vcassist.services.auth.v1.AuthService.ListSessions
+     */
+    listSessions: {
+      name: "ListSessions",
+      I: ListSessionsRequest,
+      O: ListSessionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.auth.v1.AuthService.Logout
+     */
+    logout: {
+      name: "Logout",
+      I: LogoutRequest,
+      O: LogoutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.auth.v1.AuthService.RevokeAllSessions
+     */
+    revokeAllSessions: {
+      name: "RevokeAllSessions",
+      I: RevokeAllSessionsRequest,
+      O: RevokeAllSessionsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
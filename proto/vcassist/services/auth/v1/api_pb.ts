@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
 /**
  * @generated from message vcassist.services.auth.v1.StartLoginRequest
@@ -88,6 +88,14 @@ export class ConsumeVerificationCodeRequest extends Message<ConsumeVerificationC
    */
   providedCode = "";
 
+  /**
+   * this is optional, a name for the device that is logging in (ex.
+   * "Pixel 8") so the user can recognize the session later
+   *
+   * @generated from field: string device = 3;
+   */
+  device = "";
+
   constructor(data?: PartialMessage<ConsumeVerificationCodeRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -98,6 +106,7 @@ export class ConsumeVerificationCodeRequest extends Message<ConsumeVerificationC
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "provided_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConsumeVerificationCodeRequest {
@@ -122,10 +131,29 @@ export class ConsumeVerificationCodeRequest extends Message<ConsumeVerificationC
  */
 export class ConsumeVerificationCodeResponse extends Message<ConsumeVerificationCodeResponse> {
   /**
+   * the session token, it lasts until it hasn't been used for 30 days and
+   * is used to get new access tokens with RefreshAccessToken
+   *
    * @generated from field: string token = 1;
    */
   token = "";
 
+  /**
+   * a short lived token that can be verified without a db lookup, it
+   * should be used to authenticate requests instead of the session token.
+   * this is empty if access tokens aren't enabled on the server.
+   *
+   * @generated from field: string access_token = 2;
+   */
+  accessToken = "";
+
+  /**
+   * unix timestamp
+   *
+   * @generated from field: int64 access_token_expires_at = 3;
+   */
+  accessTokenExpiresAt = protoInt64.zero;
+
   constructor(data?: PartialMessage<ConsumeVerificationCodeResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -135,6 +163,8 @@ export class ConsumeVerificationCodeResponse extends Message<ConsumeVerification
   static readonly typeName = "vcassist.services.auth.v1.ConsumeVerificationCodeResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "access_token_expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConsumeVerificationCodeResponse {
@@ -154,11 +184,228 @@ export class ConsumeVerificationCodeResponse extends Message<ConsumeVerification
   }
 }
 
+/**
+ * exactly one of id_token and authorization_code should be specified
+ *
+ * @generated from message vcassist.services.auth.v1.LoginWithGoogleRequest
+ */
+export class LoginWithGoogleRequest extends Message<LoginWithGoogleRequest> {
+  /**
+   * an id token the client got from google sign-in
+   *
+   * @generated from field: string id_token = 1;
+   */
+  idToken = "";
+
+  /**
+   * an authorization code from google's oauth consent screen, it is
+   * exchanged for an id token with the redirect_uri and code_verifier
+   * (PKCE) it was requested with
+   *
+   * @generated from field: string authorization_code = 2;
+   */
+  authorizationCode = "";
+
+  /**
+   * @generated from field: string redirect_uri = 3;
+   */
+  redirectUri = "";
+
+  /**
+   * @generated from field: string code_verifier = 4;
+   */
+  codeVerifier = "";
+
+  /**
+   * this is optional, if specified the id token must have been issued with
+   * this nonce
+   *
+   * @generated from field: string nonce = 5;
+   */
+  nonce = "";
+
+  /**
+   * this is optional, see ConsumeVerificationCodeRequest.device
+   *
+   * @generated from field: string device = 6;
+   */
+  device = "";
+
+  constructor(data?: PartialMessage<LoginWithGoogleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.LoginWithGoogleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "authorization_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "redirect_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "code_verifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "nonce", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginWithGoogleRequest {
+    return new LoginWithGoogleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoginWithGoogleRequest {
+    return new LoginWithGoogleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoginWithGoogleRequest {
+    return new LoginWithGoogleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoginWithGoogleRequest | PlainMessage<LoginWithGoogleRequest> | undefined, b: LoginWithGoogleRequest | PlainMessage<LoginWithGoogleRequest> | undefined): boolean {
+    return proto3.util.equals(LoginWithGoogleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.LoginWithGoogleResponse
+ */
+export class LoginWithGoogleResponse extends Message<LoginWithGoogleResponse> {
+  /**
+   * see ConsumeVerificationCodeResponse
+   *
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  /**
+   * @generated from field: string access_token = 2;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: int64 access_token_expires_at = 3;
+   */
+  accessTokenExpiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<LoginWithGoogleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.LoginWithGoogleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "access_token_expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginWithGoogleResponse {
+    return new LoginWithGoogleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoginWithGoogleResponse {
+    return new LoginWithGoogleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoginWithGoogleResponse {
+    return new LoginWithGoogleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoginWithGoogleResponse | PlainMessage<LoginWithGoogleResponse> | undefined, b: LoginWithGoogleResponse | PlainMessage<LoginWithGoogleResponse> | undefined): boolean {
+    return proto3.util.equals(LoginWithGoogleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.RefreshAccessTokenRequest
+ */
+export class RefreshAccessTokenRequest extends Message<RefreshAccessTokenRequest> {
+  /**
+   * the session token
+   *
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<RefreshAccessTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.RefreshAccessTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshAccessTokenRequest {
+    return new RefreshAccessTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshAccessTokenRequest {
+    return new RefreshAccessTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshAccessTokenRequest {
+    return new RefreshAccessTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshAccessTokenRequest | PlainMessage<RefreshAccessTokenRequest> | undefined, b: RefreshAccessTokenRequest | PlainMessage<RefreshAccessTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshAccessTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.RefreshAccessTokenResponse
+ */
+export class RefreshAccessTokenResponse extends Message<RefreshAccessTokenResponse> {
+  /**
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * unix timestamp
+   *
+   * @generated from field: int64 access_token_expires_at = 2;
+   */
+  accessTokenExpiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RefreshAccessTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.RefreshAccessTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "access_token_expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshAccessTokenResponse {
+    return new RefreshAccessTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshAccessTokenResponse {
+    return new RefreshAccessTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshAccessTokenResponse {
+    return new RefreshAccessTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshAccessTokenResponse | PlainMessage<RefreshAccessTokenResponse> | undefined, b: RefreshAccessTokenResponse | PlainMessage<RefreshAccessTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RefreshAccessTokenResponse, a, b);
+  }
+}
+
 /**
  * @generated from message vcassist.services.auth.v1.VerifyTokenRequest
  */
 export class VerifyTokenRequest extends Message<VerifyTokenRequest> {
   /**
+   * either a session token or an access token
+   *
    * @generated from field: string token = 1;
    */
   token = "";
@@ -228,3 +475,314 @@ export class VerifyTokenResponse extends Message<VerifyTokenResponse> {
   }
 }
 
+/**
+ * @generated from message vcassist.services.auth.v1.Session
+ */
+export class Session extends Message<Session> {
+  /**
+   * this identifies the session without revealing its token
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string device = 2;
+   */
+  device = "";
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent = "";
+
+  /**
+   * unix timestamps, created_at is 0 for sessions created before it was
+   * recorded
+   *
+   * @generated from field: int64 created_at = 4;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 last_used_at = 5;
+   */
+  lastUsedAt = protoInt64.zero;
+
+  /**
+   * this moves forward every time the session is used
+   *
+   * @generated from field: int64 expires_at = 6;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * true if this is the session of the token the request was made with
+   *
+   * @generated from field: bool current = 7;
+   */
+  current = false;
+
+  constructor(data?: PartialMessage<Session>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.Session";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_agent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "last_used_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "current", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Session {
+    return new Session().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Session {
+    return new Session().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Session {
+    return new Session().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Session | PlainMessage<Session> | undefined, b: Session | PlainMessage<Session> | undefined): boolean {
+    return proto3.util.equals(Session, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.ListSessionsRequest
+ */
+export class ListSessionsRequest extends Message<ListSessionsRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<ListSessionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.ListSessionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSessionsRequest | PlainMessage<ListSessionsRequest> | undefined, b: ListSessionsRequest | PlainMessage<ListSessionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSessionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.ListSessionsResponse
+ */
+export class ListSessionsResponse extends Message<ListSessionsResponse> {
+  /**
+   * sorted by most recently used
+   *
+   * @generated from field: repeated vcassist.services.auth.v1.Session sessions = 1;
+   */
+  sessions: Session[] = [];
+
+  constructor(data?: PartialMessage<ListSessionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.ListSessionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions", kind: "message", T: Session, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSessionsResponse | PlainMessage<ListSessionsResponse> | undefined, b: ListSessionsResponse | PlainMessage<ListSessionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSessionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.LogoutRequest
+ */
+export class LogoutRequest extends Message<LogoutRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  /**
+   * this is optional, if it is specified the session with this id is
+   * logged out instead of the session of the token
+   *
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<LogoutRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.LogoutRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LogoutRequest {
+    return new LogoutRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LogoutRequest {
+    return new LogoutRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LogoutRequest {
+    return new LogoutRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LogoutRequest | PlainMessage<LogoutRequest> | undefined, b: LogoutRequest | PlainMessage<LogoutRequest> | undefined): boolean {
+    return proto3.util.equals(LogoutRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.LogoutResponse
+ */
+export class LogoutResponse extends Message<LogoutResponse> {
+  constructor(data?: PartialMessage<LogoutResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.LogoutResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LogoutResponse {
+    return new LogoutResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LogoutResponse {
+    return new LogoutResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LogoutResponse {
+    return new LogoutResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LogoutResponse | PlainMessage<LogoutResponse> | undefined, b: LogoutResponse | PlainMessage<LogoutResponse> | undefined): boolean {
+    return proto3.util.equals(LogoutResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.RevokeAllSessionsRequest
+ */
+export class RevokeAllSessionsRequest extends Message<RevokeAllSessionsRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  /**
+   * if this is true the session of the token is not revoked
+   *
+   * @generated from field: bool keep_current = 2;
+   */
+  keepCurrent = false;
+
+  constructor(data?: PartialMessage<RevokeAllSessionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.RevokeAllSessionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "keep_current", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeAllSessionsRequest {
+    return new RevokeAllSessionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeAllSessionsRequest {
+    return new RevokeAllSessionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeAllSessionsRequest {
+    return new RevokeAllSessionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeAllSessionsRequest | PlainMessage<RevokeAllSessionsRequest> | undefined, b: RevokeAllSessionsRequest | PlainMessage<RevokeAllSessionsRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeAllSessionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.auth.v1.RevokeAllSessionsResponse
+ */
+export class RevokeAllSessionsResponse extends Message<RevokeAllSessionsResponse> {
+  constructor(data?: PartialMessage<RevokeAllSessionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.auth.v1.RevokeAllSessionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeAllSessionsResponse {
+    return new RevokeAllSessionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeAllSessionsResponse {
+    return new RevokeAllSessionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeAllSessionsResponse {
+    return new RevokeAllSessionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeAllSessionsResponse | PlainMessage<RevokeAllSessionsResponse> | undefined, b: RevokeAllSessionsResponse | PlainMessage<RevokeAllSessionsResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeAllSessionsResponse, a, b);
+  }
+}
+
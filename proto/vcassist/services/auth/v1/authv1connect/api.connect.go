@@ -38,8 +38,22 @@ const (
 	// AuthServiceConsumeVerificationCodeProcedure is the fully-qualified name of the AuthService's
 	// ConsumeVerificationCode RPC.
 	AuthServiceConsumeVerificationCodeProcedure = "/vcassist.services.auth.v1.AuthService/ConsumeVerificationCode"
+	// AuthServiceLoginWithGoogleProcedure is the fully-qualified name of the AuthService's
+	// LoginWithGoogle RPC.
+	AuthServiceLoginWithGoogleProcedure = "/vcassist.services.auth.v1.AuthService/LoginWithGoogle"
+	// AuthServiceRefreshAccessTokenProcedure is the fully-qualified name of the AuthService's
+	// RefreshAccessToken RPC.
+	AuthServiceRefreshAccessTokenProcedure = "/vcassist.services.auth.v1.AuthService/RefreshAccessToken"
 	// AuthServiceVerifyTokenProcedure is the fully-qualified name of the AuthService's VerifyToken RPC.
 	AuthServiceVerifyTokenProcedure = "/vcassist.services.auth.v1.AuthService/VerifyToken"
+	// AuthServiceListSessionsProcedure is the fully-qualified name of the AuthService's ListSessions
+	// RPC.
+	AuthServiceListSessionsProcedure = "/vcassist.services.auth.v1.AuthService/ListSessions"
+	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
+	AuthServiceLogoutProcedure = "/vcassist.services.auth.v1.AuthService/Logout"
+	// AuthServiceRevokeAllSessionsProcedure is the fully-qualified name of the AuthService's
+	// RevokeAllSessions RPC.
+	AuthServiceRevokeAllSessionsProcedure = "/vcassist.services.auth.v1.AuthService/RevokeAllSessions"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -47,14 +61,28 @@ var (
 	authServiceServiceDescriptor                       = v1.File_vcassist_services_auth_v1_api_proto.Services().ByName("AuthService")
 	authServiceStartLoginMethodDescriptor              = authServiceServiceDescriptor.Methods().ByName("StartLogin")
 	authServiceConsumeVerificationCodeMethodDescriptor = authServiceServiceDescriptor.Methods().ByName("ConsumeVerificationCode")
+	authServiceLoginWithGoogleMethodDescriptor         = authServiceServiceDescriptor.Methods().ByName("LoginWithGoogle")
+	authServiceRefreshAccessTokenMethodDescriptor      = authServiceServiceDescriptor.Methods().ByName("RefreshAccessToken")
 	authServiceVerifyTokenMethodDescriptor             = authServiceServiceDescriptor.Methods().ByName("VerifyToken")
+	authServiceListSessionsMethodDescriptor            = authServiceServiceDescriptor.Methods().ByName("ListSessions")
+	authServiceLogoutMethodDescriptor                  = authServiceServiceDescriptor.Methods().ByName("Logout")
+	authServiceRevokeAllSessionsMethodDescriptor       = authServiceServiceDescriptor.Methods().ByName("RevokeAllSessions")
 )
 
 // AuthServiceClient is a client for the vcassist.services.auth.v1.AuthService service.
 type AuthServiceClient interface {
 	StartLogin(context.Context, *connect.Request[v1.StartLoginRequest]) (*connect.Response[v1.StartLoginResponse], error)
 	ConsumeVerificationCode(context.Context, *connect.Request[v1.ConsumeVerificationCodeRequest]) (*connect.Response[v1.ConsumeVerificationCodeResponse], error)
+	// logs in with a google account instead of an emailed verification code
+	LoginWithGoogle(context.Context, *connect.Request[v1.LoginWithGoogleRequest]) (*connect.Response[v1.LoginWithGoogleResponse], error)
+	// issues a new access token for a session token, logging out or
+	// revoking the session stops new access tokens from being issued but
+	// access tokens that were already issued stay valid until they expire
+	RefreshAccessToken(context.Context, *connect.Request[v1.RefreshAccessTokenRequest]) (*connect.Response[v1.RefreshAccessTokenResponse], error)
 	VerifyToken(context.Context, *connect.Request[v1.VerifyTokenRequest]) (*connect.Response[v1.VerifyTokenResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the vcassist.services.auth.v1.AuthService service.
@@ -79,12 +107,42 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceConsumeVerificationCodeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		loginWithGoogle: connect.NewClient[v1.LoginWithGoogleRequest, v1.LoginWithGoogleResponse](
+			httpClient,
+			baseURL+AuthServiceLoginWithGoogleProcedure,
+			connect.WithSchema(authServiceLoginWithGoogleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		refreshAccessToken: connect.NewClient[v1.RefreshAccessTokenRequest, v1.RefreshAccessTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRefreshAccessTokenProcedure,
+			connect.WithSchema(authServiceRefreshAccessTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		verifyToken: connect.NewClient[v1.VerifyTokenRequest, v1.VerifyTokenResponse](
 			httpClient,
 			baseURL+AuthServiceVerifyTokenProcedure,
 			connect.WithSchema(authServiceVerifyTokenMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceListSessionsProcedure,
+			connect.WithSchema(authServiceListSessionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		logout: connect.NewClient[v1.LogoutRequest, v1.LogoutResponse](
+			httpClient,
+			baseURL+AuthServiceLogoutProcedure,
+			connect.WithSchema(authServiceLogoutMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllSessions: connect.NewClient[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeAllSessionsProcedure,
+			connect.WithSchema(authServiceRevokeAllSessionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -92,7 +150,12 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 type authServiceClient struct {
 	startLogin              *connect.Client[v1.StartLoginRequest, v1.StartLoginResponse]
 	consumeVerificationCode *connect.Client[v1.ConsumeVerificationCodeRequest, v1.ConsumeVerificationCodeResponse]
+	loginWithGoogle         *connect.Client[v1.LoginWithGoogleRequest, v1.LoginWithGoogleResponse]
+	refreshAccessToken      *connect.Client[v1.RefreshAccessTokenRequest, v1.RefreshAccessTokenResponse]
 	verifyToken             *connect.Client[v1.VerifyTokenRequest, v1.VerifyTokenResponse]
+	listSessions            *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	logout                  *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	revokeAllSessions       *connect.Client[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse]
 }
 
 // StartLogin calls vcassist.services.auth.v1.AuthService.StartLogin.
@@ -105,16 +168,50 @@ func (c *authServiceClient) ConsumeVerificationCode(ctx context.Context, req *co
 	return c.consumeVerificationCode.CallUnary(ctx, req)
 }
 
+// LoginWithGoogle calls vcassist.services.auth.v1.AuthService.LoginWithGoogle.
+func (c *authServiceClient) LoginWithGoogle(ctx context.Context, req *connect.Request[v1.LoginWithGoogleRequest]) (*connect.Response[v1.LoginWithGoogleResponse], error) {
+	return c.loginWithGoogle.CallUnary(ctx, req)
+}
+
+// RefreshAccessToken calls vcassist.services.auth.v1.AuthService.RefreshAccessToken.
+func (c *authServiceClient) RefreshAccessToken(ctx context.Context, req *connect.Request[v1.RefreshAccessTokenRequest]) (*connect.Response[v1.RefreshAccessTokenResponse], error) {
+	return c.refreshAccessToken.CallUnary(ctx, req)
+}
+
 // VerifyToken calls vcassist.services.auth.v1.AuthService.VerifyToken.
 func (c *authServiceClient) VerifyToken(ctx context.Context, req *connect.Request[v1.VerifyTokenRequest]) (*connect.Response[v1.VerifyTokenResponse], error) {
 	return c.verifyToken.CallUnary(ctx, req)
 }
 
+// ListSessions calls vcassist.services.auth.v1.AuthService.ListSessions.
+func (c *authServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// Logout calls vcassist.services.auth.v1.AuthService.Logout.
+func (c *authServiceClient) Logout(ctx context.Context, req *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return c.logout.CallUnary(ctx, req)
+}
+
+// RevokeAllSessions calls vcassist.services.auth.v1.AuthService.RevokeAllSessions.
+func (c *authServiceClient) RevokeAllSessions(ctx context.Context, req *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error) {
+	return c.revokeAllSessions.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the vcassist.services.auth.v1.AuthService service.
 type AuthServiceHandler interface {
 	StartLogin(context.Context, *connect.Request[v1.StartLoginRequest]) (*connect.Response[v1.StartLoginResponse], error)
 	ConsumeVerificationCode(context.Context, *connect.Request[v1.ConsumeVerificationCodeRequest]) (*connect.Response[v1.ConsumeVerificationCodeResponse], error)
+	// logs in with a google account instead of an emailed verification code
+	LoginWithGoogle(context.Context, *connect.Request[v1.LoginWithGoogleRequest]) (*connect.Response[v1.LoginWithGoogleResponse], error)
+	// issues a new access token for a session token, logging out or
+	// revoking the session stops new access tokens from being issued but
+	// access tokens that were already issued stay valid until they expire
+	RefreshAccessToken(context.Context, *connect.Request[v1.RefreshAccessTokenRequest]) (*connect.Response[v1.RefreshAccessTokenResponse], error)
 	VerifyToken(context.Context, *connect.Request[v1.VerifyTokenRequest]) (*connect.Response[v1.VerifyTokenResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -135,20 +232,60 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceConsumeVerificationCodeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceLoginWithGoogleHandler := connect.NewUnaryHandler(
+		AuthServiceLoginWithGoogleProcedure,
+		svc.LoginWithGoogle,
+		connect.WithSchema(authServiceLoginWithGoogleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRefreshAccessTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRefreshAccessTokenProcedure,
+		svc.RefreshAccessToken,
+		connect.WithSchema(authServiceRefreshAccessTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceVerifyTokenHandler := connect.NewUnaryHandler(
 		AuthServiceVerifyTokenProcedure,
 		svc.VerifyToken,
 		connect.WithSchema(authServiceVerifyTokenMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authServiceListSessionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLogoutHandler := connect.NewUnaryHandler(
+		AuthServiceLogoutProcedure,
+		svc.Logout,
+		connect.WithSchema(authServiceLogoutMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeAllSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeAllSessionsProcedure,
+		svc.RevokeAllSessions,
+		connect.WithSchema(authServiceRevokeAllSessionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/vcassist.services.auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceStartLoginProcedure:
 			authServiceStartLoginHandler.ServeHTTP(w, r)
 		case AuthServiceConsumeVerificationCodeProcedure:
 			authServiceConsumeVerificationCodeHandler.ServeHTTP(w, r)
+		case AuthServiceLoginWithGoogleProcedure:
+			authServiceLoginWithGoogleHandler.ServeHTTP(w, r)
+		case AuthServiceRefreshAccessTokenProcedure:
+			authServiceRefreshAccessTokenHandler.ServeHTTP(w, r)
 		case AuthServiceVerifyTokenProcedure:
 			authServiceVerifyTokenHandler.ServeHTTP(w, r)
+		case AuthServiceListSessionsProcedure:
+			authServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceLogoutProcedure:
+			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeAllSessionsProcedure:
+			authServiceRevokeAllSessionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,6 +303,26 @@ func (UnimplementedAuthServiceHandler) ConsumeVerificationCode(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.ConsumeVerificationCode is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) LoginWithGoogle(context.Context, *connect.Request[v1.LoginWithGoogleRequest]) (*connect.Response[v1.LoginWithGoogleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.LoginWithGoogle is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RefreshAccessToken(context.Context, *connect.Request[v1.RefreshAccessTokenRequest]) (*connect.Response[v1.RefreshAccessTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.RefreshAccessToken is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) VerifyToken(context.Context, *connect.Request[v1.VerifyTokenRequest]) (*connect.Response[v1.VerifyTokenResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.VerifyToken is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.Logout is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.auth.v1.AuthService.RevokeAllSessions is not implemented"))
+}
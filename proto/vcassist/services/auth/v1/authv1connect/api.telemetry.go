@@ -97,6 +97,74 @@ func (c InstrumentedAuthServiceClient) ConsumeVerificationCode(ctx context.Conte
 	return res, nil
 }
 
+func (c InstrumentedAuthServiceClient) LoginWithGoogle(ctx context.Context, req *connect.Request[v1.LoginWithGoogleRequest]) (*connect.Response[v1.LoginWithGoogleResponse], error) {
+	ctx, span := AuthServiceTracer.Start(ctx, "LoginWithGoogle")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.LoginWithGoogle(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedAuthServiceClient) RefreshAccessToken(ctx context.Context, req *connect.Request[v1.RefreshAccessTokenRequest]) (*connect.Response[v1.RefreshAccessTokenResponse], error) {
+	ctx, span := AuthServiceTracer.Start(ctx, "RefreshAccessToken")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.RefreshAccessToken(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
 func (c InstrumentedAuthServiceClient) VerifyToken(ctx context.Context, req *connect.Request[v1.VerifyTokenRequest]) (*connect.Response[v1.VerifyTokenResponse], error) {
 	ctx, span := AuthServiceTracer.Start(ctx, "VerifyToken")
 	defer span.End()
@@ -131,3 +199,105 @@ func (c InstrumentedAuthServiceClient) VerifyToken(ctx context.Context, req *con
 	return res, nil
 }
 
+func (c InstrumentedAuthServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	ctx, span := AuthServiceTracer.Start(ctx, "ListSessions")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.ListSessions(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedAuthServiceClient) Logout(ctx context.Context, req *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	ctx, span := AuthServiceTracer.Start(ctx, "Logout")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.Logout(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedAuthServiceClient) RevokeAllSessions(ctx context.Context, req *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error) {
+	ctx, span := AuthServiceTracer.Start(ctx, "RevokeAllSessions")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.RevokeAllSessions(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts"
+// @generated from file vcassist/services/events/v1/api.proto (package vcassist.services.events.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetEventsRequest, GetEventsResponse } from "./api_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service vcassist.services.events.v1.EventsService
+ */
+export const EventsService = {
+  typeName: "vcassist.services.events.v1.EventsService",
+  methods: {
+    /**
+     * @generated from rpc vcassist.services.events.v1.EventsService.GetEvents
+     */
+    getEvents: {
+      name: "GetEvents",
+      I: GetEventsRequest,
+      O: GetEventsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,139 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file vcassist/services/events/v1/api.proto (package vcassist.services.events.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * GetEvents
+ *
+ * @generated from message vcassist.services.events.v1.Event
+ */
+export class Event extends Message<Event> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * unix timestamp of the start of the day the event takes place on
+   *
+   * @generated from field: int64 date = 2;
+   */
+  date = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Event>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.events.v1.Event";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "date", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Event {
+    return new Event().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Event | PlainMessage<Event> | undefined, b: Event | PlainMessage<Event> | undefined): boolean {
+    return proto3.util.equals(Event, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.events.v1.GetEventsRequest
+ */
+export class GetEventsRequest extends Message<GetEventsRequest> {
+  /**
+   * unix timestamp, inclusive
+   *
+   * @generated from field: int64 start = 1;
+   */
+  start = protoInt64.zero;
+
+  /**
+   * unix timestamp, exclusive
+   *
+   * @generated from field: int64 end = 2;
+   */
+  end = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.events.v1.GetEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventsRequest {
+    return new GetEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventsRequest {
+    return new GetEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventsRequest {
+    return new GetEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventsRequest | PlainMessage<GetEventsRequest> | undefined, b: GetEventsRequest | PlainMessage<GetEventsRequest> | undefined): boolean {
+    return proto3.util.equals(GetEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.events.v1.GetEventsResponse
+ */
+export class GetEventsResponse extends Message<GetEventsResponse> {
+  /**
+   * @generated from field: repeated vcassist.services.events.v1.Event events = 1;
+   */
+  events: Event[] = [];
+
+  constructor(data?: PartialMessage<GetEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.events.v1.GetEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: Event, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventsResponse {
+    return new GetEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventsResponse {
+    return new GetEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventsResponse {
+    return new GetEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventsResponse | PlainMessage<GetEventsResponse> | undefined, b: GetEventsResponse | PlainMessage<GetEventsResponse> | undefined): boolean {
+    return proto3.util.equals(GetEventsResponse, a, b);
+  }
+}
+
@@ -0,0 +1,65 @@
+package eventsv1connect
+
+import (
+	"context"
+
+	connect "connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+	v1 "vcassist-backend/proto/vcassist/services/events/v1"
+)
+
+type TracerLike interface {
+	Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span)
+}
+
+var (
+	EventsServiceTracer TracerLike = otel.Tracer("vcassist.services.events.v1.EventsService")
+)
+
+type InstrumentedEventsServiceClient struct {
+	inner EventsServiceClient
+	WithInputOutput bool
+}
+
+func NewInstrumentedEventsServiceClient(inner EventsServiceClient) InstrumentedEventsServiceClient {
+	return InstrumentedEventsServiceClient{inner: inner}
+}
+
+func (c InstrumentedEventsServiceClient) GetEvents(ctx context.Context, req *connect.Request[v1.GetEventsRequest]) (*connect.Response[v1.GetEventsResponse], error) {
+	ctx, span := EventsServiceTracer.Start(ctx, "GetEvents")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.GetEvents(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
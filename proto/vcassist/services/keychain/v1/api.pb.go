@@ -20,6 +20,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type KeyValidity int32
+
+const (
+	KeyValidity_KEY_UNKNOWN KeyValidity = 0
+	KeyValidity_KEY_VALID   KeyValidity = 1
+	KeyValidity_KEY_INVALID KeyValidity = 2
+)
+
+// Enum value maps for KeyValidity.
+var (
+	KeyValidity_name = map[int32]string{
+		0: "KEY_UNKNOWN",
+		1: "KEY_VALID",
+		2: "KEY_INVALID",
+	}
+	KeyValidity_value = map[string]int32{
+		"KEY_UNKNOWN": 0,
+		"KEY_VALID":   1,
+		"KEY_INVALID": 2,
+	}
+)
+
+func (x KeyValidity) Enum() *KeyValidity {
+	p := new(KeyValidity)
+	*p = x
+	return p
+}
+
+func (x KeyValidity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyValidity) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_keychain_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyValidity) Type() protoreflect.EnumType {
+	return &file_vcassist_services_keychain_v1_api_proto_enumTypes[0]
+}
+
+func (x KeyValidity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyValidity.Descriptor instead.
+func (KeyValidity) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
 type UsernamePasswordKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -75,6 +124,72 @@ func (x *UsernamePasswordKey) GetPassword() string {
 	return ""
 }
 
+// the result of the last time a key was used to login
+type KeyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validity KeyValidity `protobuf:"varint,1,opt,name=validity,proto3,enum=vcassist.services.keychain.v1.KeyValidity" json:"validity,omitempty"`
+	// unix timestamp, this is 0 if the key has never been checked
+	CheckedAt int64 `protobuf:"varint,2,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// why the key is invalid, this is empty if it isn't
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *KeyCheck) Reset() {
+	*x = KeyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyCheck) ProtoMessage() {}
+
+func (x *KeyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyCheck.ProtoReflect.Descriptor instead.
+func (*KeyCheck) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyCheck) GetValidity() KeyValidity {
+	if x != nil {
+		return x.Validity
+	}
+	return KeyValidity_KEY_UNKNOWN
+}
+
+func (x *KeyCheck) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *KeyCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type OAuthKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -89,7 +204,7 @@ type OAuthKey struct {
 func (x *OAuthKey) Reset() {
 	*x = OAuthKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[1]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -102,7 +217,7 @@ func (x *OAuthKey) String() string {
 func (*OAuthKey) ProtoMessage() {}
 
 func (x *OAuthKey) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[1]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,7 +230,7 @@ func (x *OAuthKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthKey.ProtoReflect.Descriptor instead.
 func (*OAuthKey) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{1}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OAuthKey) GetToken() string {
@@ -160,7 +275,7 @@ type SetOAuthRequest struct {
 func (x *SetOAuthRequest) Reset() {
 	*x = SetOAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[2]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -173,7 +288,7 @@ func (x *SetOAuthRequest) String() string {
 func (*SetOAuthRequest) ProtoMessage() {}
 
 func (x *SetOAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[2]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +301,7 @@ func (x *SetOAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetOAuthRequest.ProtoReflect.Descriptor instead.
 func (*SetOAuthRequest) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{2}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SetOAuthRequest) GetNamespace() string {
@@ -219,7 +334,7 @@ type SetOAuthResponse struct {
 func (x *SetOAuthResponse) Reset() {
 	*x = SetOAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[3]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +347,7 @@ func (x *SetOAuthResponse) String() string {
 func (*SetOAuthResponse) ProtoMessage() {}
 
 func (x *SetOAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[3]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +360,7 @@ func (x *SetOAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetOAuthResponse.ProtoReflect.Descriptor instead.
 func (*SetOAuthResponse) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{3}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{4}
 }
 
 // SetUsernamePassword
@@ -257,12 +372,15 @@ type SetUsernamePasswordRequest struct {
 	Namespace string               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Id        string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	Key       *UsernamePasswordKey `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// this is optional, it should be specified if the key was checked
+	// before it was set
+	Check *KeyCheck `protobuf:"bytes,4,opt,name=check,proto3" json:"check,omitempty"`
 }
 
 func (x *SetUsernamePasswordRequest) Reset() {
 	*x = SetUsernamePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[4]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -275,7 +393,7 @@ func (x *SetUsernamePasswordRequest) String() string {
 func (*SetUsernamePasswordRequest) ProtoMessage() {}
 
 func (x *SetUsernamePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[4]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +406,7 @@ func (x *SetUsernamePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetUsernamePasswordRequest.ProtoReflect.Descriptor instead.
 func (*SetUsernamePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{4}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetUsernamePasswordRequest) GetNamespace() string {
@@ -312,6 +430,13 @@ func (x *SetUsernamePasswordRequest) GetKey() *UsernamePasswordKey {
 	return nil
 }
 
+func (x *SetUsernamePasswordRequest) GetCheck() *KeyCheck {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
 type SetUsernamePasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -321,7 +446,7 @@ type SetUsernamePasswordResponse struct {
 func (x *SetUsernamePasswordResponse) Reset() {
 	*x = SetUsernamePasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[5]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +459,7 @@ func (x *SetUsernamePasswordResponse) String() string {
 func (*SetUsernamePasswordResponse) ProtoMessage() {}
 
 func (x *SetUsernamePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[5]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +472,109 @@ func (x *SetUsernamePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetUsernamePasswordResponse.ProtoReflect.Descriptor instead.
 func (*SetUsernamePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{5}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+// SetUsernamePasswordCheck
+type SetUsernamePasswordCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string    `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Check     *KeyCheck `protobuf:"bytes,3,opt,name=check,proto3" json:"check,omitempty"`
+}
+
+func (x *SetUsernamePasswordCheckRequest) Reset() {
+	*x = SetUsernamePasswordCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUsernamePasswordCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsernamePasswordCheckRequest) ProtoMessage() {}
+
+func (x *SetUsernamePasswordCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsernamePasswordCheckRequest.ProtoReflect.Descriptor instead.
+func (*SetUsernamePasswordCheckRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetUsernamePasswordCheckRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetUsernamePasswordCheckRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetUsernamePasswordCheckRequest) GetCheck() *KeyCheck {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+type SetUsernamePasswordCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUsernamePasswordCheckResponse) Reset() {
+	*x = SetUsernamePasswordCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUsernamePasswordCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsernamePasswordCheckResponse) ProtoMessage() {}
+
+func (x *SetUsernamePasswordCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsernamePasswordCheckResponse.ProtoReflect.Descriptor instead.
+func (*SetUsernamePasswordCheckResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{8}
 }
 
 // GetOAuth
@@ -363,7 +590,7 @@ type GetOAuthRequest struct {
 func (x *GetOAuthRequest) Reset() {
 	*x = GetOAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[6]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -376,7 +603,7 @@ func (x *GetOAuthRequest) String() string {
 func (*GetOAuthRequest) ProtoMessage() {}
 
 func (x *GetOAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[6]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +616,7 @@ func (x *GetOAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthRequest.ProtoReflect.Descriptor instead.
 func (*GetOAuthRequest) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{6}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetOAuthRequest) GetNamespace() string {
@@ -418,7 +645,7 @@ type GetOAuthResponse struct {
 func (x *GetOAuthResponse) Reset() {
 	*x = GetOAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[7]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +658,7 @@ func (x *GetOAuthResponse) String() string {
 func (*GetOAuthResponse) ProtoMessage() {}
 
 func (x *GetOAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[7]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +671,7 @@ func (x *GetOAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthResponse.ProtoReflect.Descriptor instead.
 func (*GetOAuthResponse) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{7}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetOAuthResponse) GetKey() *OAuthKey {
@@ -467,7 +694,7 @@ type GetUsernamePasswordRequest struct {
 func (x *GetUsernamePasswordRequest) Reset() {
 	*x = GetUsernamePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[8]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +707,7 @@ func (x *GetUsernamePasswordRequest) String() string {
 func (*GetUsernamePasswordRequest) ProtoMessage() {}
 
 func (x *GetUsernamePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[8]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +720,7 @@ func (x *GetUsernamePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsernamePasswordRequest.ProtoReflect.Descriptor instead.
 func (*GetUsernamePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{8}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUsernamePasswordRequest) GetNamespace() string {
@@ -517,12 +744,14 @@ type GetUsernamePasswordResponse struct {
 
 	// this will be null if a key cannot be found or is expired
 	Key *UsernamePasswordKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// this will be null if the key cannot be found
+	Check *KeyCheck `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
 }
 
 func (x *GetUsernamePasswordResponse) Reset() {
 	*x = GetUsernamePasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[9]
+		mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -535,7 +764,7 @@ func (x *GetUsernamePasswordResponse) String() string {
 func (*GetUsernamePasswordResponse) ProtoMessage() {}
 
 func (x *GetUsernamePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[9]
+	mi := &file_vcassist_services_keychain_v1_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +777,7 @@ func (x *GetUsernamePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsernamePasswordResponse.ProtoReflect.Descriptor instead.
 func (*GetUsernamePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{9}
+	return file_vcassist_services_keychain_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUsernamePasswordResponse) GetKey() *UsernamePasswordKey {
@@ -558,6 +787,13 @@ func (x *GetUsernamePasswordResponse) GetKey() *UsernamePasswordKey {
 	return nil
 }
 
+func (x *GetUsernamePasswordResponse) GetCheck() *KeyCheck {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
 var File_vcassist_services_keychain_v1_api_proto protoreflect.FileDescriptor
 
 var file_vcassist_services_keychain_v1_api_proto_rawDesc = []byte{
@@ -570,34 +806,58 @@ var file_vcassist_services_keychain_v1_api_proto_rawDesc = []byte{
 	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
 	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7d, 0x0a, 0x08, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4f, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x08, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x7a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x12,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x76,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4b, 0x65, 0x79,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x05, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x05, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f,
 	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
@@ -612,13 +872,21 @@ var file_vcassist_services_keychain_v1_api_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x32, 0x89, 0x04, 0x0a, 0x0f, 0x4b, 0x65,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2a, 0x3e, 0x0a, 0x0b, 0x4b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x45, 0x59,
+	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x32, 0xa7, 0x05, 0x0a, 0x0f, 0x4b, 0x65,
 	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a,
 	0x08, 0x53, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2e, 0x2e, 0x76, 0x63, 0x61, 0x73,
 	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65,
@@ -651,24 +919,34 @@ var file_vcassist_services_keychain_v1_api_proto_rawDesc = []byte{
 	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65,
 	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
 	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x85, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x63,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x65,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x53, 0x4b, 0xaa, 0x02,
-	0x1d, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x1d, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x5c, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x29, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x5c, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x56, 0x63, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a,
-	0x3a, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x3e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x85, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2d,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x65, 0x79, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x53, 0x4b, 0xaa, 0x02, 0x1d, 0x56,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x56,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x5c, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x56,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x5c, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x56, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a, 0x4b,
+	0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -683,37 +961,48 @@ func file_vcassist_services_keychain_v1_api_proto_rawDescGZIP() []byte {
 	return file_vcassist_services_keychain_v1_api_proto_rawDescData
 }
 
-var file_vcassist_services_keychain_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_vcassist_services_keychain_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vcassist_services_keychain_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_vcassist_services_keychain_v1_api_proto_goTypes = []any{
-	(*UsernamePasswordKey)(nil),         // 0: vcassist.services.keychain.v1.UsernamePasswordKey
-	(*OAuthKey)(nil),                    // 1: vcassist.services.keychain.v1.OAuthKey
-	(*SetOAuthRequest)(nil),             // 2: vcassist.services.keychain.v1.SetOAuthRequest
-	(*SetOAuthResponse)(nil),            // 3: vcassist.services.keychain.v1.SetOAuthResponse
-	(*SetUsernamePasswordRequest)(nil),  // 4: vcassist.services.keychain.v1.SetUsernamePasswordRequest
-	(*SetUsernamePasswordResponse)(nil), // 5: vcassist.services.keychain.v1.SetUsernamePasswordResponse
-	(*GetOAuthRequest)(nil),             // 6: vcassist.services.keychain.v1.GetOAuthRequest
-	(*GetOAuthResponse)(nil),            // 7: vcassist.services.keychain.v1.GetOAuthResponse
-	(*GetUsernamePasswordRequest)(nil),  // 8: vcassist.services.keychain.v1.GetUsernamePasswordRequest
-	(*GetUsernamePasswordResponse)(nil), // 9: vcassist.services.keychain.v1.GetUsernamePasswordResponse
+	(KeyValidity)(0),                         // 0: vcassist.services.keychain.v1.KeyValidity
+	(*UsernamePasswordKey)(nil),              // 1: vcassist.services.keychain.v1.UsernamePasswordKey
+	(*KeyCheck)(nil),                         // 2: vcassist.services.keychain.v1.KeyCheck
+	(*OAuthKey)(nil),                         // 3: vcassist.services.keychain.v1.OAuthKey
+	(*SetOAuthRequest)(nil),                  // 4: vcassist.services.keychain.v1.SetOAuthRequest
+	(*SetOAuthResponse)(nil),                 // 5: vcassist.services.keychain.v1.SetOAuthResponse
+	(*SetUsernamePasswordRequest)(nil),       // 6: vcassist.services.keychain.v1.SetUsernamePasswordRequest
+	(*SetUsernamePasswordResponse)(nil),      // 7: vcassist.services.keychain.v1.SetUsernamePasswordResponse
+	(*SetUsernamePasswordCheckRequest)(nil),  // 8: vcassist.services.keychain.v1.SetUsernamePasswordCheckRequest
+	(*SetUsernamePasswordCheckResponse)(nil), // 9: vcassist.services.keychain.v1.SetUsernamePasswordCheckResponse
+	(*GetOAuthRequest)(nil),                  // 10: vcassist.services.keychain.v1.GetOAuthRequest
+	(*GetOAuthResponse)(nil),                 // 11: vcassist.services.keychain.v1.GetOAuthResponse
+	(*GetUsernamePasswordRequest)(nil),       // 12: vcassist.services.keychain.v1.GetUsernamePasswordRequest
+	(*GetUsernamePasswordResponse)(nil),      // 13: vcassist.services.keychain.v1.GetUsernamePasswordResponse
 }
 var file_vcassist_services_keychain_v1_api_proto_depIdxs = []int32{
-	1, // 0: vcassist.services.keychain.v1.SetOAuthRequest.key:type_name -> vcassist.services.keychain.v1.OAuthKey
-	0, // 1: vcassist.services.keychain.v1.SetUsernamePasswordRequest.key:type_name -> vcassist.services.keychain.v1.UsernamePasswordKey
-	1, // 2: vcassist.services.keychain.v1.GetOAuthResponse.key:type_name -> vcassist.services.keychain.v1.OAuthKey
-	0, // 3: vcassist.services.keychain.v1.GetUsernamePasswordResponse.key:type_name -> vcassist.services.keychain.v1.UsernamePasswordKey
-	2, // 4: vcassist.services.keychain.v1.KeychainService.SetOAuth:input_type -> vcassist.services.keychain.v1.SetOAuthRequest
-	6, // 5: vcassist.services.keychain.v1.KeychainService.GetOAuth:input_type -> vcassist.services.keychain.v1.GetOAuthRequest
-	4, // 6: vcassist.services.keychain.v1.KeychainService.SetUsernamePassword:input_type -> vcassist.services.keychain.v1.SetUsernamePasswordRequest
-	8, // 7: vcassist.services.keychain.v1.KeychainService.GetUsernamePassword:input_type -> vcassist.services.keychain.v1.GetUsernamePasswordRequest
-	3, // 8: vcassist.services.keychain.v1.KeychainService.SetOAuth:output_type -> vcassist.services.keychain.v1.SetOAuthResponse
-	7, // 9: vcassist.services.keychain.v1.KeychainService.GetOAuth:output_type -> vcassist.services.keychain.v1.GetOAuthResponse
-	5, // 10: vcassist.services.keychain.v1.KeychainService.SetUsernamePassword:output_type -> vcassist.services.keychain.v1.SetUsernamePasswordResponse
-	9, // 11: vcassist.services.keychain.v1.KeychainService.GetUsernamePassword:output_type -> vcassist.services.keychain.v1.GetUsernamePasswordResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: vcassist.services.keychain.v1.KeyCheck.validity:type_name -> vcassist.services.keychain.v1.KeyValidity
+	3,  // 1: vcassist.services.keychain.v1.SetOAuthRequest.key:type_name -> vcassist.services.keychain.v1.OAuthKey
+	1,  // 2: vcassist.services.keychain.v1.SetUsernamePasswordRequest.key:type_name -> vcassist.services.keychain.v1.UsernamePasswordKey
+	2,  // 3: vcassist.services.keychain.v1.SetUsernamePasswordRequest.check:type_name -> vcassist.services.keychain.v1.KeyCheck
+	2,  // 4: vcassist.services.keychain.v1.SetUsernamePasswordCheckRequest.check:type_name -> vcassist.services.keychain.v1.KeyCheck
+	3,  // 5: vcassist.services.keychain.v1.GetOAuthResponse.key:type_name -> vcassist.services.keychain.v1.OAuthKey
+	1,  // 6: vcassist.services.keychain.v1.GetUsernamePasswordResponse.key:type_name -> vcassist.services.keychain.v1.UsernamePasswordKey
+	2,  // 7: vcassist.services.keychain.v1.GetUsernamePasswordResponse.check:type_name -> vcassist.services.keychain.v1.KeyCheck
+	4,  // 8: vcassist.services.keychain.v1.KeychainService.SetOAuth:input_type -> vcassist.services.keychain.v1.SetOAuthRequest
+	10, // 9: vcassist.services.keychain.v1.KeychainService.GetOAuth:input_type -> vcassist.services.keychain.v1.GetOAuthRequest
+	6,  // 10: vcassist.services.keychain.v1.KeychainService.SetUsernamePassword:input_type -> vcassist.services.keychain.v1.SetUsernamePasswordRequest
+	12, // 11: vcassist.services.keychain.v1.KeychainService.GetUsernamePassword:input_type -> vcassist.services.keychain.v1.GetUsernamePasswordRequest
+	8,  // 12: vcassist.services.keychain.v1.KeychainService.SetUsernamePasswordCheck:input_type -> vcassist.services.keychain.v1.SetUsernamePasswordCheckRequest
+	5,  // 13: vcassist.services.keychain.v1.KeychainService.SetOAuth:output_type -> vcassist.services.keychain.v1.SetOAuthResponse
+	11, // 14: vcassist.services.keychain.v1.KeychainService.GetOAuth:output_type -> vcassist.services.keychain.v1.GetOAuthResponse
+	7,  // 15: vcassist.services.keychain.v1.KeychainService.SetUsernamePassword:output_type -> vcassist.services.keychain.v1.SetUsernamePasswordResponse
+	13, // 16: vcassist.services.keychain.v1.KeychainService.GetUsernamePassword:output_type -> vcassist.services.keychain.v1.GetUsernamePasswordResponse
+	9,  // 17: vcassist.services.keychain.v1.KeychainService.SetUsernamePasswordCheck:output_type -> vcassist.services.keychain.v1.SetUsernamePasswordCheckResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_vcassist_services_keychain_v1_api_proto_init() }
@@ -735,7 +1024,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*OAuthKey); i {
+			switch v := v.(*KeyCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -747,7 +1036,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SetOAuthRequest); i {
+			switch v := v.(*OAuthKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -759,7 +1048,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*SetOAuthResponse); i {
+			switch v := v.(*SetOAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -771,7 +1060,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*SetUsernamePasswordRequest); i {
+			switch v := v.(*SetOAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -783,7 +1072,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*SetUsernamePasswordResponse); i {
+			switch v := v.(*SetUsernamePasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -795,7 +1084,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOAuthRequest); i {
+			switch v := v.(*SetUsernamePasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -807,7 +1096,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOAuthResponse); i {
+			switch v := v.(*SetUsernamePasswordCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -819,7 +1108,7 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUsernamePasswordRequest); i {
+			switch v := v.(*SetUsernamePasswordCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -831,6 +1120,42 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_keychain_v1_api_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_keychain_v1_api_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOAuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_keychain_v1_api_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsernamePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_keychain_v1_api_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*GetUsernamePasswordResponse); i {
 			case 0:
 				return &v.state
@@ -848,13 +1173,14 @@ func file_vcassist_services_keychain_v1_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vcassist_services_keychain_v1_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_vcassist_services_keychain_v1_api_proto_goTypes,
 		DependencyIndexes: file_vcassist_services_keychain_v1_api_proto_depIdxs,
+		EnumInfos:         file_vcassist_services_keychain_v1_api_proto_enumTypes,
 		MessageInfos:      file_vcassist_services_keychain_v1_api_proto_msgTypes,
 	}.Build()
 	File_vcassist_services_keychain_v1_api_proto = out.File
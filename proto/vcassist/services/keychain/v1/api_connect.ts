@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetOAuthRequest, GetOAuthResponse, GetUsernamePasswordRequest, GetUsernamePasswordResponse, SetOAuthRequest, SetOAuthResponse, SetUsernamePasswordRequest, SetUsernamePasswordResponse } from "./api_pb.js";
+import { GetOAuthRequest, GetOAuthResponse, GetUsernamePasswordRequest, GetUsernamePasswordResponse, SetOAuthRequest, SetOAuthResponse, SetUsernamePasswordCheckRequest, SetUsernamePasswordCheckResponse, SetUsernamePasswordRequest, SetUsernamePasswordResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -48,6 +48,18 @@ export const KeychainService = {
       O: GetUsernamePasswordResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * SetUsernamePasswordCheck records the result of using a key to login,
+     * it returns NotFound if the key does not exist
+     *
+     * @generated from rpc vcassist.services.keychain.v1.KeychainService.SetUsernamePasswordCheck
+     */
+    setUsernamePasswordCheck: {
+      name: "SetUsernamePasswordCheck",
+      I: SetUsernamePasswordCheckRequest,
+      O: SetUsernamePasswordCheckResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
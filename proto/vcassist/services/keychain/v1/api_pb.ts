@@ -6,6 +6,32 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum vcassist.services.keychain.v1.KeyValidity
+ */
+export enum KeyValidity {
+  /**
+   * @generated from enum value: KEY_UNKNOWN = 0;
+   */
+  KEY_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: KEY_VALID = 1;
+   */
+  KEY_VALID = 1,
+
+  /**
+   * @generated from enum value: KEY_INVALID = 2;
+   */
+  KEY_INVALID = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(KeyValidity)
+proto3.util.setEnumType(KeyValidity, "vcassist.services.keychain.v1.KeyValidity", [
+  { no: 0, name: "KEY_UNKNOWN" },
+  { no: 1, name: "KEY_VALID" },
+  { no: 2, name: "KEY_INVALID" },
+]);
+
 /**
  * @generated from message vcassist.services.keychain.v1.UsernamePasswordKey
  */
@@ -49,6 +75,61 @@ export class UsernamePasswordKey extends Message<UsernamePasswordKey> {
   }
 }
 
+/**
+ * the result of the last time a key was used to login
+ *
+ * @generated from message vcassist.services.keychain.v1.KeyCheck
+ */
+export class KeyCheck extends Message<KeyCheck> {
+  /**
+   * @generated from field: vcassist.services.keychain.v1.KeyValidity validity = 1;
+   */
+  validity = KeyValidity.KEY_UNKNOWN;
+
+  /**
+   * unix timestamp, this is 0 if the key has never been checked
+   *
+   * @generated from field: int64 checked_at = 2;
+   */
+  checkedAt = protoInt64.zero;
+
+  /**
+   * why the key is invalid, this is empty if it isn't
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<KeyCheck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.keychain.v1.KeyCheck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "validity", kind: "enum", T: proto3.getEnumType(KeyValidity) },
+    { no: 2, name: "checked_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeyCheck {
+    return new KeyCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeyCheck {
+    return new KeyCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeyCheck {
+    return new KeyCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KeyCheck | PlainMessage<KeyCheck> | undefined, b: KeyCheck | PlainMessage<KeyCheck> | undefined): boolean {
+    return proto3.util.equals(KeyCheck, a, b);
+  }
+}
+
 /**
  * @generated from message vcassist.services.keychain.v1.OAuthKey
  */
@@ -207,6 +288,14 @@ export class SetUsernamePasswordRequest extends Message<SetUsernamePasswordReque
    */
   key?: UsernamePasswordKey;
 
+  /**
+   * this is optional, it should be specified if the key was checked
+   * before it was set
+   *
+   * @generated from field: vcassist.services.keychain.v1.KeyCheck check = 4;
+   */
+  check?: KeyCheck;
+
   constructor(data?: PartialMessage<SetUsernamePasswordRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -218,6 +307,7 @@ export class SetUsernamePasswordRequest extends Message<SetUsernamePasswordReque
     { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "key", kind: "message", T: UsernamePasswordKey },
+    { no: 4, name: "check", kind: "message", T: KeyCheck },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUsernamePasswordRequest {
@@ -268,6 +358,88 @@ export class SetUsernamePasswordResponse extends Message<SetUsernamePasswordResp
   }
 }
 
+/**
+ * SetUsernamePasswordCheck
+ *
+ * @generated from message vcassist.services.keychain.v1.SetUsernamePasswordCheckRequest
+ */
+export class SetUsernamePasswordCheckRequest extends Message<SetUsernamePasswordCheckRequest> {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id = "";
+
+  /**
+   * @generated from field: vcassist.services.keychain.v1.KeyCheck check = 3;
+   */
+  check?: KeyCheck;
+
+  constructor(data?: PartialMessage<SetUsernamePasswordCheckRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.keychain.v1.SetUsernamePasswordCheckRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "check", kind: "message", T: KeyCheck },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUsernamePasswordCheckRequest {
+    return new SetUsernamePasswordCheckRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUsernamePasswordCheckRequest {
+    return new SetUsernamePasswordCheckRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUsernamePasswordCheckRequest {
+    return new SetUsernamePasswordCheckRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUsernamePasswordCheckRequest | PlainMessage<SetUsernamePasswordCheckRequest> | undefined, b: SetUsernamePasswordCheckRequest | PlainMessage<SetUsernamePasswordCheckRequest> | undefined): boolean {
+    return proto3.util.equals(SetUsernamePasswordCheckRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.keychain.v1.SetUsernamePasswordCheckResponse
+ */
+export class SetUsernamePasswordCheckResponse extends Message<SetUsernamePasswordCheckResponse> {
+  constructor(data?: PartialMessage<SetUsernamePasswordCheckResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.keychain.v1.SetUsernamePasswordCheckResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUsernamePasswordCheckResponse {
+    return new SetUsernamePasswordCheckResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUsernamePasswordCheckResponse {
+    return new SetUsernamePasswordCheckResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUsernamePasswordCheckResponse {
+    return new SetUsernamePasswordCheckResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUsernamePasswordCheckResponse | PlainMessage<SetUsernamePasswordCheckResponse> | undefined, b: SetUsernamePasswordCheckResponse | PlainMessage<SetUsernamePasswordCheckResponse> | undefined): boolean {
+    return proto3.util.equals(SetUsernamePasswordCheckResponse, a, b);
+  }
+}
+
 /**
  * GetOAuth
  *
@@ -408,6 +580,13 @@ export class GetUsernamePasswordResponse extends Message<GetUsernamePasswordResp
    */
   key?: UsernamePasswordKey;
 
+  /**
+   * this will be null if the key cannot be found
+   *
+   * @generated from field: vcassist.services.keychain.v1.KeyCheck check = 2;
+   */
+  check?: KeyCheck;
+
   constructor(data?: PartialMessage<GetUsernamePasswordResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -417,6 +596,7 @@ export class GetUsernamePasswordResponse extends Message<GetUsernamePasswordResp
   static readonly typeName = "vcassist.services.keychain.v1.GetUsernamePasswordResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "message", T: UsernamePasswordKey },
+    { no: 2, name: "check", kind: "message", T: KeyCheck },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsernamePasswordResponse {
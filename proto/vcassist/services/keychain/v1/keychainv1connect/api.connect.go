@@ -45,15 +45,19 @@ const (
 	// KeychainServiceGetUsernamePasswordProcedure is the fully-qualified name of the KeychainService's
 	// GetUsernamePassword RPC.
 	KeychainServiceGetUsernamePasswordProcedure = "/vcassist.services.keychain.v1.KeychainService/GetUsernamePassword"
+	// KeychainServiceSetUsernamePasswordCheckProcedure is the fully-qualified name of the
+	// KeychainService's SetUsernamePasswordCheck RPC.
+	KeychainServiceSetUsernamePasswordCheckProcedure = "/vcassist.services.keychain.v1.KeychainService/SetUsernamePasswordCheck"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	keychainServiceServiceDescriptor                   = v1.File_vcassist_services_keychain_v1_api_proto.Services().ByName("KeychainService")
-	keychainServiceSetOAuthMethodDescriptor            = keychainServiceServiceDescriptor.Methods().ByName("SetOAuth")
-	keychainServiceGetOAuthMethodDescriptor            = keychainServiceServiceDescriptor.Methods().ByName("GetOAuth")
-	keychainServiceSetUsernamePasswordMethodDescriptor = keychainServiceServiceDescriptor.Methods().ByName("SetUsernamePassword")
-	keychainServiceGetUsernamePasswordMethodDescriptor = keychainServiceServiceDescriptor.Methods().ByName("GetUsernamePassword")
+	keychainServiceServiceDescriptor                        = v1.File_vcassist_services_keychain_v1_api_proto.Services().ByName("KeychainService")
+	keychainServiceSetOAuthMethodDescriptor                 = keychainServiceServiceDescriptor.Methods().ByName("SetOAuth")
+	keychainServiceGetOAuthMethodDescriptor                 = keychainServiceServiceDescriptor.Methods().ByName("GetOAuth")
+	keychainServiceSetUsernamePasswordMethodDescriptor      = keychainServiceServiceDescriptor.Methods().ByName("SetUsernamePassword")
+	keychainServiceGetUsernamePasswordMethodDescriptor      = keychainServiceServiceDescriptor.Methods().ByName("GetUsernamePassword")
+	keychainServiceSetUsernamePasswordCheckMethodDescriptor = keychainServiceServiceDescriptor.Methods().ByName("SetUsernamePasswordCheck")
 )
 
 // KeychainServiceClient is a client for the vcassist.services.keychain.v1.KeychainService service.
@@ -62,6 +66,9 @@ type KeychainServiceClient interface {
 	GetOAuth(context.Context, *connect.Request[v1.GetOAuthRequest]) (*connect.Response[v1.GetOAuthResponse], error)
 	SetUsernamePassword(context.Context, *connect.Request[v1.SetUsernamePasswordRequest]) (*connect.Response[v1.SetUsernamePasswordResponse], error)
 	GetUsernamePassword(context.Context, *connect.Request[v1.GetUsernamePasswordRequest]) (*connect.Response[v1.GetUsernamePasswordResponse], error)
+	// SetUsernamePasswordCheck records the result of using a key to login,
+	// it returns NotFound if the key does not exist
+	SetUsernamePasswordCheck(context.Context, *connect.Request[v1.SetUsernamePasswordCheckRequest]) (*connect.Response[v1.SetUsernamePasswordCheckResponse], error)
 }
 
 // NewKeychainServiceClient constructs a client for the
@@ -99,15 +106,22 @@ func NewKeychainServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(keychainServiceGetUsernamePasswordMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setUsernamePasswordCheck: connect.NewClient[v1.SetUsernamePasswordCheckRequest, v1.SetUsernamePasswordCheckResponse](
+			httpClient,
+			baseURL+KeychainServiceSetUsernamePasswordCheckProcedure,
+			connect.WithSchema(keychainServiceSetUsernamePasswordCheckMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // keychainServiceClient implements KeychainServiceClient.
 type keychainServiceClient struct {
-	setOAuth            *connect.Client[v1.SetOAuthRequest, v1.SetOAuthResponse]
-	getOAuth            *connect.Client[v1.GetOAuthRequest, v1.GetOAuthResponse]
-	setUsernamePassword *connect.Client[v1.SetUsernamePasswordRequest, v1.SetUsernamePasswordResponse]
-	getUsernamePassword *connect.Client[v1.GetUsernamePasswordRequest, v1.GetUsernamePasswordResponse]
+	setOAuth                 *connect.Client[v1.SetOAuthRequest, v1.SetOAuthResponse]
+	getOAuth                 *connect.Client[v1.GetOAuthRequest, v1.GetOAuthResponse]
+	setUsernamePassword      *connect.Client[v1.SetUsernamePasswordRequest, v1.SetUsernamePasswordResponse]
+	getUsernamePassword      *connect.Client[v1.GetUsernamePasswordRequest, v1.GetUsernamePasswordResponse]
+	setUsernamePasswordCheck *connect.Client[v1.SetUsernamePasswordCheckRequest, v1.SetUsernamePasswordCheckResponse]
 }
 
 // SetOAuth calls vcassist.services.keychain.v1.KeychainService.SetOAuth.
@@ -130,6 +144,12 @@ func (c *keychainServiceClient) GetUsernamePassword(ctx context.Context, req *co
 	return c.getUsernamePassword.CallUnary(ctx, req)
 }
 
+// SetUsernamePasswordCheck calls
+// vcassist.services.keychain.v1.KeychainService.SetUsernamePasswordCheck.
+func (c *keychainServiceClient) SetUsernamePasswordCheck(ctx context.Context, req *connect.Request[v1.SetUsernamePasswordCheckRequest]) (*connect.Response[v1.SetUsernamePasswordCheckResponse], error) {
+	return c.setUsernamePasswordCheck.CallUnary(ctx, req)
+}
+
 // KeychainServiceHandler is an implementation of the vcassist.services.keychain.v1.KeychainService
 // service.
 type KeychainServiceHandler interface {
@@ -137,6 +157,9 @@ type KeychainServiceHandler interface {
 	GetOAuth(context.Context, *connect.Request[v1.GetOAuthRequest]) (*connect.Response[v1.GetOAuthResponse], error)
 	SetUsernamePassword(context.Context, *connect.Request[v1.SetUsernamePasswordRequest]) (*connect.Response[v1.SetUsernamePasswordResponse], error)
 	GetUsernamePassword(context.Context, *connect.Request[v1.GetUsernamePasswordRequest]) (*connect.Response[v1.GetUsernamePasswordResponse], error)
+	// SetUsernamePasswordCheck records the result of using a key to login,
+	// it returns NotFound if the key does not exist
+	SetUsernamePasswordCheck(context.Context, *connect.Request[v1.SetUsernamePasswordCheckRequest]) (*connect.Response[v1.SetUsernamePasswordCheckResponse], error)
 }
 
 // NewKeychainServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -169,6 +192,12 @@ func NewKeychainServiceHandler(svc KeychainServiceHandler, opts ...connect.Handl
 		connect.WithSchema(keychainServiceGetUsernamePasswordMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	keychainServiceSetUsernamePasswordCheckHandler := connect.NewUnaryHandler(
+		KeychainServiceSetUsernamePasswordCheckProcedure,
+		svc.SetUsernamePasswordCheck,
+		connect.WithSchema(keychainServiceSetUsernamePasswordCheckMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/vcassist.services.keychain.v1.KeychainService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KeychainServiceSetOAuthProcedure:
@@ -179,6 +208,8 @@ func NewKeychainServiceHandler(svc KeychainServiceHandler, opts ...connect.Handl
 			keychainServiceSetUsernamePasswordHandler.ServeHTTP(w, r)
 		case KeychainServiceGetUsernamePasswordProcedure:
 			keychainServiceGetUsernamePasswordHandler.ServeHTTP(w, r)
+		case KeychainServiceSetUsernamePasswordCheckProcedure:
+			keychainServiceSetUsernamePasswordCheckHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -203,3 +234,7 @@ func (UnimplementedKeychainServiceHandler) SetUsernamePassword(context.Context,
 func (UnimplementedKeychainServiceHandler) GetUsernamePassword(context.Context, *connect.Request[v1.GetUsernamePasswordRequest]) (*connect.Response[v1.GetUsernamePasswordResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.keychain.v1.KeychainService.GetUsernamePassword is not implemented"))
 }
+
+func (UnimplementedKeychainServiceHandler) SetUsernamePasswordCheck(context.Context, *connect.Request[v1.SetUsernamePasswordCheckRequest]) (*connect.Response[v1.SetUsernamePasswordCheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.keychain.v1.KeychainService.SetUsernamePasswordCheck is not implemented"))
+}
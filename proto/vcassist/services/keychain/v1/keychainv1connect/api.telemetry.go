@@ -165,3 +165,37 @@ func (c InstrumentedKeychainServiceClient) GetUsernamePassword(ctx context.Conte
 	return res, nil
 }
 
+func (c InstrumentedKeychainServiceClient) SetUsernamePasswordCheck(ctx context.Context, req *connect.Request[v1.SetUsernamePasswordCheckRequest]) (*connect.Response[v1.SetUsernamePasswordCheckResponse], error) {
+	ctx, span := KeychainServiceTracer.Start(ctx, "SetUsernamePasswordCheck")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.SetUsernamePasswordCheck(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
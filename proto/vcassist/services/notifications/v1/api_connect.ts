@@ -0,0 +1,71 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts"
+// @generated from file vcassist/services/notifications/v1/api.proto (package vcassist.services.notifications.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetPreferencesRequest, GetPreferencesResponse, GetPushConfigRequest, GetPushConfigResponse, ListDevicesRequest, ListDevicesResponse, RegisterDeviceRequest, RegisterDeviceResponse, SetPreferencesRequest, SetPreferencesResponse, UnregisterDeviceRequest, UnregisterDeviceResponse } from "./api_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service vcassist.services.notifications.v1.NotificationsService
+ */
+export const NotificationsService = {
+  typeName: "vcassist.services.notifications.v1.NotificationsService",
+  methods: {
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.RegisterDevice
+     */
+    registerDevice: {
+      name: "RegisterDevice",
+      I: RegisterDeviceRequest,
+      O: RegisterDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.UnregisterDevice
+     */
+    unregisterDevice: {
+      name: "UnregisterDevice",
+      I: UnregisterDeviceRequest,
+      O: UnregisterDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.ListDevices
+     */
+    listDevices: {
+      name: "ListDevices",
+      I: ListDevicesRequest,
+      O: ListDevicesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.GetPushConfig
+     */
+    getPushConfig: {
+      name: "GetPushConfig",
+      I: GetPushConfigRequest,
+      O: GetPushConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.GetPreferences
+     */
+    getPreferences: {
+      name: "GetPreferences",
+      I: GetPreferencesRequest,
+      O: GetPreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.notifications.v1.NotificationsService.SetPreferences
+     */
+    setPreferences: {
+      name: "SetPreferences",
+      I: SetPreferencesRequest,
+      O: SetPreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,567 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file vcassist/services/notifications/v1/api.proto (package vcassist.services.notifications.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * RegisterDevice
+ * a web push subscription, this is the same as the PushSubscription
+ * object returned by the browser
+ *
+ * @generated from message vcassist.services.notifications.v1.Device
+ */
+export class Device extends Message<Device> {
+  /**
+   * @generated from field: string endpoint = 1;
+   */
+  endpoint = "";
+
+  /**
+   * base64url encoded P-256 public key of the subscription
+   *
+   * @generated from field: string p256dh = 2;
+   */
+  p256dh = "";
+
+  /**
+   * base64url encoded authentication secret of the subscription
+   *
+   * @generated from field: string auth = 3;
+   */
+  auth = "";
+
+  constructor(data?: PartialMessage<Device>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.Device";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "p256dh", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "auth", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Device {
+    return new Device().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Device {
+    return new Device().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Device {
+    return new Device().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Device | PlainMessage<Device> | undefined, b: Device | PlainMessage<Device> | undefined): boolean {
+    return proto3.util.equals(Device, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.RegisterDeviceRequest
+ */
+export class RegisterDeviceRequest extends Message<RegisterDeviceRequest> {
+  /**
+   * @generated from field: vcassist.services.notifications.v1.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<RegisterDeviceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.RegisterDeviceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterDeviceRequest | PlainMessage<RegisterDeviceRequest> | undefined, b: RegisterDeviceRequest | PlainMessage<RegisterDeviceRequest> | undefined): boolean {
+    return proto3.util.equals(RegisterDeviceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.RegisterDeviceResponse
+ */
+export class RegisterDeviceResponse extends Message<RegisterDeviceResponse> {
+  constructor(data?: PartialMessage<RegisterDeviceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.RegisterDeviceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterDeviceResponse | PlainMessage<RegisterDeviceResponse> | undefined, b: RegisterDeviceResponse | PlainMessage<RegisterDeviceResponse> | undefined): boolean {
+    return proto3.util.equals(RegisterDeviceResponse, a, b);
+  }
+}
+
+/**
+ * UnregisterDevice
+ *
+ * @generated from message vcassist.services.notifications.v1.UnregisterDeviceRequest
+ */
+export class UnregisterDeviceRequest extends Message<UnregisterDeviceRequest> {
+  /**
+   * @generated from field: string endpoint = 1;
+   */
+  endpoint = "";
+
+  constructor(data?: PartialMessage<UnregisterDeviceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.UnregisterDeviceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnregisterDeviceRequest | PlainMessage<UnregisterDeviceRequest> | undefined, b: UnregisterDeviceRequest | PlainMessage<UnregisterDeviceRequest> | undefined): boolean {
+    return proto3.util.equals(UnregisterDeviceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.UnregisterDeviceResponse
+ */
+export class UnregisterDeviceResponse extends Message<UnregisterDeviceResponse> {
+  constructor(data?: PartialMessage<UnregisterDeviceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.UnregisterDeviceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnregisterDeviceResponse | PlainMessage<UnregisterDeviceResponse> | undefined, b: UnregisterDeviceResponse | PlainMessage<UnregisterDeviceResponse> | undefined): boolean {
+    return proto3.util.equals(UnregisterDeviceResponse, a, b);
+  }
+}
+
+/**
+ * ListDevices
+ *
+ * @generated from message vcassist.services.notifications.v1.ListDevicesRequest
+ */
+export class ListDevicesRequest extends Message<ListDevicesRequest> {
+  constructor(data?: PartialMessage<ListDevicesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.ListDevicesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDevicesRequest | PlainMessage<ListDevicesRequest> | undefined, b: ListDevicesRequest | PlainMessage<ListDevicesRequest> | undefined): boolean {
+    return proto3.util.equals(ListDevicesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.ListDevicesResponse
+ */
+export class ListDevicesResponse extends Message<ListDevicesResponse> {
+  /**
+   * @generated from field: repeated vcassist.services.notifications.v1.Device devices = 1;
+   */
+  devices: Device[] = [];
+
+  constructor(data?: PartialMessage<ListDevicesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.ListDevicesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "devices", kind: "message", T: Device, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDevicesResponse | PlainMessage<ListDevicesResponse> | undefined, b: ListDevicesResponse | PlainMessage<ListDevicesResponse> | undefined): boolean {
+    return proto3.util.equals(ListDevicesResponse, a, b);
+  }
+}
+
+/**
+ * GetPushConfig
+ *
+ * @generated from message vcassist.services.notifications.v1.GetPushConfigRequest
+ */
+export class GetPushConfigRequest extends Message<GetPushConfigRequest> {
+  constructor(data?: PartialMessage<GetPushConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.GetPushConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPushConfigRequest {
+    return new GetPushConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPushConfigRequest {
+    return new GetPushConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPushConfigRequest {
+    return new GetPushConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPushConfigRequest | PlainMessage<GetPushConfigRequest> | undefined, b: GetPushConfigRequest | PlainMessage<GetPushConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GetPushConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.GetPushConfigResponse
+ */
+export class GetPushConfigResponse extends Message<GetPushConfigResponse> {
+  /**
+   * base64url encoded VAPID public key, this should be passed as the
+   * applicationServerKey when subscribing, it is empty if web push is
+   * disabled
+   *
+   * @generated from field: string vapid_public_key = 1;
+   */
+  vapidPublicKey = "";
+
+  constructor(data?: PartialMessage<GetPushConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.GetPushConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "vapid_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPushConfigResponse {
+    return new GetPushConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPushConfigResponse {
+    return new GetPushConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPushConfigResponse {
+    return new GetPushConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPushConfigResponse | PlainMessage<GetPushConfigResponse> | undefined, b: GetPushConfigResponse | PlainMessage<GetPushConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetPushConfigResponse, a, b);
+  }
+}
+
+/**
+ * GetPreferences
+ *
+ * @generated from message vcassist.services.notifications.v1.Preferences
+ */
+export class Preferences extends Message<Preferences> {
+  /**
+   * send notifications to registered devices as they happen
+   *
+   * @generated from field: bool push_enabled = 1;
+   */
+  pushEnabled = false;
+
+  /**
+   * send a daily email with all the notifications of the day
+   *
+   * @generated from field: bool email_digest = 2;
+   */
+  emailDigest = false;
+
+  /**
+   * @generated from field: bool new_assignments = 3;
+   */
+  newAssignments = false;
+
+  /**
+   * @generated from field: bool scores_posted = 4;
+   */
+  scoresPosted = false;
+
+  /**
+   * @generated from field: bool missing_assignments = 5;
+   */
+  missingAssignments = false;
+
+  /**
+   * @generated from field: bool late_assignments = 6;
+   */
+  lateAssignments = false;
+
+  /**
+   * overall grade changes are only sent when the grade drops by more than
+   * this many percentage points, a negative value disables them
+   *
+   * @generated from field: float grade_drop_threshold = 7;
+   */
+  gradeDropThreshold = 0;
+
+  constructor(data?: PartialMessage<Preferences>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.Preferences";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "push_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "email_digest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "new_assignments", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "scores_posted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "missing_assignments", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "late_assignments", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "grade_drop_threshold", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Preferences {
+    return new Preferences().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Preferences {
+    return new Preferences().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Preferences {
+    return new Preferences().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Preferences | PlainMessage<Preferences> | undefined, b: Preferences | PlainMessage<Preferences> | undefined): boolean {
+    return proto3.util.equals(Preferences, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.GetPreferencesRequest
+ */
+export class GetPreferencesRequest extends Message<GetPreferencesRequest> {
+  constructor(data?: PartialMessage<GetPreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.GetPreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPreferencesRequest | PlainMessage<GetPreferencesRequest> | undefined, b: GetPreferencesRequest | PlainMessage<GetPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(GetPreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.GetPreferencesResponse
+ */
+export class GetPreferencesResponse extends Message<GetPreferencesResponse> {
+  /**
+   * @generated from field: vcassist.services.notifications.v1.Preferences preferences = 1;
+   */
+  preferences?: Preferences;
+
+  constructor(data?: PartialMessage<GetPreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.GetPreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: Preferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPreferencesResponse | PlainMessage<GetPreferencesResponse> | undefined, b: GetPreferencesResponse | PlainMessage<GetPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(GetPreferencesResponse, a, b);
+  }
+}
+
+/**
+ * SetPreferences
+ *
+ * @generated from message vcassist.services.notifications.v1.SetPreferencesRequest
+ */
+export class SetPreferencesRequest extends Message<SetPreferencesRequest> {
+  /**
+   * @generated from field: vcassist.services.notifications.v1.Preferences preferences = 1;
+   */
+  preferences?: Preferences;
+
+  constructor(data?: PartialMessage<SetPreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.SetPreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: Preferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPreferencesRequest {
+    return new SetPreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPreferencesRequest {
+    return new SetPreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPreferencesRequest {
+    return new SetPreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPreferencesRequest | PlainMessage<SetPreferencesRequest> | undefined, b: SetPreferencesRequest | PlainMessage<SetPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(SetPreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.notifications.v1.SetPreferencesResponse
+ */
+export class SetPreferencesResponse extends Message<SetPreferencesResponse> {
+  constructor(data?: PartialMessage<SetPreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.notifications.v1.SetPreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPreferencesResponse {
+    return new SetPreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPreferencesResponse {
+    return new SetPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPreferencesResponse {
+    return new SetPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPreferencesResponse | PlainMessage<SetPreferencesResponse> | undefined, b: SetPreferencesResponse | PlainMessage<SetPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(SetPreferencesResponse, a, b);
+  }
+}
+
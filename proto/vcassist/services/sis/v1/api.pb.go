@@ -21,6 +21,201 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RefreshDataStream
+type RefreshPhase int32
+
+const (
+	// fetching the student's powerschool credentials from the keychain
+	RefreshPhase_PHASE_KEYCHAIN RefreshPhase = 0
+	// logging into powerschool
+	RefreshPhase_PHASE_LOGIN RefreshPhase = 1
+	// fetching the student's profile
+	RefreshPhase_PHASE_GET_ALL_STUDENTS RefreshPhase = 2
+	// fetching courses, assignments and grades
+	RefreshPhase_PHASE_GET_STUDENT_DATA RefreshPhase = 3
+	// fetching the course meetings for the current week
+	RefreshPhase_PHASE_GET_COURSE_MEETINGS RefreshPhase = 4
+	// adding historical grade snapshots
+	RefreshPhase_PHASE_GRADE_SNAPSHOTS RefreshPhase = 5
+	// linking courses to their assignment category weights
+	RefreshPhase_PHASE_LINK_WEIGHTS RefreshPhase = 6
+	// saving the new data
+	RefreshPhase_PHASE_CACHE RefreshPhase = 7
+)
+
+// Enum value maps for RefreshPhase.
+var (
+	RefreshPhase_name = map[int32]string{
+		0: "PHASE_KEYCHAIN",
+		1: "PHASE_LOGIN",
+		2: "PHASE_GET_ALL_STUDENTS",
+		3: "PHASE_GET_STUDENT_DATA",
+		4: "PHASE_GET_COURSE_MEETINGS",
+		5: "PHASE_GRADE_SNAPSHOTS",
+		6: "PHASE_LINK_WEIGHTS",
+		7: "PHASE_CACHE",
+	}
+	RefreshPhase_value = map[string]int32{
+		"PHASE_KEYCHAIN":            0,
+		"PHASE_LOGIN":               1,
+		"PHASE_GET_ALL_STUDENTS":    2,
+		"PHASE_GET_STUDENT_DATA":    3,
+		"PHASE_GET_COURSE_MEETINGS": 4,
+		"PHASE_GRADE_SNAPSHOTS":     5,
+		"PHASE_LINK_WEIGHTS":        6,
+		"PHASE_CACHE":               7,
+	}
+)
+
+func (x RefreshPhase) Enum() *RefreshPhase {
+	p := new(RefreshPhase)
+	*p = x
+	return p
+}
+
+func (x RefreshPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_sis_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (RefreshPhase) Type() protoreflect.EnumType {
+	return &file_vcassist_services_sis_v1_api_proto_enumTypes[0]
+}
+
+func (x RefreshPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshPhase.Descriptor instead.
+func (RefreshPhase) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type PhaseStatus int32
+
+const (
+	PhaseStatus_STATUS_STARTED   PhaseStatus = 0
+	PhaseStatus_STATUS_COMPLETED PhaseStatus = 1
+	// some phases (ex. PHASE_GET_COURSE_MEETINGS) are not required for
+	// a refresh to succeed, so a failed phase does not necessarily mean
+	// the refresh has failed
+	PhaseStatus_STATUS_FAILED PhaseStatus = 2
+)
+
+// Enum value maps for PhaseStatus.
+var (
+	PhaseStatus_name = map[int32]string{
+		0: "STATUS_STARTED",
+		1: "STATUS_COMPLETED",
+		2: "STATUS_FAILED",
+	}
+	PhaseStatus_value = map[string]int32{
+		"STATUS_STARTED":   0,
+		"STATUS_COMPLETED": 1,
+		"STATUS_FAILED":    2,
+	}
+)
+
+func (x PhaseStatus) Enum() *PhaseStatus {
+	p := new(PhaseStatus)
+	*p = x
+	return p
+}
+
+func (x PhaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PhaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_sis_v1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (PhaseStatus) Type() protoreflect.EnumType {
+	return &file_vcassist_services_sis_v1_api_proto_enumTypes[1]
+}
+
+func (x PhaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PhaseStatus.Descriptor instead.
+func (PhaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+// GetChanges
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_NEW_ASSIGNMENT ChangeType = 0
+	// an assignment that previously had no score now has one
+	ChangeType_CHANGE_SCORE_POSTED ChangeType = 1
+	// the score of an assignment was modified
+	ChangeType_CHANGE_SCORE_CHANGED  ChangeType = 2
+	ChangeType_CHANGE_MARKED_MISSING ChangeType = 3
+	ChangeType_CHANGE_MARKED_LATE    ChangeType = 4
+	// the overall grade of a course went up or down
+	ChangeType_CHANGE_OVERALL_GRADE ChangeType = 5
+	// the due date of an assignment was moved
+	ChangeType_CHANGE_DUE_DATE_CHANGED ChangeType = 6
+	// an assignment that previously had a score no longer has one
+	ChangeType_CHANGE_SCORE_REMOVED ChangeType = 7
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_NEW_ASSIGNMENT",
+		1: "CHANGE_SCORE_POSTED",
+		2: "CHANGE_SCORE_CHANGED",
+		3: "CHANGE_MARKED_MISSING",
+		4: "CHANGE_MARKED_LATE",
+		5: "CHANGE_OVERALL_GRADE",
+		6: "CHANGE_DUE_DATE_CHANGED",
+		7: "CHANGE_SCORE_REMOVED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_NEW_ASSIGNMENT":   0,
+		"CHANGE_SCORE_POSTED":     1,
+		"CHANGE_SCORE_CHANGED":    2,
+		"CHANGE_MARKED_MISSING":   3,
+		"CHANGE_MARKED_LATE":      4,
+		"CHANGE_OVERALL_GRADE":    5,
+		"CHANGE_DUE_DATE_CHANGED": 6,
+		"CHANGE_SCORE_REMOVED":    7,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_sis_v1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_vcassist_services_sis_v1_api_proto_enumTypes[2]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
 // GetCredentialStatus
 type GetCredentialStatusRequest struct {
 	state         protoimpl.MessageState
@@ -470,230 +665,1478 @@ func (x *RefreshDataResponse) GetData() *Data {
 	return nil
 }
 
-var File_vcassist_services_sis_v1_api_proto protoreflect.FileDescriptor
+type RefreshPhaseEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_vcassist_services_sis_v1_api_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x23,
-	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x66, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x67, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x76,
-	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x42,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x6f, 0x6f,
-	0x6c, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x52, 0x09, 0x62, 0x75, 0x6c, 0x6c, 0x65,
-	0x74, 0x69, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x14, 0x0a,
-	0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xda,
-	0x03, 0x0a, 0x09, 0x53, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x63, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73,
-	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x63, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73,
-	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x76, 0x63, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73,
-	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6a, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c,
-	0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76,
-	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xe2, 0x01, 0x0a, 0x1c,
-	0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70,
-	0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x73, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x56, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x18, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x5c, 0x53, 0x69, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x56, 0x63,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c,
-	0x53, 0x69, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x1b, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a, 0x53, 0x69, 0x73, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Phase  RefreshPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=vcassist.services.sis.v1.RefreshPhase" json:"phase,omitempty"`
+	Status PhaseStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=vcassist.services.sis.v1.PhaseStatus" json:"status,omitempty"`
+	// this will only be set when status is STATUS_FAILED
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// how much of the refresh is done (0-100), a phase counts as done once
+	// it has completed or failed
+	Percent int32 `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"`
 }
 
-var (
-	file_vcassist_services_sis_v1_api_proto_rawDescOnce sync.Once
-	file_vcassist_services_sis_v1_api_proto_rawDescData = file_vcassist_services_sis_v1_api_proto_rawDesc
-)
+func (x *RefreshPhaseEvent) Reset() {
+	*x = RefreshPhaseEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_vcassist_services_sis_v1_api_proto_rawDescGZIP() []byte {
-	file_vcassist_services_sis_v1_api_proto_rawDescOnce.Do(func() {
-		file_vcassist_services_sis_v1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_vcassist_services_sis_v1_api_proto_rawDescData)
-	})
-	return file_vcassist_services_sis_v1_api_proto_rawDescData
+func (x *RefreshPhaseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_vcassist_services_sis_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_vcassist_services_sis_v1_api_proto_goTypes = []any{
-	(*GetCredentialStatusRequest)(nil),   // 0: vcassist.services.sis.v1.GetCredentialStatusRequest
-	(*GetCredentialStatusResponse)(nil),  // 1: vcassist.services.sis.v1.GetCredentialStatusResponse
-	(*ProvideCredentialRequest)(nil),     // 2: vcassist.services.sis.v1.ProvideCredentialRequest
-	(*ProvideCredentialResponse)(nil),    // 3: vcassist.services.sis.v1.ProvideCredentialResponse
-	(*Data)(nil),                         // 4: vcassist.services.sis.v1.Data
-	(*GetDataRequest)(nil),               // 5: vcassist.services.sis.v1.GetDataRequest
-	(*GetDataResponse)(nil),              // 6: vcassist.services.sis.v1.GetDataResponse
-	(*RefreshDataRequest)(nil),           // 7: vcassist.services.sis.v1.RefreshDataRequest
-	(*RefreshDataResponse)(nil),          // 8: vcassist.services.sis.v1.RefreshDataResponse
-	(*v1.CredentialStatus)(nil),          // 9: vcassist.services.keychain.v1.CredentialStatus
-	(*v1.OAuthTokenProvision)(nil),       // 10: vcassist.services.keychain.v1.OAuthTokenProvision
-	(*v1.UsernamePasswordProvision)(nil), // 11: vcassist.services.keychain.v1.UsernamePasswordProvision
-	(*StudentProfile)(nil),               // 12: vcassist.services.sis.v1.StudentProfile
-	(*SchoolData)(nil),                   // 13: vcassist.services.sis.v1.SchoolData
-	(*Bulletin)(nil),                     // 14: vcassist.services.sis.v1.Bulletin
-	(*CourseData)(nil),                   // 15: vcassist.services.sis.v1.CourseData
+func (*RefreshPhaseEvent) ProtoMessage() {}
+
+func (x *RefreshPhaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_vcassist_services_sis_v1_api_proto_depIdxs = []int32{
-	9,  // 0: vcassist.services.sis.v1.GetCredentialStatusResponse.status:type_name -> vcassist.services.keychain.v1.CredentialStatus
-	10, // 1: vcassist.services.sis.v1.ProvideCredentialRequest.token:type_name -> vcassist.services.keychain.v1.OAuthTokenProvision
-	11, // 2: vcassist.services.sis.v1.ProvideCredentialRequest.username_password:type_name -> vcassist.services.keychain.v1.UsernamePasswordProvision
-	12, // 3: vcassist.services.sis.v1.Data.profile:type_name -> vcassist.services.sis.v1.StudentProfile
-	13, // 4: vcassist.services.sis.v1.Data.schools:type_name -> vcassist.services.sis.v1.SchoolData
-	14, // 5: vcassist.services.sis.v1.Data.bulletins:type_name -> vcassist.services.sis.v1.Bulletin
-	15, // 6: vcassist.services.sis.v1.Data.courses:type_name -> vcassist.services.sis.v1.CourseData
-	4,  // 7: vcassist.services.sis.v1.GetDataResponse.data:type_name -> vcassist.services.sis.v1.Data
-	4,  // 8: vcassist.services.sis.v1.RefreshDataResponse.data:type_name -> vcassist.services.sis.v1.Data
-	0,  // 9: vcassist.services.sis.v1.SIService.GetCredentialStatus:input_type -> vcassist.services.sis.v1.GetCredentialStatusRequest
-	2,  // 10: vcassist.services.sis.v1.SIService.ProvideCredential:input_type -> vcassist.services.sis.v1.ProvideCredentialRequest
-	5,  // 11: vcassist.services.sis.v1.SIService.GetData:input_type -> vcassist.services.sis.v1.GetDataRequest
-	7,  // 12: vcassist.services.sis.v1.SIService.RefreshData:input_type -> vcassist.services.sis.v1.RefreshDataRequest
-	1,  // 13: vcassist.services.sis.v1.SIService.GetCredentialStatus:output_type -> vcassist.services.sis.v1.GetCredentialStatusResponse
-	3,  // 14: vcassist.services.sis.v1.SIService.ProvideCredential:output_type -> vcassist.services.sis.v1.ProvideCredentialResponse
-	6,  // 15: vcassist.services.sis.v1.SIService.GetData:output_type -> vcassist.services.sis.v1.GetDataResponse
-	8,  // 16: vcassist.services.sis.v1.SIService.RefreshData:output_type -> vcassist.services.sis.v1.RefreshDataResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use RefreshPhaseEvent.ProtoReflect.Descriptor instead.
+func (*RefreshPhaseEvent) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_vcassist_services_sis_v1_api_proto_init() }
-func file_vcassist_services_sis_v1_api_proto_init() {
-	if File_vcassist_services_sis_v1_api_proto != nil {
-		return
+func (x *RefreshPhaseEvent) GetPhase() RefreshPhase {
+	if x != nil {
+		return x.Phase
 	}
-	file_vcassist_services_sis_v1_data_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_vcassist_services_sis_v1_api_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCredentialStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_vcassist_services_sis_v1_api_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCredentialStatusResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_vcassist_services_sis_v1_api_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ProvideCredentialRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_vcassist_services_sis_v1_api_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ProvideCredentialResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return RefreshPhase_PHASE_KEYCHAIN
+}
+
+func (x *RefreshPhaseEvent) GetStatus() PhaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PhaseStatus_STATUS_STARTED
+}
+
+func (x *RefreshPhaseEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RefreshPhaseEvent) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type RefreshDataStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshDataStreamRequest) Reset() {
+	*x = RefreshDataStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshDataStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDataStreamRequest) ProtoMessage() {}
+
+func (x *RefreshDataStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vcassist_services_sis_v1_api_proto_msgTypes[4].Exporter = func(v any, i int) any {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDataStreamRequest.ProtoReflect.Descriptor instead.
+func (*RefreshDataStreamRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{10}
+}
+
+type RefreshDataStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*RefreshDataStreamResponse_Phase
+	//	*RefreshDataStreamResponse_Data
+	Event isRefreshDataStreamResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *RefreshDataStreamResponse) Reset() {
+	*x = RefreshDataStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshDataStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDataStreamResponse) ProtoMessage() {}
+
+func (x *RefreshDataStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDataStreamResponse.ProtoReflect.Descriptor instead.
+func (*RefreshDataStreamResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *RefreshDataStreamResponse) GetEvent() isRefreshDataStreamResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *RefreshDataStreamResponse) GetPhase() *RefreshPhaseEvent {
+	if x, ok := x.GetEvent().(*RefreshDataStreamResponse_Phase); ok {
+		return x.Phase
+	}
+	return nil
+}
+
+func (x *RefreshDataStreamResponse) GetData() *Data {
+	if x, ok := x.GetEvent().(*RefreshDataStreamResponse_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isRefreshDataStreamResponse_Event interface {
+	isRefreshDataStreamResponse_Event()
+}
+
+type RefreshDataStreamResponse_Phase struct {
+	Phase *RefreshPhaseEvent `protobuf:"bytes,1,opt,name=phase,proto3,oneof"`
+}
+
+type RefreshDataStreamResponse_Data struct {
+	// this is the last message sent if the refresh succeeds
+	Data *Data `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*RefreshDataStreamResponse_Phase) isRefreshDataStreamResponse_Event() {}
+
+func (*RefreshDataStreamResponse_Data) isRefreshDataStreamResponse_Event() {}
+
+type Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the time the change was detected
+	Time       int64      `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type       ChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=vcassist.services.sis.v1.ChangeType" json:"type,omitempty"`
+	CourseGuid string     `protobuf:"bytes,3,opt,name=course_guid,json=courseGuid,proto3" json:"course_guid,omitempty"`
+	CourseName string     `protobuf:"bytes,4,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	// the title of the assignment, this will be empty for CHANGE_OVERALL_GRADE
+	Assignment string `protobuf:"bytes,5,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	// for assignment changes these are points earned, for CHANGE_OVERALL_GRADE
+	// these are percentages (0-100)
+	Previous *float32 `protobuf:"fixed32,6,opt,name=previous,proto3,oneof" json:"previous,omitempty"`
+	Current  *float32 `protobuf:"fixed32,7,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	// unix timestamps, these are only set for CHANGE_DUE_DATE_CHANGED
+	PreviousDueDate *int64 `protobuf:"varint,8,opt,name=previous_due_date,json=previousDueDate,proto3,oneof" json:"previous_due_date,omitempty"`
+	CurrentDueDate  *int64 `protobuf:"varint,9,opt,name=current_due_date,json=currentDueDate,proto3,oneof" json:"current_due_date,omitempty"`
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Change) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Change) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_NEW_ASSIGNMENT
+}
+
+func (x *Change) GetCourseGuid() string {
+	if x != nil {
+		return x.CourseGuid
+	}
+	return ""
+}
+
+func (x *Change) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *Change) GetAssignment() string {
+	if x != nil {
+		return x.Assignment
+	}
+	return ""
+}
+
+func (x *Change) GetPrevious() float32 {
+	if x != nil && x.Previous != nil {
+		return *x.Previous
+	}
+	return 0
+}
+
+func (x *Change) GetCurrent() float32 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *Change) GetPreviousDueDate() int64 {
+	if x != nil && x.PreviousDueDate != nil {
+		return *x.PreviousDueDate
+	}
+	return 0
+}
+
+func (x *Change) GetCurrentDueDate() int64 {
+	if x != nil && x.CurrentDueDate != nil {
+		return *x.CurrentDueDate
+	}
+	return 0
+}
+
+type GetChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix timestamp, only changes detected after this time will be returned
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *GetChangesRequest) Reset() {
+	*x = GetChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesRequest) ProtoMessage() {}
+
+func (x *GetChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangesRequest.ProtoReflect.Descriptor instead.
+func (*GetChangesRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetChangesRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type GetChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes are sorted from oldest to newest
+	Changes []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetChangesResponse) Reset() {
+	*x = GetChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesResponse) ProtoMessage() {}
+
+func (x *GetChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangesResponse.ProtoReflect.Descriptor instead.
+func (*GetChangesResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetChangesResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// CalculateGrade
+type NeededScoreQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the category of the future assignment
+	Category       string  `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	PointsPossible float32 `protobuf:"fixed32,2,opt,name=points_possible,json=pointsPossible,proto3" json:"points_possible,omitempty"`
+	// the overall (weighted) grade to reach, this is a value from 0-100
+	TargetGrade float32 `protobuf:"fixed32,3,opt,name=target_grade,json=targetGrade,proto3" json:"target_grade,omitempty"`
+}
+
+func (x *NeededScoreQuery) Reset() {
+	*x = NeededScoreQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeededScoreQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeededScoreQuery) ProtoMessage() {}
+
+func (x *NeededScoreQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeededScoreQuery.ProtoReflect.Descriptor instead.
+func (*NeededScoreQuery) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NeededScoreQuery) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NeededScoreQuery) GetPointsPossible() float32 {
+	if x != nil {
+		return x.PointsPossible
+	}
+	return 0
+}
+
+func (x *NeededScoreQuery) GetTargetGrade() float32 {
+	if x != nil {
+		return x.TargetGrade
+	}
+	return 0
+}
+
+type CategoryGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// this is a value from 0-1, it is 0 for categories without a weight
+	Weight         float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	PointsEarned   float32 `protobuf:"fixed32,3,opt,name=points_earned,json=pointsEarned,proto3" json:"points_earned,omitempty"`
+	PointsPossible float32 `protobuf:"fixed32,4,opt,name=points_possible,json=pointsPossible,proto3" json:"points_possible,omitempty"`
+	// this is a value from 0-100, it is unset if nothing in the category
+	// has been graded
+	Grade *float32 `protobuf:"fixed32,5,opt,name=grade,proto3,oneof" json:"grade,omitempty"`
+}
+
+func (x *CategoryGrade) Reset() {
+	*x = CategoryGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGrade) ProtoMessage() {}
+
+func (x *CategoryGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGrade.ProtoReflect.Descriptor instead.
+func (*CategoryGrade) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CategoryGrade) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryGrade) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CategoryGrade) GetPointsEarned() float32 {
+	if x != nil {
+		return x.PointsEarned
+	}
+	return 0
+}
+
+func (x *CategoryGrade) GetPointsPossible() float32 {
+	if x != nil {
+		return x.PointsPossible
+	}
+	return 0
+}
+
+func (x *CategoryGrade) GetGrade() float32 {
+	if x != nil && x.Grade != nil {
+		return *x.Grade
+	}
+	return 0
+}
+
+type CalculateGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseGuid string `protobuf:"bytes,1,opt,name=course_guid,json=courseGuid,proto3" json:"course_guid,omitempty"`
+	// assignments to add to the course
+	HypotheticalAssignments []*AssignmentData `protobuf:"bytes,2,rep,name=hypothetical_assignments,json=hypotheticalAssignments,proto3" json:"hypothetical_assignments,omitempty"`
+	// replacements for existing assignments, these are matched to existing
+	// assignments by title and due date
+	EditedAssignments []*AssignmentData `protobuf:"bytes,3,rep,name=edited_assignments,json=editedAssignments,proto3" json:"edited_assignments,omitempty"`
+	// if specified, the score needed on a future assignment to reach a
+	// target grade will be computed
+	Needed *NeededScoreQuery `protobuf:"bytes,4,opt,name=needed,proto3,oneof" json:"needed,omitempty"`
+}
+
+func (x *CalculateGradeRequest) Reset() {
+	*x = CalculateGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateGradeRequest) ProtoMessage() {}
+
+func (x *CalculateGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateGradeRequest.ProtoReflect.Descriptor instead.
+func (*CalculateGradeRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CalculateGradeRequest) GetCourseGuid() string {
+	if x != nil {
+		return x.CourseGuid
+	}
+	return ""
+}
+
+func (x *CalculateGradeRequest) GetHypotheticalAssignments() []*AssignmentData {
+	if x != nil {
+		return x.HypotheticalAssignments
+	}
+	return nil
+}
+
+func (x *CalculateGradeRequest) GetEditedAssignments() []*AssignmentData {
+	if x != nil {
+		return x.EditedAssignments
+	}
+	return nil
+}
+
+func (x *CalculateGradeRequest) GetNeeded() *NeededScoreQuery {
+	if x != nil {
+		return x.Needed
+	}
+	return nil
+}
+
+type CalculateGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// these are values from 0-100, they are unset if nothing in the course
+	// has been graded
+	WeightedGrade   *float32         `protobuf:"fixed32,1,opt,name=weighted_grade,json=weightedGrade,proto3,oneof" json:"weighted_grade,omitempty"`
+	UnweightedGrade *float32         `protobuf:"fixed32,2,opt,name=unweighted_grade,json=unweightedGrade,proto3,oneof" json:"unweighted_grade,omitempty"`
+	Categories      []*CategoryGrade `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// the points needed on the future assignment, this can be more than
+	// points_possible if the target can't be reached or negative if the
+	// target will be reached regardless
+	NeededPoints *float32 `protobuf:"fixed32,4,opt,name=needed_points,json=neededPoints,proto3,oneof" json:"needed_points,omitempty"`
+}
+
+func (x *CalculateGradeResponse) Reset() {
+	*x = CalculateGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateGradeResponse) ProtoMessage() {}
+
+func (x *CalculateGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateGradeResponse.ProtoReflect.Descriptor instead.
+func (*CalculateGradeResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CalculateGradeResponse) GetWeightedGrade() float32 {
+	if x != nil && x.WeightedGrade != nil {
+		return *x.WeightedGrade
+	}
+	return 0
+}
+
+func (x *CalculateGradeResponse) GetUnweightedGrade() float32 {
+	if x != nil && x.UnweightedGrade != nil {
+		return *x.UnweightedGrade
+	}
+	return 0
+}
+
+func (x *CalculateGradeResponse) GetCategories() []*CategoryGrade {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CalculateGradeResponse) GetNeededPoints() float32 {
+	if x != nil && x.NeededPoints != nil {
+		return *x.NeededPoints
+	}
+	return 0
+}
+
+// GetTranscript
+type TranscriptTerm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseGuid string `protobuf:"bytes,1,opt,name=course_guid,json=courseGuid,proto3" json:"course_guid,omitempty"`
+	CourseName string `protobuf:"bytes,2,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	Start      int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// this is a value from 0-100
+	Percent    float32 `protobuf:"fixed32,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	InProgress bool    `protobuf:"varint,6,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	// the points added to this term for the weighted GPA
+	GpaBump float32 `protobuf:"fixed32,7,opt,name=gpa_bump,json=gpaBump,proto3" json:"gpa_bump,omitempty"`
+}
+
+func (x *TranscriptTerm) Reset() {
+	*x = TranscriptTerm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranscriptTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptTerm) ProtoMessage() {}
+
+func (x *TranscriptTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptTerm.ProtoReflect.Descriptor instead.
+func (*TranscriptTerm) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TranscriptTerm) GetCourseGuid() string {
+	if x != nil {
+		return x.CourseGuid
+	}
+	return ""
+}
+
+func (x *TranscriptTerm) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *TranscriptTerm) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TranscriptTerm) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TranscriptTerm) GetPercent() float32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *TranscriptTerm) GetInProgress() bool {
+	if x != nil {
+		return x.InProgress
+	}
+	return false
+}
+
+func (x *TranscriptTerm) GetGpaBump() float32 {
+	if x != nil {
+		return x.GpaBump
+	}
+	return 0
+}
+
+type TranscriptYear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartYear int32             `protobuf:"varint,1,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear   int32             `protobuf:"varint,2,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	Terms     []*TranscriptTerm `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+	// GPAs only include terms that are not in progress, they are unset if
+	// there are no such terms
+	WeightedGpa   *float32 `protobuf:"fixed32,4,opt,name=weighted_gpa,json=weightedGpa,proto3,oneof" json:"weighted_gpa,omitempty"`
+	UnweightedGpa *float32 `protobuf:"fixed32,5,opt,name=unweighted_gpa,json=unweightedGpa,proto3,oneof" json:"unweighted_gpa,omitempty"`
+}
+
+func (x *TranscriptYear) Reset() {
+	*x = TranscriptYear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranscriptYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptYear) ProtoMessage() {}
+
+func (x *TranscriptYear) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptYear.ProtoReflect.Descriptor instead.
+func (*TranscriptYear) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TranscriptYear) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *TranscriptYear) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *TranscriptYear) GetTerms() []*TranscriptTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *TranscriptYear) GetWeightedGpa() float32 {
+	if x != nil && x.WeightedGpa != nil {
+		return *x.WeightedGpa
+	}
+	return 0
+}
+
+func (x *TranscriptYear) GetUnweightedGpa() float32 {
+	if x != nil && x.UnweightedGpa != nil {
+		return *x.UnweightedGpa
+	}
+	return 0
+}
+
+type GetTranscriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTranscriptRequest) Reset() {
+	*x = GetTranscriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptRequest) ProtoMessage() {}
+
+func (x *GetTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*GetTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+type GetTranscriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// years are sorted from oldest to newest
+	Years []*TranscriptYear `protobuf:"bytes,1,rep,name=years,proto3" json:"years,omitempty"`
+	// the cumulative GPAs across all years
+	WeightedGpa   *float32 `protobuf:"fixed32,2,opt,name=weighted_gpa,json=weightedGpa,proto3,oneof" json:"weighted_gpa,omitempty"`
+	UnweightedGpa *float32 `protobuf:"fixed32,3,opt,name=unweighted_gpa,json=unweightedGpa,proto3,oneof" json:"unweighted_gpa,omitempty"`
+}
+
+func (x *GetTranscriptResponse) Reset() {
+	*x = GetTranscriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptResponse) ProtoMessage() {}
+
+func (x *GetTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_sis_v1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*GetTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_sis_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTranscriptResponse) GetYears() []*TranscriptYear {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+func (x *GetTranscriptResponse) GetWeightedGpa() float32 {
+	if x != nil && x.WeightedGpa != nil {
+		return *x.WeightedGpa
+	}
+	return 0
+}
+
+func (x *GetTranscriptResponse) GetUnweightedGpa() float32 {
+	if x != nil && x.UnweightedGpa != nil {
+		return *x.UnweightedGpa
+	}
+	return 0
+}
+
+var File_vcassist_services_sis_v1_api_proto protoreflect.FileDescriptor
+
+var file_vcassist_services_sis_v1_api_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x23,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x66, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x67, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x76,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x42,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x52, 0x09, 0x62, 0x75, 0x6c, 0x6c, 0x65,
+	0x74, 0x69, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x14, 0x0a,
+	0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc0,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x68, 0x61, 0x73, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01,
+	0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x63, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x9c, 0x03, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x38,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x76,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x29,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x10, 0x4e,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0xca, 0x02, 0x0a, 0x15, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x67, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47,
+	0x75, 0x69, 0x64, 0x12, 0x63, 0x0a, 0x18, 0x68, 0x79, 0x70, 0x6f, 0x74, 0x68, 0x65, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x17, 0x68, 0x79, 0x70, 0x6f, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x12, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x47, 0x0a, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52,
+	0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x16, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x0e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0d, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0f, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0c,
+	0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x61, 0x5f, 0x62, 0x75, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x67, 0x70, 0x61, 0x42, 0x75, 0x6d, 0x70, 0x22, 0x82, 0x02, 0x0a,
+	0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3e, 0x0a, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x65,
+	0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x00, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x47, 0x70, 0x61, 0x88, 0x01,
+	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f,
+	0x67, 0x70, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x47, 0x70, 0x61, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x61, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70,
+	0x61, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x79, 0x65, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x59, 0x65, 0x61, 0x72, 0x52, 0x05, 0x79, 0x65,
+	0x61, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f,
+	0x67, 0x70, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x47, 0x70, 0x61, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x75,
+	0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x47, 0x70, 0x61, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x61, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x75, 0x6e, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x70, 0x61, 0x2a, 0xce, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x0e,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45,
+	0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x45,
+	0x45, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x53, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x53, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x10, 0x07, 0x2a, 0x4a, 0x0a, 0x0b,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xde, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x43, 0x4f,
+	0x52, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x03,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x44, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x4c, 0x4c, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45,
+	0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x55, 0x45,
+	0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x07, 0x32, 0xaa, 0x07, 0x0a, 0x09, 0x53, 0x49,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x11,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x2e, 0x76, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x32, 0x2e, 0x76, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x2f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x2e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xe2, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
+	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x35, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2d, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x69,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x53, 0x53,
+	0xaa, 0x02, 0x18, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x56, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c,
+	0x53, 0x69, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x53, 0x69, 0x73, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
+	0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x3a, 0x3a, 0x53, 0x69, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vcassist_services_sis_v1_api_proto_rawDescOnce sync.Once
+	file_vcassist_services_sis_v1_api_proto_rawDescData = file_vcassist_services_sis_v1_api_proto_rawDesc
+)
+
+func file_vcassist_services_sis_v1_api_proto_rawDescGZIP() []byte {
+	file_vcassist_services_sis_v1_api_proto_rawDescOnce.Do(func() {
+		file_vcassist_services_sis_v1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_vcassist_services_sis_v1_api_proto_rawDescData)
+	})
+	return file_vcassist_services_sis_v1_api_proto_rawDescData
+}
+
+var file_vcassist_services_sis_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vcassist_services_sis_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_vcassist_services_sis_v1_api_proto_goTypes = []any{
+	(RefreshPhase)(0),                    // 0: vcassist.services.sis.v1.RefreshPhase
+	(PhaseStatus)(0),                     // 1: vcassist.services.sis.v1.PhaseStatus
+	(ChangeType)(0),                      // 2: vcassist.services.sis.v1.ChangeType
+	(*GetCredentialStatusRequest)(nil),   // 3: vcassist.services.sis.v1.GetCredentialStatusRequest
+	(*GetCredentialStatusResponse)(nil),  // 4: vcassist.services.sis.v1.GetCredentialStatusResponse
+	(*ProvideCredentialRequest)(nil),     // 5: vcassist.services.sis.v1.ProvideCredentialRequest
+	(*ProvideCredentialResponse)(nil),    // 6: vcassist.services.sis.v1.ProvideCredentialResponse
+	(*Data)(nil),                         // 7: vcassist.services.sis.v1.Data
+	(*GetDataRequest)(nil),               // 8: vcassist.services.sis.v1.GetDataRequest
+	(*GetDataResponse)(nil),              // 9: vcassist.services.sis.v1.GetDataResponse
+	(*RefreshDataRequest)(nil),           // 10: vcassist.services.sis.v1.RefreshDataRequest
+	(*RefreshDataResponse)(nil),          // 11: vcassist.services.sis.v1.RefreshDataResponse
+	(*RefreshPhaseEvent)(nil),            // 12: vcassist.services.sis.v1.RefreshPhaseEvent
+	(*RefreshDataStreamRequest)(nil),     // 13: vcassist.services.sis.v1.RefreshDataStreamRequest
+	(*RefreshDataStreamResponse)(nil),    // 14: vcassist.services.sis.v1.RefreshDataStreamResponse
+	(*Change)(nil),                       // 15: vcassist.services.sis.v1.Change
+	(*GetChangesRequest)(nil),            // 16: vcassist.services.sis.v1.GetChangesRequest
+	(*GetChangesResponse)(nil),           // 17: vcassist.services.sis.v1.GetChangesResponse
+	(*NeededScoreQuery)(nil),             // 18: vcassist.services.sis.v1.NeededScoreQuery
+	(*CategoryGrade)(nil),                // 19: vcassist.services.sis.v1.CategoryGrade
+	(*CalculateGradeRequest)(nil),        // 20: vcassist.services.sis.v1.CalculateGradeRequest
+	(*CalculateGradeResponse)(nil),       // 21: vcassist.services.sis.v1.CalculateGradeResponse
+	(*TranscriptTerm)(nil),               // 22: vcassist.services.sis.v1.TranscriptTerm
+	(*TranscriptYear)(nil),               // 23: vcassist.services.sis.v1.TranscriptYear
+	(*GetTranscriptRequest)(nil),         // 24: vcassist.services.sis.v1.GetTranscriptRequest
+	(*GetTranscriptResponse)(nil),        // 25: vcassist.services.sis.v1.GetTranscriptResponse
+	(*v1.CredentialStatus)(nil),          // 26: vcassist.services.keychain.v1.CredentialStatus
+	(*v1.OAuthTokenProvision)(nil),       // 27: vcassist.services.keychain.v1.OAuthTokenProvision
+	(*v1.UsernamePasswordProvision)(nil), // 28: vcassist.services.keychain.v1.UsernamePasswordProvision
+	(*StudentProfile)(nil),               // 29: vcassist.services.sis.v1.StudentProfile
+	(*SchoolData)(nil),                   // 30: vcassist.services.sis.v1.SchoolData
+	(*Bulletin)(nil),                     // 31: vcassist.services.sis.v1.Bulletin
+	(*CourseData)(nil),                   // 32: vcassist.services.sis.v1.CourseData
+	(*AssignmentData)(nil),               // 33: vcassist.services.sis.v1.AssignmentData
+}
+var file_vcassist_services_sis_v1_api_proto_depIdxs = []int32{
+	26, // 0: vcassist.services.sis.v1.GetCredentialStatusResponse.status:type_name -> vcassist.services.keychain.v1.CredentialStatus
+	27, // 1: vcassist.services.sis.v1.ProvideCredentialRequest.token:type_name -> vcassist.services.keychain.v1.OAuthTokenProvision
+	28, // 2: vcassist.services.sis.v1.ProvideCredentialRequest.username_password:type_name -> vcassist.services.keychain.v1.UsernamePasswordProvision
+	29, // 3: vcassist.services.sis.v1.Data.profile:type_name -> vcassist.services.sis.v1.StudentProfile
+	30, // 4: vcassist.services.sis.v1.Data.schools:type_name -> vcassist.services.sis.v1.SchoolData
+	31, // 5: vcassist.services.sis.v1.Data.bulletins:type_name -> vcassist.services.sis.v1.Bulletin
+	32, // 6: vcassist.services.sis.v1.Data.courses:type_name -> vcassist.services.sis.v1.CourseData
+	7,  // 7: vcassist.services.sis.v1.GetDataResponse.data:type_name -> vcassist.services.sis.v1.Data
+	7,  // 8: vcassist.services.sis.v1.RefreshDataResponse.data:type_name -> vcassist.services.sis.v1.Data
+	0,  // 9: vcassist.services.sis.v1.RefreshPhaseEvent.phase:type_name -> vcassist.services.sis.v1.RefreshPhase
+	1,  // 10: vcassist.services.sis.v1.RefreshPhaseEvent.status:type_name -> vcassist.services.sis.v1.PhaseStatus
+	12, // 11: vcassist.services.sis.v1.RefreshDataStreamResponse.phase:type_name -> vcassist.services.sis.v1.RefreshPhaseEvent
+	7,  // 12: vcassist.services.sis.v1.RefreshDataStreamResponse.data:type_name -> vcassist.services.sis.v1.Data
+	2,  // 13: vcassist.services.sis.v1.Change.type:type_name -> vcassist.services.sis.v1.ChangeType
+	15, // 14: vcassist.services.sis.v1.GetChangesResponse.changes:type_name -> vcassist.services.sis.v1.Change
+	33, // 15: vcassist.services.sis.v1.CalculateGradeRequest.hypothetical_assignments:type_name -> vcassist.services.sis.v1.AssignmentData
+	33, // 16: vcassist.services.sis.v1.CalculateGradeRequest.edited_assignments:type_name -> vcassist.services.sis.v1.AssignmentData
+	18, // 17: vcassist.services.sis.v1.CalculateGradeRequest.needed:type_name -> vcassist.services.sis.v1.NeededScoreQuery
+	19, // 18: vcassist.services.sis.v1.CalculateGradeResponse.categories:type_name -> vcassist.services.sis.v1.CategoryGrade
+	22, // 19: vcassist.services.sis.v1.TranscriptYear.terms:type_name -> vcassist.services.sis.v1.TranscriptTerm
+	23, // 20: vcassist.services.sis.v1.GetTranscriptResponse.years:type_name -> vcassist.services.sis.v1.TranscriptYear
+	3,  // 21: vcassist.services.sis.v1.SIService.GetCredentialStatus:input_type -> vcassist.services.sis.v1.GetCredentialStatusRequest
+	5,  // 22: vcassist.services.sis.v1.SIService.ProvideCredential:input_type -> vcassist.services.sis.v1.ProvideCredentialRequest
+	8,  // 23: vcassist.services.sis.v1.SIService.GetData:input_type -> vcassist.services.sis.v1.GetDataRequest
+	10, // 24: vcassist.services.sis.v1.SIService.RefreshData:input_type -> vcassist.services.sis.v1.RefreshDataRequest
+	13, // 25: vcassist.services.sis.v1.SIService.RefreshDataStream:input_type -> vcassist.services.sis.v1.RefreshDataStreamRequest
+	16, // 26: vcassist.services.sis.v1.SIService.GetChanges:input_type -> vcassist.services.sis.v1.GetChangesRequest
+	20, // 27: vcassist.services.sis.v1.SIService.CalculateGrade:input_type -> vcassist.services.sis.v1.CalculateGradeRequest
+	24, // 28: vcassist.services.sis.v1.SIService.GetTranscript:input_type -> vcassist.services.sis.v1.GetTranscriptRequest
+	4,  // 29: vcassist.services.sis.v1.SIService.GetCredentialStatus:output_type -> vcassist.services.sis.v1.GetCredentialStatusResponse
+	6,  // 30: vcassist.services.sis.v1.SIService.ProvideCredential:output_type -> vcassist.services.sis.v1.ProvideCredentialResponse
+	9,  // 31: vcassist.services.sis.v1.SIService.GetData:output_type -> vcassist.services.sis.v1.GetDataResponse
+	11, // 32: vcassist.services.sis.v1.SIService.RefreshData:output_type -> vcassist.services.sis.v1.RefreshDataResponse
+	14, // 33: vcassist.services.sis.v1.SIService.RefreshDataStream:output_type -> vcassist.services.sis.v1.RefreshDataStreamResponse
+	17, // 34: vcassist.services.sis.v1.SIService.GetChanges:output_type -> vcassist.services.sis.v1.GetChangesResponse
+	21, // 35: vcassist.services.sis.v1.SIService.CalculateGrade:output_type -> vcassist.services.sis.v1.CalculateGradeResponse
+	25, // 36: vcassist.services.sis.v1.SIService.GetTranscript:output_type -> vcassist.services.sis.v1.GetTranscriptResponse
+	29, // [29:37] is the sub-list for method output_type
+	21, // [21:29] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_vcassist_services_sis_v1_api_proto_init() }
+func file_vcassist_services_sis_v1_api_proto_init() {
+	if File_vcassist_services_sis_v1_api_proto != nil {
+		return
+	}
+	file_vcassist_services_sis_v1_data_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vcassist_services_sis_v1_api_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCredentialStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCredentialStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ProvideCredentialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ProvideCredentialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*Data); i {
 			case 0:
 				return &v.state
@@ -753,23 +2196,202 @@ func file_vcassist_services_sis_v1_api_proto_init() {
 				return nil
 			}
 		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshPhaseEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshDataStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshDataStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*NeededScoreQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateGradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*TranscriptTerm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TranscriptYear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTranscriptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_sis_v1_api_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTranscriptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_vcassist_services_sis_v1_api_proto_msgTypes[2].OneofWrappers = []any{
 		(*ProvideCredentialRequest_Token)(nil),
 		(*ProvideCredentialRequest_UsernamePassword)(nil),
 	}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[11].OneofWrappers = []any{
+		(*RefreshDataStreamResponse_Phase)(nil),
+		(*RefreshDataStreamResponse_Data)(nil),
+	}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[12].OneofWrappers = []any{}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[16].OneofWrappers = []any{}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[17].OneofWrappers = []any{}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[18].OneofWrappers = []any{}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[20].OneofWrappers = []any{}
+	file_vcassist_services_sis_v1_api_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vcassist_services_sis_v1_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_vcassist_services_sis_v1_api_proto_goTypes,
 		DependencyIndexes: file_vcassist_services_sis_v1_api_proto_depIdxs,
+		EnumInfos:         file_vcassist_services_sis_v1_api_proto_enumTypes,
 		MessageInfos:      file_vcassist_services_sis_v1_api_proto_msgTypes,
 	}.Build()
 	File_vcassist_services_sis_v1_api_proto = out.File
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetCredentialStatusRequest, GetCredentialStatusResponse, GetDataRequest, GetDataResponse, ProvideCredentialRequest, ProvideCredentialResponse, RefreshDataRequest, RefreshDataResponse } from "./api_pb.js";
+import { CalculateGradeRequest, CalculateGradeResponse, GetChangesRequest, GetChangesResponse, GetCredentialStatusRequest, GetCredentialStatusResponse, GetDataRequest, GetDataResponse, GetTranscriptRequest, GetTranscriptResponse, ProvideCredentialRequest, ProvideCredentialResponse, RefreshDataRequest, RefreshDataResponse, RefreshDataStreamRequest, RefreshDataStreamResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -50,6 +50,42 @@ export const SIService = {
       O: RefreshDataResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc vcassist.services.sis.v1.SIService.RefreshDataStream
+     */
+    refreshDataStream: {
+      name: "RefreshDataStream",
+      I: RefreshDataStreamRequest,
+      O: RefreshDataStreamResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc vcassist.services.sis.v1.SIService.GetChanges
+     */
+    getChanges: {
+      name: "GetChanges",
+      I: GetChangesRequest,
+      O: GetChangesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.sis.v1.SIService.CalculateGrade
+     */
+    calculateGrade: {
+      name: "CalculateGrade",
+      I: CalculateGradeRequest,
+      O: CalculateGradeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.sis.v1.SIService.GetTranscript
+     */
+    getTranscript: {
+      name: "GetTranscript",
+      I: GetTranscriptRequest,
+      O: GetTranscriptResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,9 +4,181 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { CredentialStatus, OAuthTokenProvision, UsernamePasswordProvision } from "../../keychain/v1/auth_flow_pb.js";
-import { Bulletin, CourseData, SchoolData, StudentProfile } from "./data_pb.js";
+import { AssignmentData, Bulletin, CourseData, SchoolData, StudentProfile } from "./data_pb.js";
+
+/**
+ * RefreshDataStream
+ *
+ * @generated from enum vcassist.services.sis.v1.RefreshPhase
+ */
+export enum RefreshPhase {
+  /**
+   * fetching the student's powerschool credentials from the keychain
+   *
+   * @generated from enum value: PHASE_KEYCHAIN = 0;
+   */
+  PHASE_KEYCHAIN = 0,
+
+  /**
+   * logging into powerschool
+   *
+   * @generated from enum value: PHASE_LOGIN = 1;
+   */
+  PHASE_LOGIN = 1,
+
+  /**
+   * fetching the student's profile
+   *
+   * @generated from enum value: PHASE_GET_ALL_STUDENTS = 2;
+   */
+  PHASE_GET_ALL_STUDENTS = 2,
+
+  /**
+   * fetching courses, assignments and grades
+   *
+   * @generated from enum value: PHASE_GET_STUDENT_DATA = 3;
+   */
+  PHASE_GET_STUDENT_DATA = 3,
+
+  /**
+   * fetching the course meetings for the current week
+   *
+   * @generated from enum value: PHASE_GET_COURSE_MEETINGS = 4;
+   */
+  PHASE_GET_COURSE_MEETINGS = 4,
+
+  /**
+   * adding historical grade snapshots
+   *
+   * @generated from enum value: PHASE_GRADE_SNAPSHOTS = 5;
+   */
+  PHASE_GRADE_SNAPSHOTS = 5,
+
+  /**
+   * linking courses to their assignment category weights
+   *
+   * @generated from enum value: PHASE_LINK_WEIGHTS = 6;
+   */
+  PHASE_LINK_WEIGHTS = 6,
+
+  /**
+   * saving the new data
+   *
+   * @generated from enum value: PHASE_CACHE = 7;
+   */
+  PHASE_CACHE = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RefreshPhase)
+proto3.util.setEnumType(RefreshPhase, "vcassist.services.sis.v1.RefreshPhase", [
+  { no: 0, name: "PHASE_KEYCHAIN" },
+  { no: 1, name: "PHASE_LOGIN" },
+  { no: 2, name: "PHASE_GET_ALL_STUDENTS" },
+  { no: 3, name: "PHASE_GET_STUDENT_DATA" },
+  { no: 4, name: "PHASE_GET_COURSE_MEETINGS" },
+  { no: 5, name: "PHASE_GRADE_SNAPSHOTS" },
+  { no: 6, name: "PHASE_LINK_WEIGHTS" },
+  { no: 7, name: "PHASE_CACHE" },
+]);
+
+/**
+ * @generated from enum vcassist.services.sis.v1.PhaseStatus
+ */
+export enum PhaseStatus {
+  /**
+   * @generated from enum value: STATUS_STARTED = 0;
+   */
+  STATUS_STARTED = 0,
+
+  /**
+   * @generated from enum value: STATUS_COMPLETED = 1;
+   */
+  STATUS_COMPLETED = 1,
+
+  /**
+   * some phases (ex. PHASE_GET_COURSE_MEETINGS) are not required for
+   * a refresh to succeed, so a failed phase does not necessarily mean
+   * the refresh has failed
+   *
+   * @generated from enum value: STATUS_FAILED = 2;
+   */
+  STATUS_FAILED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PhaseStatus)
+proto3.util.setEnumType(PhaseStatus, "vcassist.services.sis.v1.PhaseStatus", [
+  { no: 0, name: "STATUS_STARTED" },
+  { no: 1, name: "STATUS_COMPLETED" },
+  { no: 2, name: "STATUS_FAILED" },
+]);
+
+/**
+ * GetChanges
+ *
+ * @generated from enum vcassist.services.sis.v1.ChangeType
+ */
+export enum ChangeType {
+  /**
+   * @generated from enum value: CHANGE_NEW_ASSIGNMENT = 0;
+   */
+  CHANGE_NEW_ASSIGNMENT = 0,
+
+  /**
+   * an assignment that previously had no score now has one
+   *
+   * @generated from enum value: CHANGE_SCORE_POSTED = 1;
+   */
+  CHANGE_SCORE_POSTED = 1,
+
+  /**
+   * the score of an assignment was modified
+   *
+   * @generated from enum value: CHANGE_SCORE_CHANGED = 2;
+   */
+  CHANGE_SCORE_CHANGED = 2,
+
+  /**
+   * @generated from enum value: CHANGE_MARKED_MISSING = 3;
+   */
+  CHANGE_MARKED_MISSING = 3,
+
+  /**
+   * @generated from enum value: CHANGE_MARKED_LATE = 4;
+   */
+  CHANGE_MARKED_LATE = 4,
+
+  /**
+   * the overall grade of a course went up or down
+   *
+   * @generated from enum value: CHANGE_OVERALL_GRADE = 5;
+   */
+  CHANGE_OVERALL_GRADE = 5,
+
+  /**
+   * the due date of an assignment was moved
+   *
+   * @generated from enum value: CHANGE_DUE_DATE_CHANGED = 6;
+   */
+  CHANGE_DUE_DATE_CHANGED = 6,
+
+  /**
+   * an assignment that previously had a score no longer has one
+   *
+   * @generated from enum value: CHANGE_SCORE_REMOVED = 7;
+   */
+  CHANGE_SCORE_REMOVED = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ChangeType)
+proto3.util.setEnumType(ChangeType, "vcassist.services.sis.v1.ChangeType", [
+  { no: 0, name: "CHANGE_NEW_ASSIGNMENT" },
+  { no: 1, name: "CHANGE_SCORE_POSTED" },
+  { no: 2, name: "CHANGE_SCORE_CHANGED" },
+  { no: 3, name: "CHANGE_MARKED_MISSING" },
+  { no: 4, name: "CHANGE_MARKED_LATE" },
+  { no: 5, name: "CHANGE_OVERALL_GRADE" },
+  { no: 6, name: "CHANGE_DUE_DATE_CHANGED" },
+  { no: 7, name: "CHANGE_SCORE_REMOVED" },
+]);
 
 /**
  * GetCredentialStatus
@@ -356,3 +528,791 @@ export class RefreshDataResponse extends Message<RefreshDataResponse> {
   }
 }
 
+/**
+ * @generated from message vcassist.services.sis.v1.RefreshPhaseEvent
+ */
+export class RefreshPhaseEvent extends Message<RefreshPhaseEvent> {
+  /**
+   * @generated from field: vcassist.services.sis.v1.RefreshPhase phase = 1;
+   */
+  phase = RefreshPhase.PHASE_KEYCHAIN;
+
+  /**
+   * @generated from field: vcassist.services.sis.v1.PhaseStatus status = 2;
+   */
+  status = PhaseStatus.STATUS_STARTED;
+
+  /**
+   * this will only be set when status is STATUS_FAILED
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  /**
+   * how much of the refresh is done (0-100), a phase counts as done once
+   * it has completed or failed
+   *
+   * @generated from field: int32 percent = 4;
+   */
+  percent = 0;
+
+  constructor(data?: PartialMessage<RefreshPhaseEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.RefreshPhaseEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "enum", T: proto3.getEnumType(RefreshPhase) },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(PhaseStatus) },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "percent", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshPhaseEvent {
+    return new RefreshPhaseEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshPhaseEvent {
+    return new RefreshPhaseEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshPhaseEvent {
+    return new RefreshPhaseEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshPhaseEvent | PlainMessage<RefreshPhaseEvent> | undefined, b: RefreshPhaseEvent | PlainMessage<RefreshPhaseEvent> | undefined): boolean {
+    return proto3.util.equals(RefreshPhaseEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.RefreshDataStreamRequest
+ */
+export class RefreshDataStreamRequest extends Message<RefreshDataStreamRequest> {
+  constructor(data?: PartialMessage<RefreshDataStreamRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.RefreshDataStreamRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshDataStreamRequest {
+    return new RefreshDataStreamRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshDataStreamRequest {
+    return new RefreshDataStreamRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshDataStreamRequest {
+    return new RefreshDataStreamRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshDataStreamRequest | PlainMessage<RefreshDataStreamRequest> | undefined, b: RefreshDataStreamRequest | PlainMessage<RefreshDataStreamRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshDataStreamRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.RefreshDataStreamResponse
+ */
+export class RefreshDataStreamResponse extends Message<RefreshDataStreamResponse> {
+  /**
+   * @generated from oneof vcassist.services.sis.v1.RefreshDataStreamResponse.event
+   */
+  event: {
+    /**
+     * @generated from field: vcassist.services.sis.v1.RefreshPhaseEvent phase = 1;
+     */
+    value: RefreshPhaseEvent;
+    case: "phase";
+  } | {
+    /**
+     * this is the last message sent if the refresh succeeds
+     *
+     * @generated from field: vcassist.services.sis.v1.Data data = 2;
+     */
+    value: Data;
+    case: "data";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<RefreshDataStreamResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.RefreshDataStreamResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "message", T: RefreshPhaseEvent, oneof: "event" },
+    { no: 2, name: "data", kind: "message", T: Data, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshDataStreamResponse {
+    return new RefreshDataStreamResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshDataStreamResponse {
+    return new RefreshDataStreamResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshDataStreamResponse {
+    return new RefreshDataStreamResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshDataStreamResponse | PlainMessage<RefreshDataStreamResponse> | undefined, b: RefreshDataStreamResponse | PlainMessage<RefreshDataStreamResponse> | undefined): boolean {
+    return proto3.util.equals(RefreshDataStreamResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.Change
+ */
+export class Change extends Message<Change> {
+  /**
+   * the time the change was detected
+   *
+   * @generated from field: int64 time = 1;
+   */
+  time = protoInt64.zero;
+
+  /**
+   * @generated from field: vcassist.services.sis.v1.ChangeType type = 2;
+   */
+  type = ChangeType.CHANGE_NEW_ASSIGNMENT;
+
+  /**
+   * @generated from field: string course_guid = 3;
+   */
+  courseGuid = "";
+
+  /**
+   * @generated from field: string course_name = 4;
+   */
+  courseName = "";
+
+  /**
+   * the title of the assignment, this will be empty for CHANGE_OVERALL_GRADE
+   *
+   * @generated from field: string assignment = 5;
+   */
+  assignment = "";
+
+  /**
+   * for assignment changes these are points earned, for CHANGE_OVERALL_GRADE
+   * these are percentages (0-100)
+   *
+   * @generated from field: optional float previous = 6;
+   */
+  previous?: number;
+
+  /**
+   * @generated from field: optional float current = 7;
+   */
+  current?: number;
+
+  /**
+   * unix timestamps, these are only set for CHANGE_DUE_DATE_CHANGED
+   *
+   * @generated from field: optional int64 previous_due_date = 8;
+   */
+  previousDueDate?: bigint;
+
+  /**
+   * @generated from field: optional int64 current_due_date = 9;
+   */
+  currentDueDate?: bigint;
+
+  constructor(data?: PartialMessage<Change>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.Change";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(ChangeType) },
+    { no: 3, name: "course_guid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "assignment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "previous", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 7, name: "current", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 8, name: "previous_due_date", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 9, name: "current_due_date", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Change {
+    return new Change().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Change {
+    return new Change().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Change {
+    return new Change().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Change | PlainMessage<Change> | undefined, b: Change | PlainMessage<Change> | undefined): boolean {
+    return proto3.util.equals(Change, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.GetChangesRequest
+ */
+export class GetChangesRequest extends Message<GetChangesRequest> {
+  /**
+   * unix timestamp, only changes detected after this time will be returned
+   *
+   * @generated from field: int64 since = 1;
+   */
+  since = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetChangesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.GetChangesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChangesRequest {
+    return new GetChangesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChangesRequest {
+    return new GetChangesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChangesRequest {
+    return new GetChangesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChangesRequest | PlainMessage<GetChangesRequest> | undefined, b: GetChangesRequest | PlainMessage<GetChangesRequest> | undefined): boolean {
+    return proto3.util.equals(GetChangesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.GetChangesResponse
+ */
+export class GetChangesResponse extends Message<GetChangesResponse> {
+  /**
+   * changes are sorted from oldest to newest
+   *
+   * @generated from field: repeated vcassist.services.sis.v1.Change changes = 1;
+   */
+  changes: Change[] = [];
+
+  constructor(data?: PartialMessage<GetChangesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.GetChangesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: Change, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChangesResponse {
+    return new GetChangesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChangesResponse {
+    return new GetChangesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChangesResponse {
+    return new GetChangesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChangesResponse | PlainMessage<GetChangesResponse> | undefined, b: GetChangesResponse | PlainMessage<GetChangesResponse> | undefined): boolean {
+    return proto3.util.equals(GetChangesResponse, a, b);
+  }
+}
+
+/**
+ * CalculateGrade
+ *
+ * @generated from message vcassist.services.sis.v1.NeededScoreQuery
+ */
+export class NeededScoreQuery extends Message<NeededScoreQuery> {
+  /**
+   * the category of the future assignment
+   *
+   * @generated from field: string category = 1;
+   */
+  category = "";
+
+  /**
+   * @generated from field: float points_possible = 2;
+   */
+  pointsPossible = 0;
+
+  /**
+   * the overall (weighted) grade to reach, this is a value from 0-100
+   *
+   * @generated from field: float target_grade = 3;
+   */
+  targetGrade = 0;
+
+  constructor(data?: PartialMessage<NeededScoreQuery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.NeededScoreQuery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "points_possible", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "target_grade", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NeededScoreQuery {
+    return new NeededScoreQuery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NeededScoreQuery {
+    return new NeededScoreQuery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NeededScoreQuery {
+    return new NeededScoreQuery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NeededScoreQuery | PlainMessage<NeededScoreQuery> | undefined, b: NeededScoreQuery | PlainMessage<NeededScoreQuery> | undefined): boolean {
+    return proto3.util.equals(NeededScoreQuery, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.CategoryGrade
+ */
+export class CategoryGrade extends Message<CategoryGrade> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * this is a value from 0-1, it is 0 for categories without a weight
+   *
+   * @generated from field: float weight = 2;
+   */
+  weight = 0;
+
+  /**
+   * @generated from field: float points_earned = 3;
+   */
+  pointsEarned = 0;
+
+  /**
+   * @generated from field: float points_possible = 4;
+   */
+  pointsPossible = 0;
+
+  /**
+   * this is a value from 0-100, it is unset if nothing in the category
+   * has been graded
+   *
+   * @generated from field: optional float grade = 5;
+   */
+  grade?: number;
+
+  constructor(data?: PartialMessage<CategoryGrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.CategoryGrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "weight", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "points_earned", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "points_possible", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 5, name: "grade", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CategoryGrade {
+    return new CategoryGrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CategoryGrade {
+    return new CategoryGrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CategoryGrade {
+    return new CategoryGrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CategoryGrade | PlainMessage<CategoryGrade> | undefined, b: CategoryGrade | PlainMessage<CategoryGrade> | undefined): boolean {
+    return proto3.util.equals(CategoryGrade, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.CalculateGradeRequest
+ */
+export class CalculateGradeRequest extends Message<CalculateGradeRequest> {
+  /**
+   * @generated from field: string course_guid = 1;
+   */
+  courseGuid = "";
+
+  /**
+   * assignments to add to the course
+   *
+   * @generated from field: repeated vcassist.services.sis.v1.AssignmentData hypothetical_assignments = 2;
+   */
+  hypotheticalAssignments: AssignmentData[] = [];
+
+  /**
+   * replacements for existing assignments, these are matched to existing
+   * assignments by title and due date
+   *
+   * @generated from field: repeated vcassist.services.sis.v1.AssignmentData edited_assignments = 3;
+   */
+  editedAssignments: AssignmentData[] = [];
+
+  /**
+   * if specified, the score needed on a future assignment to reach a
+   * target grade will be computed
+   *
+   * @generated from field: optional vcassist.services.sis.v1.NeededScoreQuery needed = 4;
+   */
+  needed?: NeededScoreQuery;
+
+  constructor(data?: PartialMessage<CalculateGradeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.CalculateGradeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "course_guid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "hypothetical_assignments", kind: "message", T: AssignmentData, repeated: true },
+    { no: 3, name: "edited_assignments", kind: "message", T: AssignmentData, repeated: true },
+    { no: 4, name: "needed", kind: "message", T: NeededScoreQuery, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CalculateGradeRequest {
+    return new CalculateGradeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CalculateGradeRequest {
+    return new CalculateGradeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CalculateGradeRequest {
+    return new CalculateGradeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CalculateGradeRequest | PlainMessage<CalculateGradeRequest> | undefined, b: CalculateGradeRequest | PlainMessage<CalculateGradeRequest> | undefined): boolean {
+    return proto3.util.equals(CalculateGradeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.CalculateGradeResponse
+ */
+export class CalculateGradeResponse extends Message<CalculateGradeResponse> {
+  /**
+   * these are values from 0-100, they are unset if nothing in the course
+   * has been graded
+   *
+   * @generated from field: optional float weighted_grade = 1;
+   */
+  weightedGrade?: number;
+
+  /**
+   * @generated from field: optional float unweighted_grade = 2;
+   */
+  unweightedGrade?: number;
+
+  /**
+   * @generated from field: repeated vcassist.services.sis.v1.CategoryGrade categories = 3;
+   */
+  categories: CategoryGrade[] = [];
+
+  /**
+   * the points needed on the future assignment, this can be more than
+   * points_possible if the target can't be reached or negative if the
+   * target will be reached regardless
+   *
+   * @generated from field: optional float needed_points = 4;
+   */
+  neededPoints?: number;
+
+  constructor(data?: PartialMessage<CalculateGradeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.CalculateGradeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "weighted_grade", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 2, name: "unweighted_grade", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 3, name: "categories", kind: "message", T: CategoryGrade, repeated: true },
+    { no: 4, name: "needed_points", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CalculateGradeResponse {
+    return new CalculateGradeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CalculateGradeResponse {
+    return new CalculateGradeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CalculateGradeResponse {
+    return new CalculateGradeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CalculateGradeResponse | PlainMessage<CalculateGradeResponse> | undefined, b: CalculateGradeResponse | PlainMessage<CalculateGradeResponse> | undefined): boolean {
+    return proto3.util.equals(CalculateGradeResponse, a, b);
+  }
+}
+
+/**
+ * GetTranscript
+ *
+ * @generated from message vcassist.services.sis.v1.TranscriptTerm
+ */
+export class TranscriptTerm extends Message<TranscriptTerm> {
+  /**
+   * @generated from field: string course_guid = 1;
+   */
+  courseGuid = "";
+
+  /**
+   * @generated from field: string course_name = 2;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: int64 start = 3;
+   */
+  start = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 end = 4;
+   */
+  end = protoInt64.zero;
+
+  /**
+   * this is a value from 0-100
+   *
+   * @generated from field: float percent = 5;
+   */
+  percent = 0;
+
+  /**
+   * @generated from field: bool in_progress = 6;
+   */
+  inProgress = false;
+
+  /**
+   * the points added to this term for the weighted GPA
+   *
+   * @generated from field: float gpa_bump = 7;
+   */
+  gpaBump = 0;
+
+  constructor(data?: PartialMessage<TranscriptTerm>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.TranscriptTerm";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "course_guid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "percent", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "in_progress", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "gpa_bump", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptTerm {
+    return new TranscriptTerm().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptTerm {
+    return new TranscriptTerm().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptTerm {
+    return new TranscriptTerm().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptTerm | PlainMessage<TranscriptTerm> | undefined, b: TranscriptTerm | PlainMessage<TranscriptTerm> | undefined): boolean {
+    return proto3.util.equals(TranscriptTerm, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.TranscriptYear
+ */
+export class TranscriptYear extends Message<TranscriptYear> {
+  /**
+   * @generated from field: int32 start_year = 1;
+   */
+  startYear = 0;
+
+  /**
+   * @generated from field: int32 end_year = 2;
+   */
+  endYear = 0;
+
+  /**
+   * @generated from field: repeated vcassist.services.sis.v1.TranscriptTerm terms = 3;
+   */
+  terms: TranscriptTerm[] = [];
+
+  /**
+   * GPAs only include terms that are not in progress, they are unset if
+   * there are no such terms
+   *
+   * @generated from field: optional float weighted_gpa = 4;
+   */
+  weightedGpa?: number;
+
+  /**
+   * @generated from field: optional float unweighted_gpa = 5;
+   */
+  unweightedGpa?: number;
+
+  constructor(data?: PartialMessage<TranscriptYear>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.TranscriptYear";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "end_year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "terms", kind: "message", T: TranscriptTerm, repeated: true },
+    { no: 4, name: "weighted_gpa", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 5, name: "unweighted_gpa", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptYear {
+    return new TranscriptYear().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptYear {
+    return new TranscriptYear().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptYear {
+    return new TranscriptYear().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptYear | PlainMessage<TranscriptYear> | undefined, b: TranscriptYear | PlainMessage<TranscriptYear> | undefined): boolean {
+    return proto3.util.equals(TranscriptYear, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.GetTranscriptRequest
+ */
+export class GetTranscriptRequest extends Message<GetTranscriptRequest> {
+  constructor(data?: PartialMessage<GetTranscriptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.GetTranscriptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTranscriptRequest {
+    return new GetTranscriptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTranscriptRequest {
+    return new GetTranscriptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTranscriptRequest {
+    return new GetTranscriptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTranscriptRequest | PlainMessage<GetTranscriptRequest> | undefined, b: GetTranscriptRequest | PlainMessage<GetTranscriptRequest> | undefined): boolean {
+    return proto3.util.equals(GetTranscriptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.sis.v1.GetTranscriptResponse
+ */
+export class GetTranscriptResponse extends Message<GetTranscriptResponse> {
+  /**
+   * years are sorted from oldest to newest
+   *
+   * @generated from field: repeated vcassist.services.sis.v1.TranscriptYear years = 1;
+   */
+  years: TranscriptYear[] = [];
+
+  /**
+   * the cumulative GPAs across all years
+   *
+   * @generated from field: optional float weighted_gpa = 2;
+   */
+  weightedGpa?: number;
+
+  /**
+   * @generated from field: optional float unweighted_gpa = 3;
+   */
+  unweightedGpa?: number;
+
+  constructor(data?: PartialMessage<GetTranscriptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.GetTranscriptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "years", kind: "message", T: TranscriptYear, repeated: true },
+    { no: 2, name: "weighted_gpa", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 3, name: "unweighted_gpa", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTranscriptResponse {
+    return new GetTranscriptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTranscriptResponse {
+    return new GetTranscriptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTranscriptResponse {
+    return new GetTranscriptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTranscriptResponse | PlainMessage<GetTranscriptResponse> | undefined, b: GetTranscriptResponse | PlainMessage<GetTranscriptResponse> | undefined): boolean {
+    return proto3.util.equals(GetTranscriptResponse, a, b);
+  }
+}
+
@@ -236,6 +236,63 @@ export class GradeSnapshot extends Message<GradeSnapshot> {
   }
 }
 
+/**
+ * @generated from message vcassist.services.sis.v1.TermGrade
+ */
+export class TermGrade extends Message<TermGrade> {
+  /**
+   * @generated from field: int64 start = 1;
+   */
+  start = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 end = 2;
+   */
+  end = protoInt64.zero;
+
+  /**
+   * this is a value from 0-100, it is unset if the term has no final grade
+   *
+   * @generated from field: optional float percent = 3;
+   */
+  percent?: number;
+
+  /**
+   * @generated from field: bool in_progress = 4;
+   */
+  inProgress = false;
+
+  constructor(data?: PartialMessage<TermGrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.sis.v1.TermGrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "percent", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 4, name: "in_progress", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TermGrade {
+    return new TermGrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TermGrade {
+    return new TermGrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TermGrade {
+    return new TermGrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TermGrade | PlainMessage<TermGrade> | undefined, b: TermGrade | PlainMessage<TermGrade> | undefined): boolean {
+    return proto3.util.equals(TermGrade, a, b);
+  }
+}
+
 /**
  * @generated from message vcassist.services.sis.v1.CourseData
  */
@@ -305,6 +362,13 @@ export class CourseData extends Message<CourseData> {
    */
   assignmentCategories: AssignmentCategory[] = [];
 
+  /**
+   * the final grades of every term the course is a part of
+   *
+   * @generated from field: repeated vcassist.services.sis.v1.TermGrade terms = 14;
+   */
+  terms: TermGrade[] = [];
+
   constructor(data?: PartialMessage<CourseData>) {
     super();
     proto3.util.initPartial(data, this);
@@ -326,6 +390,7 @@ export class CourseData extends Message<CourseData> {
     { no: 11, name: "meetings", kind: "message", T: Meeting, repeated: true },
     { no: 12, name: "snapshots", kind: "message", T: GradeSnapshot, repeated: true },
     { no: 13, name: "assignment_categories", kind: "message", T: AssignmentCategory, repeated: true },
+    { no: 14, name: "terms", kind: "message", T: TermGrade, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CourseData {
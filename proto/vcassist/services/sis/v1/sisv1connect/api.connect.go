@@ -43,6 +43,16 @@ const (
 	SIServiceGetDataProcedure = "/vcassist.services.sis.v1.SIService/GetData"
 	// SIServiceRefreshDataProcedure is the fully-qualified name of the SIService's RefreshData RPC.
 	SIServiceRefreshDataProcedure = "/vcassist.services.sis.v1.SIService/RefreshData"
+	// SIServiceRefreshDataStreamProcedure is the fully-qualified name of the SIService's
+	// RefreshDataStream RPC.
+	SIServiceRefreshDataStreamProcedure = "/vcassist.services.sis.v1.SIService/RefreshDataStream"
+	// SIServiceGetChangesProcedure is the fully-qualified name of the SIService's GetChanges RPC.
+	SIServiceGetChangesProcedure = "/vcassist.services.sis.v1.SIService/GetChanges"
+	// SIServiceCalculateGradeProcedure is the fully-qualified name of the SIService's CalculateGrade
+	// RPC.
+	SIServiceCalculateGradeProcedure = "/vcassist.services.sis.v1.SIService/CalculateGrade"
+	// SIServiceGetTranscriptProcedure is the fully-qualified name of the SIService's GetTranscript RPC.
+	SIServiceGetTranscriptProcedure = "/vcassist.services.sis.v1.SIService/GetTranscript"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -52,6 +62,10 @@ var (
 	sIServiceProvideCredentialMethodDescriptor   = sIServiceServiceDescriptor.Methods().ByName("ProvideCredential")
 	sIServiceGetDataMethodDescriptor             = sIServiceServiceDescriptor.Methods().ByName("GetData")
 	sIServiceRefreshDataMethodDescriptor         = sIServiceServiceDescriptor.Methods().ByName("RefreshData")
+	sIServiceRefreshDataStreamMethodDescriptor   = sIServiceServiceDescriptor.Methods().ByName("RefreshDataStream")
+	sIServiceGetChangesMethodDescriptor          = sIServiceServiceDescriptor.Methods().ByName("GetChanges")
+	sIServiceCalculateGradeMethodDescriptor      = sIServiceServiceDescriptor.Methods().ByName("CalculateGrade")
+	sIServiceGetTranscriptMethodDescriptor       = sIServiceServiceDescriptor.Methods().ByName("GetTranscript")
 )
 
 // SIServiceClient is a client for the vcassist.services.sis.v1.SIService service.
@@ -60,6 +74,10 @@ type SIServiceClient interface {
 	ProvideCredential(context.Context, *connect.Request[v1.ProvideCredentialRequest]) (*connect.Response[v1.ProvideCredentialResponse], error)
 	GetData(context.Context, *connect.Request[v1.GetDataRequest]) (*connect.Response[v1.GetDataResponse], error)
 	RefreshData(context.Context, *connect.Request[v1.RefreshDataRequest]) (*connect.Response[v1.RefreshDataResponse], error)
+	RefreshDataStream(context.Context, *connect.Request[v1.RefreshDataStreamRequest]) (*connect.ServerStreamForClient[v1.RefreshDataStreamResponse], error)
+	GetChanges(context.Context, *connect.Request[v1.GetChangesRequest]) (*connect.Response[v1.GetChangesResponse], error)
+	CalculateGrade(context.Context, *connect.Request[v1.CalculateGradeRequest]) (*connect.Response[v1.CalculateGradeResponse], error)
+	GetTranscript(context.Context, *connect.Request[v1.GetTranscriptRequest]) (*connect.Response[v1.GetTranscriptResponse], error)
 }
 
 // NewSIServiceClient constructs a client for the vcassist.services.sis.v1.SIService service. By
@@ -96,6 +114,30 @@ func NewSIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...c
 			connect.WithSchema(sIServiceRefreshDataMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		refreshDataStream: connect.NewClient[v1.RefreshDataStreamRequest, v1.RefreshDataStreamResponse](
+			httpClient,
+			baseURL+SIServiceRefreshDataStreamProcedure,
+			connect.WithSchema(sIServiceRefreshDataStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getChanges: connect.NewClient[v1.GetChangesRequest, v1.GetChangesResponse](
+			httpClient,
+			baseURL+SIServiceGetChangesProcedure,
+			connect.WithSchema(sIServiceGetChangesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		calculateGrade: connect.NewClient[v1.CalculateGradeRequest, v1.CalculateGradeResponse](
+			httpClient,
+			baseURL+SIServiceCalculateGradeProcedure,
+			connect.WithSchema(sIServiceCalculateGradeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTranscript: connect.NewClient[v1.GetTranscriptRequest, v1.GetTranscriptResponse](
+			httpClient,
+			baseURL+SIServiceGetTranscriptProcedure,
+			connect.WithSchema(sIServiceGetTranscriptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -105,6 +147,10 @@ type sIServiceClient struct {
 	provideCredential   *connect.Client[v1.ProvideCredentialRequest, v1.ProvideCredentialResponse]
 	getData             *connect.Client[v1.GetDataRequest, v1.GetDataResponse]
 	refreshData         *connect.Client[v1.RefreshDataRequest, v1.RefreshDataResponse]
+	refreshDataStream   *connect.Client[v1.RefreshDataStreamRequest, v1.RefreshDataStreamResponse]
+	getChanges          *connect.Client[v1.GetChangesRequest, v1.GetChangesResponse]
+	calculateGrade      *connect.Client[v1.CalculateGradeRequest, v1.CalculateGradeResponse]
+	getTranscript       *connect.Client[v1.GetTranscriptRequest, v1.GetTranscriptResponse]
 }
 
 // GetCredentialStatus calls vcassist.services.sis.v1.SIService.GetCredentialStatus.
@@ -127,12 +173,36 @@ func (c *sIServiceClient) RefreshData(ctx context.Context, req *connect.Request[
 	return c.refreshData.CallUnary(ctx, req)
 }
 
+// RefreshDataStream calls vcassist.services.sis.v1.SIService.RefreshDataStream.
+func (c *sIServiceClient) RefreshDataStream(ctx context.Context, req *connect.Request[v1.RefreshDataStreamRequest]) (*connect.ServerStreamForClient[v1.RefreshDataStreamResponse], error) {
+	return c.refreshDataStream.CallServerStream(ctx, req)
+}
+
+// GetChanges calls vcassist.services.sis.v1.SIService.GetChanges.
+func (c *sIServiceClient) GetChanges(ctx context.Context, req *connect.Request[v1.GetChangesRequest]) (*connect.Response[v1.GetChangesResponse], error) {
+	return c.getChanges.CallUnary(ctx, req)
+}
+
+// CalculateGrade calls vcassist.services.sis.v1.SIService.CalculateGrade.
+func (c *sIServiceClient) CalculateGrade(ctx context.Context, req *connect.Request[v1.CalculateGradeRequest]) (*connect.Response[v1.CalculateGradeResponse], error) {
+	return c.calculateGrade.CallUnary(ctx, req)
+}
+
+// GetTranscript calls vcassist.services.sis.v1.SIService.GetTranscript.
+func (c *sIServiceClient) GetTranscript(ctx context.Context, req *connect.Request[v1.GetTranscriptRequest]) (*connect.Response[v1.GetTranscriptResponse], error) {
+	return c.getTranscript.CallUnary(ctx, req)
+}
+
 // SIServiceHandler is an implementation of the vcassist.services.sis.v1.SIService service.
 type SIServiceHandler interface {
 	GetCredentialStatus(context.Context, *connect.Request[v1.GetCredentialStatusRequest]) (*connect.Response[v1.GetCredentialStatusResponse], error)
 	ProvideCredential(context.Context, *connect.Request[v1.ProvideCredentialRequest]) (*connect.Response[v1.ProvideCredentialResponse], error)
 	GetData(context.Context, *connect.Request[v1.GetDataRequest]) (*connect.Response[v1.GetDataResponse], error)
 	RefreshData(context.Context, *connect.Request[v1.RefreshDataRequest]) (*connect.Response[v1.RefreshDataResponse], error)
+	RefreshDataStream(context.Context, *connect.Request[v1.RefreshDataStreamRequest], *connect.ServerStream[v1.RefreshDataStreamResponse]) error
+	GetChanges(context.Context, *connect.Request[v1.GetChangesRequest]) (*connect.Response[v1.GetChangesResponse], error)
+	CalculateGrade(context.Context, *connect.Request[v1.CalculateGradeRequest]) (*connect.Response[v1.CalculateGradeResponse], error)
+	GetTranscript(context.Context, *connect.Request[v1.GetTranscriptRequest]) (*connect.Response[v1.GetTranscriptResponse], error)
 }
 
 // NewSIServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -165,6 +235,30 @@ func NewSIServiceHandler(svc SIServiceHandler, opts ...connect.HandlerOption) (s
 		connect.WithSchema(sIServiceRefreshDataMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	sIServiceRefreshDataStreamHandler := connect.NewServerStreamHandler(
+		SIServiceRefreshDataStreamProcedure,
+		svc.RefreshDataStream,
+		connect.WithSchema(sIServiceRefreshDataStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sIServiceGetChangesHandler := connect.NewUnaryHandler(
+		SIServiceGetChangesProcedure,
+		svc.GetChanges,
+		connect.WithSchema(sIServiceGetChangesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sIServiceCalculateGradeHandler := connect.NewUnaryHandler(
+		SIServiceCalculateGradeProcedure,
+		svc.CalculateGrade,
+		connect.WithSchema(sIServiceCalculateGradeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sIServiceGetTranscriptHandler := connect.NewUnaryHandler(
+		SIServiceGetTranscriptProcedure,
+		svc.GetTranscript,
+		connect.WithSchema(sIServiceGetTranscriptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/vcassist.services.sis.v1.SIService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SIServiceGetCredentialStatusProcedure:
@@ -175,6 +269,14 @@ func NewSIServiceHandler(svc SIServiceHandler, opts ...connect.HandlerOption) (s
 			sIServiceGetDataHandler.ServeHTTP(w, r)
 		case SIServiceRefreshDataProcedure:
 			sIServiceRefreshDataHandler.ServeHTTP(w, r)
+		case SIServiceRefreshDataStreamProcedure:
+			sIServiceRefreshDataStreamHandler.ServeHTTP(w, r)
+		case SIServiceGetChangesProcedure:
+			sIServiceGetChangesHandler.ServeHTTP(w, r)
+		case SIServiceCalculateGradeProcedure:
+			sIServiceCalculateGradeHandler.ServeHTTP(w, r)
+		case SIServiceGetTranscriptProcedure:
+			sIServiceGetTranscriptHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -199,3 +301,19 @@ func (UnimplementedSIServiceHandler) GetData(context.Context, *connect.Request[v
 func (UnimplementedSIServiceHandler) RefreshData(context.Context, *connect.Request[v1.RefreshDataRequest]) (*connect.Response[v1.RefreshDataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.sis.v1.SIService.RefreshData is not implemented"))
 }
+
+func (UnimplementedSIServiceHandler) RefreshDataStream(context.Context, *connect.Request[v1.RefreshDataStreamRequest], *connect.ServerStream[v1.RefreshDataStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.sis.v1.SIService.RefreshDataStream is not implemented"))
+}
+
+func (UnimplementedSIServiceHandler) GetChanges(context.Context, *connect.Request[v1.GetChangesRequest]) (*connect.Response[v1.GetChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.sis.v1.SIService.GetChanges is not implemented"))
+}
+
+func (UnimplementedSIServiceHandler) CalculateGrade(context.Context, *connect.Request[v1.CalculateGradeRequest]) (*connect.Response[v1.CalculateGradeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.sis.v1.SIService.CalculateGrade is not implemented"))
+}
+
+func (UnimplementedSIServiceHandler) GetTranscript(context.Context, *connect.Request[v1.GetTranscriptRequest]) (*connect.Response[v1.GetTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.sis.v1.SIService.GetTranscript is not implemented"))
+}
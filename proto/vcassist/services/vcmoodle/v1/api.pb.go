@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	v1 "vcassist-backend/proto/vcassist/services/sis/v1"
 )
 
 const (
@@ -20,6 +21,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GetAuthStatus
+type CredentialValidity int32
+
+const (
+	CredentialValidity_CREDENTIAL_UNKNOWN CredentialValidity = 0
+	CredentialValidity_CREDENTIAL_VALID   CredentialValidity = 1
+	CredentialValidity_CREDENTIAL_INVALID CredentialValidity = 2
+)
+
+// Enum value maps for CredentialValidity.
+var (
+	CredentialValidity_name = map[int32]string{
+		0: "CREDENTIAL_UNKNOWN",
+		1: "CREDENTIAL_VALID",
+		2: "CREDENTIAL_INVALID",
+	}
+	CredentialValidity_value = map[string]int32{
+		"CREDENTIAL_UNKNOWN": 0,
+		"CREDENTIAL_VALID":   1,
+		"CREDENTIAL_INVALID": 2,
+	}
+)
+
+func (x CredentialValidity) Enum() *CredentialValidity {
+	p := new(CredentialValidity)
+	*p = x
+	return p
+}
+
+func (x CredentialValidity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialValidity) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (CredentialValidity) Type() protoreflect.EnumType {
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[0]
+}
+
+func (x CredentialValidity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialValidity.Descriptor instead.
+func (CredentialValidity) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
 // GetCourses
 type ResourceType int32
 
@@ -57,11 +108,11 @@ func (x ResourceType) String() string {
 }
 
 func (ResourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[0].Descriptor()
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[1].Descriptor()
 }
 
 func (ResourceType) Type() protoreflect.EnumType {
-	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[0]
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[1]
 }
 
 func (x ResourceType) Number() protoreflect.EnumNumber {
@@ -70,10 +121,210 @@ func (x ResourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResourceType.Descriptor instead.
 func (ResourceType) EnumDescriptor() ([]byte, []int) {
-	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{0}
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+// SearchMoodle
+type SearchResultKind int32
+
+const (
+	SearchResultKind_SEARCH_RESULT_SECTION  SearchResultKind = 0
+	SearchResultKind_SEARCH_RESULT_RESOURCE SearchResultKind = 1
+	SearchResultKind_SEARCH_RESULT_CHAPTER  SearchResultKind = 2
+)
+
+// Enum value maps for SearchResultKind.
+var (
+	SearchResultKind_name = map[int32]string{
+		0: "SEARCH_RESULT_SECTION",
+		1: "SEARCH_RESULT_RESOURCE",
+		2: "SEARCH_RESULT_CHAPTER",
+	}
+	SearchResultKind_value = map[string]int32{
+		"SEARCH_RESULT_SECTION":  0,
+		"SEARCH_RESULT_RESOURCE": 1,
+		"SEARCH_RESULT_CHAPTER":  2,
+	}
+)
+
+func (x SearchResultKind) Enum() *SearchResultKind {
+	p := new(SearchResultKind)
+	*p = x
+	return p
+}
+
+func (x SearchResultKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResultKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchResultKind) Type() protoreflect.EnumType {
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[2]
+}
+
+func (x SearchResultKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResultKind.Descriptor instead.
+func (SearchResultKind) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
+// GetCourseUpdates
+type ContentKind int32
+
+const (
+	ContentKind_CONTENT_SECTION  ContentKind = 0
+	ContentKind_CONTENT_RESOURCE ContentKind = 1
+	ContentKind_CONTENT_CHAPTER  ContentKind = 2
+)
+
+// Enum value maps for ContentKind.
+var (
+	ContentKind_name = map[int32]string{
+		0: "CONTENT_SECTION",
+		1: "CONTENT_RESOURCE",
+		2: "CONTENT_CHAPTER",
+	}
+	ContentKind_value = map[string]int32{
+		"CONTENT_SECTION":  0,
+		"CONTENT_RESOURCE": 1,
+		"CONTENT_CHAPTER":  2,
+	}
+)
+
+func (x ContentKind) Enum() *ContentKind {
+	p := new(ContentKind)
+	*p = x
+	return p
+}
+
+func (x ContentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[3].Descriptor()
+}
+
+func (ContentKind) Type() protoreflect.EnumType {
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[3]
+}
+
+func (x ContentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentKind.Descriptor instead.
+func (ContentKind) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+type UpdateType int32
+
+const (
+	UpdateType_UPDATE_ADDED   UpdateType = 0
+	UpdateType_UPDATE_CHANGED UpdateType = 1
+	UpdateType_UPDATE_REMOVED UpdateType = 2
+)
+
+// Enum value maps for UpdateType.
+var (
+	UpdateType_name = map[int32]string{
+		0: "UPDATE_ADDED",
+		1: "UPDATE_CHANGED",
+		2: "UPDATE_REMOVED",
+	}
+	UpdateType_value = map[string]int32{
+		"UPDATE_ADDED":   0,
+		"UPDATE_CHANGED": 1,
+		"UPDATE_REMOVED": 2,
+	}
+)
+
+func (x UpdateType) Enum() *UpdateType {
+	p := new(UpdateType)
+	*p = x
+	return p
+}
+
+func (x UpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[4].Descriptor()
+}
+
+func (UpdateType) Type() protoreflect.EnumType {
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[4]
+}
+
+func (x UpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateType.Descriptor instead.
+func (UpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{4}
+}
+
+// GetDeadlines
+type DeadlineType int32
+
+const (
+	DeadlineType_DEADLINE_ASSIGNMENT DeadlineType = 0
+	DeadlineType_DEADLINE_QUIZ       DeadlineType = 1
+	// events from the upcoming events calendar that aren't an assignment
+	// or quiz
+	DeadlineType_DEADLINE_EVENT DeadlineType = 2
+)
+
+// Enum value maps for DeadlineType.
+var (
+	DeadlineType_name = map[int32]string{
+		0: "DEADLINE_ASSIGNMENT",
+		1: "DEADLINE_QUIZ",
+		2: "DEADLINE_EVENT",
+	}
+	DeadlineType_value = map[string]int32{
+		"DEADLINE_ASSIGNMENT": 0,
+		"DEADLINE_QUIZ":       1,
+		"DEADLINE_EVENT":      2,
+	}
+)
+
+func (x DeadlineType) Enum() *DeadlineType {
+	p := new(DeadlineType)
+	*p = x
+	return p
+}
+
+func (x DeadlineType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadlineType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (DeadlineType) Type() protoreflect.EnumType {
+	return &file_vcassist_services_vcmoodle_v1_api_proto_enumTypes[5]
+}
+
+func (x DeadlineType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadlineType.Descriptor instead.
+func (DeadlineType) EnumDescriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{5}
 }
 
-// GetAuthStatus
 type GetAuthStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -118,6 +369,12 @@ type GetAuthStatusResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Provided bool `protobuf:"varint,1,opt,name=provided,proto3" json:"provided,omitempty"`
+	// whether the credentials worked the last time they were used to login
+	Validity CredentialValidity `protobuf:"varint,2,opt,name=validity,proto3,enum=vcassist.services.vcmoodle.v1.CredentialValidity" json:"validity,omitempty"`
+	// unix timestamp, this is 0 if the credentials have never been checked
+	LastChecked int64 `protobuf:"varint,3,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	// why the credentials are invalid, this is empty if they aren't
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *GetAuthStatusResponse) Reset() {
@@ -159,6 +416,27 @@ func (x *GetAuthStatusResponse) GetProvided() bool {
 	return false
 }
 
+func (x *GetAuthStatusResponse) GetValidity() CredentialValidity {
+	if x != nil {
+		return x.Validity
+	}
+	return CredentialValidity_CREDENTIAL_UNKNOWN
+}
+
+func (x *GetAuthStatusResponse) GetLastChecked() int64 {
+	if x != nil {
+		return x.LastChecked
+	}
+	return 0
+}
+
+func (x *GetAuthStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // ProvideUsernamePassword
 type ProvideUsernamePasswordRequest struct {
 	state         protoimpl.MessageState
@@ -752,6 +1030,9 @@ type GetFileContentRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// the etag of a previously fetched copy of the file, if it is still
+	// current the file is not sent again
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *GetFileContentRequest) Reset() {
@@ -793,12 +1074,24 @@ func (x *GetFileContentRequest) GetUrl() string {
 	return ""
 }
 
+func (x *GetFileContentRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type GetFileContentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	File        []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Etag        string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// when this is true the etag in the request is still current and file
+	// is empty
+	NotModified bool `protobuf:"varint,4,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 }
 
 func (x *GetFileContentResponse) Reset() {
@@ -840,15 +1133,43 @@ func (x *GetFileContentResponse) GetFile() []byte {
 	return nil
 }
 
-// RefreshCourses
-type RefreshCoursesRequest struct {
+func (x *GetFileContentResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetFileContentResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetFileContentResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// DownloadFile
+type DownloadFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// the byte offset to start sending the file from, this can be used to
+	// resume an interrupted download
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// the maximum number of bytes to send, 0 means until the end of the file
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (x *RefreshCoursesRequest) Reset() {
-	*x = RefreshCoursesRequest{}
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +1177,13 @@ func (x *RefreshCoursesRequest) Reset() {
 	}
 }
 
-func (x *RefreshCoursesRequest) String() string {
+func (x *DownloadFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshCoursesRequest) ProtoMessage() {}
+func (*DownloadFileRequest) ProtoMessage() {}
 
-func (x *RefreshCoursesRequest) ProtoReflect() protoreflect.Message {
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,21 +1195,53 @@ func (x *RefreshCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshCoursesRequest.ProtoReflect.Descriptor instead.
-func (*RefreshCoursesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
 	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{14}
 }
 
-type RefreshCoursesResponse struct {
+func (x *DownloadFileRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type FileMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
-}
-
-func (x *RefreshCoursesResponse) Reset() {
-	*x = RefreshCoursesResponse{}
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// this may be empty if moodle does not give the file a name
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// the size of the entire file in bytes regardless of the requested
+	// range, -1 if it is unknown
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// the offset of the first chunk in the file
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// this changes whenever the file does, so it can be used to check that
+	// a download is resumed on the same file. it may be empty for files
+	// that are not mirrored.
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *FileMetadata) Reset() {
+	*x = FileMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1249,13 @@ func (x *RefreshCoursesResponse) Reset() {
 	}
 }
 
-func (x *RefreshCoursesResponse) String() string {
+func (x *FileMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshCoursesResponse) ProtoMessage() {}
+func (*FileMetadata) ProtoMessage() {}
 
-func (x *RefreshCoursesResponse) ProtoReflect() protoreflect.Message {
+func (x *FileMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,27 +1267,60 @@ func (x *RefreshCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshCoursesResponse.ProtoReflect.Descriptor instead.
-func (*RefreshCoursesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
+func (*FileMetadata) Descriptor() ([]byte, []int) {
 	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RefreshCoursesResponse) GetCourses() []*Course {
+func (x *FileMetadata) GetContentType() string {
 	if x != nil {
-		return x.Courses
+		return x.ContentType
 	}
-	return nil
+	return ""
 }
 
-// GetSession
-type GetSessionRequest struct {
+func (x *FileMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DownloadFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//
+	//	*DownloadFileResponse_Metadata
+	//	*DownloadFileResponse_Chunk
+	Content isDownloadFileResponse_Content `protobuf_oneof:"content"`
 }
 
-func (x *GetSessionRequest) Reset() {
-	*x = GetSessionRequest{}
+func (x *DownloadFileResponse) Reset() {
+	*x = DownloadFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,13 +1328,13 @@ func (x *GetSessionRequest) Reset() {
 	}
 }
 
-func (x *GetSessionRequest) String() string {
+func (x *DownloadFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionRequest) ProtoMessage() {}
+func (*DownloadFileResponse) ProtoMessage() {}
 
-func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,36 +1346,1160 @@ func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
 	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{16}
 }
 
-type GetSessionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *DownloadFileResponse) GetContent() isDownloadFileResponse_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
 
-	Cookies string `protobuf:"bytes,1,opt,name=cookies,proto3" json:"cookies,omitempty"`
+func (x *DownloadFileResponse) GetMetadata() *FileMetadata {
+	if x, ok := x.GetContent().(*DownloadFileResponse_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *GetSessionResponse) Reset() {
-	*x = GetSessionResponse{}
+func (x *DownloadFileResponse) GetChunk() []byte {
+	if x, ok := x.GetContent().(*DownloadFileResponse_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isDownloadFileResponse_Content interface {
+	isDownloadFileResponse_Content()
+}
+
+type DownloadFileResponse_Metadata struct {
+	// this is always sent first and only once
+	Metadata *FileMetadata `protobuf:"bytes,2,opt,name=metadata,proto3,oneof"`
+}
+
+type DownloadFileResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadFileResponse_Metadata) isDownloadFileResponse_Content() {}
+
+func (*DownloadFileResponse_Chunk) isDownloadFileResponse_Content() {}
+
+// RefreshCourses
+type RefreshCoursesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshCoursesRequest) Reset() {
+	*x = RefreshCoursesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCoursesRequest) ProtoMessage() {}
+
+func (x *RefreshCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCoursesRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+type RefreshCoursesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+}
+
+func (x *RefreshCoursesResponse) Reset() {
+	*x = RefreshCoursesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCoursesResponse) ProtoMessage() {}
+
+func (x *RefreshCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCoursesResponse.ProtoReflect.Descriptor instead.
+func (*RefreshCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefreshCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+// GetSession
+type GetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+type GetSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cookies string `protobuf:"bytes,1,opt,name=cookies,proto3" json:"cookies,omitempty"`
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSessionResponse) GetCookies() string {
+	if x != nil {
+		return x.Cookies
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       SearchResultKind `protobuf:"varint,1,opt,name=kind,proto3,enum=vcassist.services.vcmoodle.v1.SearchResultKind" json:"kind,omitempty"`
+	CourseId   int64            `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseName string           `protobuf:"bytes,3,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	SectionIdx int64            `protobuf:"varint,4,opt,name=section_idx,json=sectionIdx,proto3" json:"section_idx,omitempty"`
+	// this will be 0 for SEARCH_RESULT_SECTION
+	ResourceIdx int64 `protobuf:"varint,5,opt,name=resource_idx,json=resourceIdx,proto3" json:"resource_idx,omitempty"`
+	// this will be 0 for results that are not SEARCH_RESULT_CHAPTER
+	ChapterId int64  `protobuf:"varint,6,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	Title     string `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	// an excerpt of the matched text content, matched terms are wrapped in
+	// <mark></mark>, this will be empty if only the title matched
+	Snippet string `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Url     string `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchResult) GetKind() SearchResultKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SearchResultKind_SEARCH_RESULT_SECTION
+}
+
+func (x *SearchResult) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSectionIdx() int64 {
+	if x != nil {
+		return x.SectionIdx
+	}
+	return 0
+}
+
+func (x *SearchResult) GetResourceIdx() int64 {
+	if x != nil {
+		return x.ResourceIdx
+	}
+	return 0
+}
+
+func (x *SearchResult) GetChapterId() int64 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SearchMoodleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// the maximum number of results to return, defaults to 25 if unset
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchMoodleRequest) Reset() {
+	*x = SearchMoodleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMoodleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoodleRequest) ProtoMessage() {}
+
+func (x *SearchMoodleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoodleRequest.ProtoReflect.Descriptor instead.
+func (*SearchMoodleRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchMoodleRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMoodleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchMoodleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are sorted from most to least relevant
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchMoodleResponse) Reset() {
+	*x = SearchMoodleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMoodleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoodleResponse) ProtoMessage() {}
+
+func (x *SearchMoodleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoodleResponse.ProtoReflect.Descriptor instead.
+func (*SearchMoodleResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchMoodleResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CourseUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the time the update was found by a scrape
+	Time       int64       `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type       UpdateType  `protobuf:"varint,2,opt,name=type,proto3,enum=vcassist.services.vcmoodle.v1.UpdateType" json:"type,omitempty"`
+	Kind       ContentKind `protobuf:"varint,3,opt,name=kind,proto3,enum=vcassist.services.vcmoodle.v1.ContentKind" json:"kind,omitempty"`
+	CourseId   int64       `protobuf:"varint,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseName string      `protobuf:"bytes,5,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	SectionIdx int64       `protobuf:"varint,6,opt,name=section_idx,json=sectionIdx,proto3" json:"section_idx,omitempty"`
+	// this will be 0 for CONTENT_SECTION
+	ResourceIdx int64 `protobuf:"varint,7,opt,name=resource_idx,json=resourceIdx,proto3" json:"resource_idx,omitempty"`
+	// this will be 0 for content that is not CONTENT_CHAPTER
+	ChapterId int64 `protobuf:"varint,8,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// this will be empty for html areas
+	Name string `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	// this will be empty for UPDATE_REMOVED
+	Url string `protobuf:"bytes,10,opt,name=url,proto3" json:"url,omitempty"`
+	// for UPDATE_CHANGED chapters and html areas, this is the new html
+	// content with removed text wrapped in <del> and added text wrapped in
+	// <ins>, otherwise it is empty
+	DiffHtml string `protobuf:"bytes,11,opt,name=diff_html,json=diffHtml,proto3" json:"diff_html,omitempty"`
+}
+
+func (x *CourseUpdate) Reset() {
+	*x = CourseUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseUpdate) ProtoMessage() {}
+
+func (x *CourseUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseUpdate.ProtoReflect.Descriptor instead.
+func (*CourseUpdate) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CourseUpdate) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *CourseUpdate) GetType() UpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateType_UPDATE_ADDED
+}
+
+func (x *CourseUpdate) GetKind() ContentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ContentKind_CONTENT_SECTION
+}
+
+func (x *CourseUpdate) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CourseUpdate) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *CourseUpdate) GetSectionIdx() int64 {
+	if x != nil {
+		return x.SectionIdx
+	}
+	return 0
+}
+
+func (x *CourseUpdate) GetResourceIdx() int64 {
+	if x != nil {
+		return x.ResourceIdx
+	}
+	return 0
+}
+
+func (x *CourseUpdate) GetChapterId() int64 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CourseUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseUpdate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CourseUpdate) GetDiffHtml() string {
+	if x != nil {
+		return x.DiffHtml
+	}
+	return ""
+}
+
+type GetCourseUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix timestamp, only updates found after this time will be returned
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *GetCourseUpdatesRequest) Reset() {
+	*x = GetCourseUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCourseUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseUpdatesRequest) ProtoMessage() {}
+
+func (x *GetCourseUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCourseUpdatesRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type GetCourseUpdatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// updates are sorted from oldest to newest
+	Updates []*CourseUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *GetCourseUpdatesResponse) Reset() {
+	*x = GetCourseUpdatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCourseUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseUpdatesResponse) ProtoMessage() {}
+
+func (x *GetCourseUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCourseUpdatesResponse) GetUpdates() []*CourseUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+// GetAgenda
+type AgendaLessonPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId   int64    `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseName string   `protobuf:"bytes,2,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	Chapter    *Chapter `protobuf:"bytes,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
+}
+
+func (x *AgendaLessonPlan) Reset() {
+	*x = AgendaLessonPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgendaLessonPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaLessonPlan) ProtoMessage() {}
+
+func (x *AgendaLessonPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaLessonPlan.ProtoReflect.Descriptor instead.
+func (*AgendaLessonPlan) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AgendaLessonPlan) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *AgendaLessonPlan) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *AgendaLessonPlan) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+type AgendaMeeting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseGuid string      `protobuf:"bytes,1,opt,name=course_guid,json=courseGuid,proto3" json:"course_guid,omitempty"`
+	CourseName string      `protobuf:"bytes,2,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	Period     string      `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Room       string      `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	Meeting    *v1.Meeting `protobuf:"bytes,5,opt,name=meeting,proto3" json:"meeting,omitempty"`
+}
+
+func (x *AgendaMeeting) Reset() {
+	*x = AgendaMeeting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgendaMeeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaMeeting) ProtoMessage() {}
+
+func (x *AgendaMeeting) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaMeeting.ProtoReflect.Descriptor instead.
+func (*AgendaMeeting) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AgendaMeeting) GetCourseGuid() string {
+	if x != nil {
+		return x.CourseGuid
+	}
+	return ""
+}
+
+func (x *AgendaMeeting) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *AgendaMeeting) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AgendaMeeting) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *AgendaMeeting) GetMeeting() *v1.Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type AgendaDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix timestamp of the start of the day
+	Date int64 `protobuf:"varint,1,opt,name=date,proto3" json:"date,omitempty"`
+	// the lesson plan chapters dated to this day, sorted by course name
+	LessonPlans []*AgendaLessonPlan `protobuf:"bytes,2,rep,name=lesson_plans,json=lessonPlans,proto3" json:"lesson_plans,omitempty"`
+	// the course meetings on this day, sorted by start time
+	Meetings []*AgendaMeeting `protobuf:"bytes,3,rep,name=meetings,proto3" json:"meetings,omitempty"`
+}
+
+func (x *AgendaDay) Reset() {
+	*x = AgendaDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgendaDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaDay) ProtoMessage() {}
+
+func (x *AgendaDay) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaDay.ProtoReflect.Descriptor instead.
+func (*AgendaDay) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AgendaDay) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *AgendaDay) GetLessonPlans() []*AgendaLessonPlan {
+	if x != nil {
+		return x.LessonPlans
+	}
+	return nil
+}
+
+func (x *AgendaDay) GetMeetings() []*AgendaMeeting {
+	if x != nil {
+		return x.Meetings
+	}
+	return nil
+}
+
+type GetAgendaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix timestamps, days that overlap [start, end) are included
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetAgendaRequest) Reset() {
+	*x = GetAgendaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgendaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgendaRequest) ProtoMessage() {}
+
+func (x *GetAgendaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgendaRequest.ProtoReflect.Descriptor instead.
+func (*GetAgendaRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAgendaRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetAgendaRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetAgendaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// there is an entry for every day in the range, even if it is empty
+	Days []*AgendaDay `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// unix timestamps, the SIS only has the course meetings of the current
+	// week (sunday to saturday) so only days in [meetings_start, meetings_end)
+	// have meetings. these are unset if no meetings could be fetched for the
+	// requested range.
+	MeetingsStart *int64 `protobuf:"varint,2,opt,name=meetings_start,json=meetingsStart,proto3,oneof" json:"meetings_start,omitempty"`
+	MeetingsEnd   *int64 `protobuf:"varint,3,opt,name=meetings_end,json=meetingsEnd,proto3,oneof" json:"meetings_end,omitempty"`
+}
+
+func (x *GetAgendaResponse) Reset() {
+	*x = GetAgendaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgendaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgendaResponse) ProtoMessage() {}
+
+func (x *GetAgendaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgendaResponse.ProtoReflect.Descriptor instead.
+func (*GetAgendaResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAgendaResponse) GetDays() []*AgendaDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAgendaResponse) GetMeetingsStart() int64 {
+	if x != nil && x.MeetingsStart != nil {
+		return *x.MeetingsStart
+	}
+	return 0
+}
+
+func (x *GetAgendaResponse) GetMeetingsEnd() int64 {
+	if x != nil && x.MeetingsEnd != nil {
+		return *x.MeetingsEnd
+	}
+	return 0
+}
+
+type Deadline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DeadlineType `protobuf:"varint,1,opt,name=type,proto3,enum=vcassist.services.vcmoodle.v1.DeadlineType" json:"type,omitempty"`
+	// this is 0 for events that don't belong to a course
+	CourseId   int64  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseName string `protobuf:"bytes,3,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	Title      string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// the name of the section the assignment or quiz is in
+	Section string `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
+	// unix timestamp, 0 if there is no due date
+	DueDate int64  `protobuf:"varint,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Url     string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// the submission status as moodle displays it, ex. "Submitted for
+	// grading", this is only set for assignments
+	SubmissionStatus string `protobuf:"bytes,8,opt,name=submission_status,json=submissionStatus,proto3" json:"submission_status,omitempty"`
+	IsSubmitted      bool   `protobuf:"varint,9,opt,name=is_submitted,json=isSubmitted,proto3" json:"is_submitted,omitempty"`
+	// these are only set if the grade is visible
+	PointsEarned   *float32 `protobuf:"fixed32,10,opt,name=points_earned,json=pointsEarned,proto3,oneof" json:"points_earned,omitempty"`
+	PointsPossible *float32 `protobuf:"fixed32,11,opt,name=points_possible,json=pointsPossible,proto3,oneof" json:"points_possible,omitempty"`
+}
+
+func (x *Deadline) Reset() {
+	*x = Deadline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deadline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deadline) ProtoMessage() {}
+
+func (x *Deadline) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deadline.ProtoReflect.Descriptor instead.
+func (*Deadline) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Deadline) GetType() DeadlineType {
+	if x != nil {
+		return x.Type
+	}
+	return DeadlineType_DEADLINE_ASSIGNMENT
+}
+
+func (x *Deadline) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Deadline) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *Deadline) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Deadline) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Deadline) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *Deadline) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Deadline) GetSubmissionStatus() string {
+	if x != nil {
+		return x.SubmissionStatus
+	}
+	return ""
+}
+
+func (x *Deadline) GetIsSubmitted() bool {
+	if x != nil {
+		return x.IsSubmitted
+	}
+	return false
+}
+
+func (x *Deadline) GetPointsEarned() float32 {
+	if x != nil && x.PointsEarned != nil {
+		return *x.PointsEarned
+	}
+	return 0
+}
+
+func (x *Deadline) GetPointsPossible() float32 {
+	if x != nil && x.PointsPossible != nil {
+		return *x.PointsPossible
+	}
+	return 0
+}
+
+type GetDeadlinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeadlinesRequest) Reset() {
+	*x = GetDeadlinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[17]
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSessionResponse) String() string {
+func (x *GetDeadlinesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionResponse) ProtoMessage() {}
+func (*GetDeadlinesRequest) ProtoMessage() {}
 
-func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[17]
+func (x *GetDeadlinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,16 +2510,57 @@ func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionResponse) Descriptor() ([]byte, []int) {
-	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetDeadlinesRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadlinesRequest) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetSessionResponse) GetCookies() string {
+type GetDeadlinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sorted by due date, deadlines without a due date are last
+	Deadlines []*Deadline `protobuf:"bytes,1,rep,name=deadlines,proto3" json:"deadlines,omitempty"`
+}
+
+func (x *GetDeadlinesResponse) Reset() {
+	*x = GetDeadlinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadlinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadlinesResponse) ProtoMessage() {}
+
+func (x *GetDeadlinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadlinesResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadlinesResponse) Descriptor() ([]byte, []int) {
+	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDeadlinesResponse) GetDeadlines() []*Deadline {
 	if x != nil {
-		return x.Cookies
+		return x.Deadlines
 	}
-	return ""
+	return nil
 }
 
 var File_vcassist_services_vcmoodle_v1_api_proto protoreflect.FileDescriptor
@@ -1019,12 +2570,23 @@ var file_vcassist_services_vcmoodle_v1_api_proto_rawDesc = []byte{
 	0x63, 0x65, 0x73, 0x2f, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
 	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x76, 0x63, 0x61, 0x73, 0x73,
 	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d,
-	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41,
-	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x23, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x16, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
@@ -1085,103 +2647,345 @@ var file_vcassist_services_vcmoodle_v1_api_proto_rawDesc = []byte{
 	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
 	0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x22, 0x29, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x09, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x22, 0x3d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69,
 	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x16, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x2a, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x45, 0x4e,
-	0x45, 0x52, 0x49, 0x43, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49,
-	0x4c, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x0d,
-	0x0a, 0x09, 0x48, 0x54, 0x4d, 0x4c, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x03, 0x32, 0x93, 0x07,
-	0x0a, 0x0d, 0x4d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x7a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x33, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x17,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3d, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x86, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22,
+	0x57, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x84, 0x01, 0x0a,
+	0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
 	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f,
-	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
-	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
-	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x16,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
 	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f,
-	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x63, 0x61, 0x73,
+	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x22, 0xb6, 0x02, 0x0a,
+	0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x76, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5d, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x85, 0x03, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x76, 0x63, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76,
+	0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x76, 0x63, 0x61, 0x73,
 	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63,
-	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0e,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x34,
+	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x48, 0x74, 0x6d, 0x6c, 0x22,
+	0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f,
+	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x41, 0x67, 0x65,
+	0x6e, 0x64, 0x61, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61,
+	0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x64, 0x61, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0b,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x64, 0x61, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e,
+	0x64, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x44, 0x61, 0x79, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0d, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0xb4, 0x03,
+	0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x75, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
+	0x0c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x09, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2a, 0x5a, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x52, 0x45, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x2a, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49,
+	0x43, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x48,
+	0x54, 0x4d, 0x4c, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x10, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x41,
+	0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x50, 0x54, 0x45, 0x52, 0x10, 0x02,
+	0x2a, 0x4d, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f,
+	0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x50, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a,
+	0x46, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x0c, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x41, 0x44, 0x4c,
+	0x49, 0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x51, 0x55, 0x49,
+	0x5a, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x32, 0xf6, 0x0b, 0x0a, 0x0d, 0x4d, 0x6f, 0x6f, 0x64,
+	0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x2e, 0x76, 0x63, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76,
+	0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x3d, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3e, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x12, 0x30, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
 	0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x2e, 0x76, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x37, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x76, 0x63, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76,
-	0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x34, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
 	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
 	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
+	0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x2e,
+	0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76,
 	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x85, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x63, 0x61, 0x73,
-	0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63,
-	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2d,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x63,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
-	0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x63, 0x6d, 0x6f,
-	0x6f, 0x64, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x53, 0x56, 0xaa, 0x02, 0x1d, 0x56,
-	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x56, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x56,
-	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x5c, 0x56, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x56,
-	0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x5c, 0x56, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x56, 0x63, 0x61, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a, 0x56,
-	0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
+	0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x67,
+	0x65, 0x6e, 0x64, 0x61, 0x12, 0x2f, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f,
+	0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x63,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x85, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x63, 0x6d, 0x6f, 0x6f,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3f, 0x76, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2d, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x63, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x63, 0x6d,
+	0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x63, 0x6d, 0x6f, 0x6f, 0x64, 0x6c,
+	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x53, 0x56, 0xaa, 0x02, 0x1d, 0x56, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x63,
+	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x56, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x56, 0x63,
+	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x56, 0x63, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x56, 0x63,
+	0x6d, 0x6f, 0x6f, 0x64, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x56, 0x63, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x63, 0x6d, 0x6f,
+	0x6f, 0x64, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1196,55 +3000,102 @@ func file_vcassist_services_vcmoodle_v1_api_proto_rawDescGZIP() []byte {
 	return file_vcassist_services_vcmoodle_v1_api_proto_rawDescData
 }
 
-var file_vcassist_services_vcmoodle_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_vcassist_services_vcmoodle_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_vcassist_services_vcmoodle_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_vcassist_services_vcmoodle_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_vcassist_services_vcmoodle_v1_api_proto_goTypes = []any{
-	(ResourceType)(0),                       // 0: vcassist.services.vcmoodle.v1.ResourceType
-	(*GetAuthStatusRequest)(nil),            // 1: vcassist.services.vcmoodle.v1.GetAuthStatusRequest
-	(*GetAuthStatusResponse)(nil),           // 2: vcassist.services.vcmoodle.v1.GetAuthStatusResponse
-	(*ProvideUsernamePasswordRequest)(nil),  // 3: vcassist.services.vcmoodle.v1.ProvideUsernamePasswordRequest
-	(*ProvideUsernamePasswordResponse)(nil), // 4: vcassist.services.vcmoodle.v1.ProvideUsernamePasswordResponse
-	(*Chapter)(nil),                         // 5: vcassist.services.vcmoodle.v1.Chapter
-	(*Resource)(nil),                        // 6: vcassist.services.vcmoodle.v1.Resource
-	(*Section)(nil),                         // 7: vcassist.services.vcmoodle.v1.Section
-	(*Course)(nil),                          // 8: vcassist.services.vcmoodle.v1.Course
-	(*GetCoursesRequest)(nil),               // 9: vcassist.services.vcmoodle.v1.GetCoursesRequest
-	(*GetCoursesResponse)(nil),              // 10: vcassist.services.vcmoodle.v1.GetCoursesResponse
-	(*GetChapterContentRequest)(nil),        // 11: vcassist.services.vcmoodle.v1.GetChapterContentRequest
-	(*GetChapterContentResponse)(nil),       // 12: vcassist.services.vcmoodle.v1.GetChapterContentResponse
-	(*GetFileContentRequest)(nil),           // 13: vcassist.services.vcmoodle.v1.GetFileContentRequest
-	(*GetFileContentResponse)(nil),          // 14: vcassist.services.vcmoodle.v1.GetFileContentResponse
-	(*RefreshCoursesRequest)(nil),           // 15: vcassist.services.vcmoodle.v1.RefreshCoursesRequest
-	(*RefreshCoursesResponse)(nil),          // 16: vcassist.services.vcmoodle.v1.RefreshCoursesResponse
-	(*GetSessionRequest)(nil),               // 17: vcassist.services.vcmoodle.v1.GetSessionRequest
-	(*GetSessionResponse)(nil),              // 18: vcassist.services.vcmoodle.v1.GetSessionResponse
+	(CredentialValidity)(0),                 // 0: vcassist.services.vcmoodle.v1.CredentialValidity
+	(ResourceType)(0),                       // 1: vcassist.services.vcmoodle.v1.ResourceType
+	(SearchResultKind)(0),                   // 2: vcassist.services.vcmoodle.v1.SearchResultKind
+	(ContentKind)(0),                        // 3: vcassist.services.vcmoodle.v1.ContentKind
+	(UpdateType)(0),                         // 4: vcassist.services.vcmoodle.v1.UpdateType
+	(DeadlineType)(0),                       // 5: vcassist.services.vcmoodle.v1.DeadlineType
+	(*GetAuthStatusRequest)(nil),            // 6: vcassist.services.vcmoodle.v1.GetAuthStatusRequest
+	(*GetAuthStatusResponse)(nil),           // 7: vcassist.services.vcmoodle.v1.GetAuthStatusResponse
+	(*ProvideUsernamePasswordRequest)(nil),  // 8: vcassist.services.vcmoodle.v1.ProvideUsernamePasswordRequest
+	(*ProvideUsernamePasswordResponse)(nil), // 9: vcassist.services.vcmoodle.v1.ProvideUsernamePasswordResponse
+	(*Chapter)(nil),                         // 10: vcassist.services.vcmoodle.v1.Chapter
+	(*Resource)(nil),                        // 11: vcassist.services.vcmoodle.v1.Resource
+	(*Section)(nil),                         // 12: vcassist.services.vcmoodle.v1.Section
+	(*Course)(nil),                          // 13: vcassist.services.vcmoodle.v1.Course
+	(*GetCoursesRequest)(nil),               // 14: vcassist.services.vcmoodle.v1.GetCoursesRequest
+	(*GetCoursesResponse)(nil),              // 15: vcassist.services.vcmoodle.v1.GetCoursesResponse
+	(*GetChapterContentRequest)(nil),        // 16: vcassist.services.vcmoodle.v1.GetChapterContentRequest
+	(*GetChapterContentResponse)(nil),       // 17: vcassist.services.vcmoodle.v1.GetChapterContentResponse
+	(*GetFileContentRequest)(nil),           // 18: vcassist.services.vcmoodle.v1.GetFileContentRequest
+	(*GetFileContentResponse)(nil),          // 19: vcassist.services.vcmoodle.v1.GetFileContentResponse
+	(*DownloadFileRequest)(nil),             // 20: vcassist.services.vcmoodle.v1.DownloadFileRequest
+	(*FileMetadata)(nil),                    // 21: vcassist.services.vcmoodle.v1.FileMetadata
+	(*DownloadFileResponse)(nil),            // 22: vcassist.services.vcmoodle.v1.DownloadFileResponse
+	(*RefreshCoursesRequest)(nil),           // 23: vcassist.services.vcmoodle.v1.RefreshCoursesRequest
+	(*RefreshCoursesResponse)(nil),          // 24: vcassist.services.vcmoodle.v1.RefreshCoursesResponse
+	(*GetSessionRequest)(nil),               // 25: vcassist.services.vcmoodle.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),              // 26: vcassist.services.vcmoodle.v1.GetSessionResponse
+	(*SearchResult)(nil),                    // 27: vcassist.services.vcmoodle.v1.SearchResult
+	(*SearchMoodleRequest)(nil),             // 28: vcassist.services.vcmoodle.v1.SearchMoodleRequest
+	(*SearchMoodleResponse)(nil),            // 29: vcassist.services.vcmoodle.v1.SearchMoodleResponse
+	(*CourseUpdate)(nil),                    // 30: vcassist.services.vcmoodle.v1.CourseUpdate
+	(*GetCourseUpdatesRequest)(nil),         // 31: vcassist.services.vcmoodle.v1.GetCourseUpdatesRequest
+	(*GetCourseUpdatesResponse)(nil),        // 32: vcassist.services.vcmoodle.v1.GetCourseUpdatesResponse
+	(*AgendaLessonPlan)(nil),                // 33: vcassist.services.vcmoodle.v1.AgendaLessonPlan
+	(*AgendaMeeting)(nil),                   // 34: vcassist.services.vcmoodle.v1.AgendaMeeting
+	(*AgendaDay)(nil),                       // 35: vcassist.services.vcmoodle.v1.AgendaDay
+	(*GetAgendaRequest)(nil),                // 36: vcassist.services.vcmoodle.v1.GetAgendaRequest
+	(*GetAgendaResponse)(nil),               // 37: vcassist.services.vcmoodle.v1.GetAgendaResponse
+	(*Deadline)(nil),                        // 38: vcassist.services.vcmoodle.v1.Deadline
+	(*GetDeadlinesRequest)(nil),             // 39: vcassist.services.vcmoodle.v1.GetDeadlinesRequest
+	(*GetDeadlinesResponse)(nil),            // 40: vcassist.services.vcmoodle.v1.GetDeadlinesResponse
+	(*v1.Meeting)(nil),                      // 41: vcassist.services.sis.v1.Meeting
 }
 var file_vcassist_services_vcmoodle_v1_api_proto_depIdxs = []int32{
-	0,  // 0: vcassist.services.vcmoodle.v1.Resource.type:type_name -> vcassist.services.vcmoodle.v1.ResourceType
-	5,  // 1: vcassist.services.vcmoodle.v1.Resource.chapters:type_name -> vcassist.services.vcmoodle.v1.Chapter
-	6,  // 2: vcassist.services.vcmoodle.v1.Section.resources:type_name -> vcassist.services.vcmoodle.v1.Resource
-	7,  // 3: vcassist.services.vcmoodle.v1.Course.sections:type_name -> vcassist.services.vcmoodle.v1.Section
-	8,  // 4: vcassist.services.vcmoodle.v1.GetCoursesResponse.courses:type_name -> vcassist.services.vcmoodle.v1.Course
-	8,  // 5: vcassist.services.vcmoodle.v1.RefreshCoursesResponse.courses:type_name -> vcassist.services.vcmoodle.v1.Course
-	1,  // 6: vcassist.services.vcmoodle.v1.MoodleService.GetAuthStatus:input_type -> vcassist.services.vcmoodle.v1.GetAuthStatusRequest
-	3,  // 7: vcassist.services.vcmoodle.v1.MoodleService.ProvideUsernamePassword:input_type -> vcassist.services.vcmoodle.v1.ProvideUsernamePasswordRequest
-	17, // 8: vcassist.services.vcmoodle.v1.MoodleService.GetSession:input_type -> vcassist.services.vcmoodle.v1.GetSessionRequest
-	9,  // 9: vcassist.services.vcmoodle.v1.MoodleService.GetCourses:input_type -> vcassist.services.vcmoodle.v1.GetCoursesRequest
-	15, // 10: vcassist.services.vcmoodle.v1.MoodleService.RefreshCourses:input_type -> vcassist.services.vcmoodle.v1.RefreshCoursesRequest
-	11, // 11: vcassist.services.vcmoodle.v1.MoodleService.GetChapterContent:input_type -> vcassist.services.vcmoodle.v1.GetChapterContentRequest
-	13, // 12: vcassist.services.vcmoodle.v1.MoodleService.GetFileContent:input_type -> vcassist.services.vcmoodle.v1.GetFileContentRequest
-	2,  // 13: vcassist.services.vcmoodle.v1.MoodleService.GetAuthStatus:output_type -> vcassist.services.vcmoodle.v1.GetAuthStatusResponse
-	4,  // 14: vcassist.services.vcmoodle.v1.MoodleService.ProvideUsernamePassword:output_type -> vcassist.services.vcmoodle.v1.ProvideUsernamePasswordResponse
-	18, // 15: vcassist.services.vcmoodle.v1.MoodleService.GetSession:output_type -> vcassist.services.vcmoodle.v1.GetSessionResponse
-	10, // 16: vcassist.services.vcmoodle.v1.MoodleService.GetCourses:output_type -> vcassist.services.vcmoodle.v1.GetCoursesResponse
-	16, // 17: vcassist.services.vcmoodle.v1.MoodleService.RefreshCourses:output_type -> vcassist.services.vcmoodle.v1.RefreshCoursesResponse
-	12, // 18: vcassist.services.vcmoodle.v1.MoodleService.GetChapterContent:output_type -> vcassist.services.vcmoodle.v1.GetChapterContentResponse
-	14, // 19: vcassist.services.vcmoodle.v1.MoodleService.GetFileContent:output_type -> vcassist.services.vcmoodle.v1.GetFileContentResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 0: vcassist.services.vcmoodle.v1.GetAuthStatusResponse.validity:type_name -> vcassist.services.vcmoodle.v1.CredentialValidity
+	1,  // 1: vcassist.services.vcmoodle.v1.Resource.type:type_name -> vcassist.services.vcmoodle.v1.ResourceType
+	10, // 2: vcassist.services.vcmoodle.v1.Resource.chapters:type_name -> vcassist.services.vcmoodle.v1.Chapter
+	11, // 3: vcassist.services.vcmoodle.v1.Section.resources:type_name -> vcassist.services.vcmoodle.v1.Resource
+	12, // 4: vcassist.services.vcmoodle.v1.Course.sections:type_name -> vcassist.services.vcmoodle.v1.Section
+	13, // 5: vcassist.services.vcmoodle.v1.GetCoursesResponse.courses:type_name -> vcassist.services.vcmoodle.v1.Course
+	21, // 6: vcassist.services.vcmoodle.v1.DownloadFileResponse.metadata:type_name -> vcassist.services.vcmoodle.v1.FileMetadata
+	13, // 7: vcassist.services.vcmoodle.v1.RefreshCoursesResponse.courses:type_name -> vcassist.services.vcmoodle.v1.Course
+	2,  // 8: vcassist.services.vcmoodle.v1.SearchResult.kind:type_name -> vcassist.services.vcmoodle.v1.SearchResultKind
+	27, // 9: vcassist.services.vcmoodle.v1.SearchMoodleResponse.results:type_name -> vcassist.services.vcmoodle.v1.SearchResult
+	4,  // 10: vcassist.services.vcmoodle.v1.CourseUpdate.type:type_name -> vcassist.services.vcmoodle.v1.UpdateType
+	3,  // 11: vcassist.services.vcmoodle.v1.CourseUpdate.kind:type_name -> vcassist.services.vcmoodle.v1.ContentKind
+	30, // 12: vcassist.services.vcmoodle.v1.GetCourseUpdatesResponse.updates:type_name -> vcassist.services.vcmoodle.v1.CourseUpdate
+	10, // 13: vcassist.services.vcmoodle.v1.AgendaLessonPlan.chapter:type_name -> vcassist.services.vcmoodle.v1.Chapter
+	41, // 14: vcassist.services.vcmoodle.v1.AgendaMeeting.meeting:type_name -> vcassist.services.sis.v1.Meeting
+	33, // 15: vcassist.services.vcmoodle.v1.AgendaDay.lesson_plans:type_name -> vcassist.services.vcmoodle.v1.AgendaLessonPlan
+	34, // 16: vcassist.services.vcmoodle.v1.AgendaDay.meetings:type_name -> vcassist.services.vcmoodle.v1.AgendaMeeting
+	35, // 17: vcassist.services.vcmoodle.v1.GetAgendaResponse.days:type_name -> vcassist.services.vcmoodle.v1.AgendaDay
+	5,  // 18: vcassist.services.vcmoodle.v1.Deadline.type:type_name -> vcassist.services.vcmoodle.v1.DeadlineType
+	38, // 19: vcassist.services.vcmoodle.v1.GetDeadlinesResponse.deadlines:type_name -> vcassist.services.vcmoodle.v1.Deadline
+	6,  // 20: vcassist.services.vcmoodle.v1.MoodleService.GetAuthStatus:input_type -> vcassist.services.vcmoodle.v1.GetAuthStatusRequest
+	8,  // 21: vcassist.services.vcmoodle.v1.MoodleService.ProvideUsernamePassword:input_type -> vcassist.services.vcmoodle.v1.ProvideUsernamePasswordRequest
+	25, // 22: vcassist.services.vcmoodle.v1.MoodleService.GetSession:input_type -> vcassist.services.vcmoodle.v1.GetSessionRequest
+	14, // 23: vcassist.services.vcmoodle.v1.MoodleService.GetCourses:input_type -> vcassist.services.vcmoodle.v1.GetCoursesRequest
+	23, // 24: vcassist.services.vcmoodle.v1.MoodleService.RefreshCourses:input_type -> vcassist.services.vcmoodle.v1.RefreshCoursesRequest
+	16, // 25: vcassist.services.vcmoodle.v1.MoodleService.GetChapterContent:input_type -> vcassist.services.vcmoodle.v1.GetChapterContentRequest
+	18, // 26: vcassist.services.vcmoodle.v1.MoodleService.GetFileContent:input_type -> vcassist.services.vcmoodle.v1.GetFileContentRequest
+	20, // 27: vcassist.services.vcmoodle.v1.MoodleService.DownloadFile:input_type -> vcassist.services.vcmoodle.v1.DownloadFileRequest
+	28, // 28: vcassist.services.vcmoodle.v1.MoodleService.SearchMoodle:input_type -> vcassist.services.vcmoodle.v1.SearchMoodleRequest
+	31, // 29: vcassist.services.vcmoodle.v1.MoodleService.GetCourseUpdates:input_type -> vcassist.services.vcmoodle.v1.GetCourseUpdatesRequest
+	36, // 30: vcassist.services.vcmoodle.v1.MoodleService.GetAgenda:input_type -> vcassist.services.vcmoodle.v1.GetAgendaRequest
+	39, // 31: vcassist.services.vcmoodle.v1.MoodleService.GetDeadlines:input_type -> vcassist.services.vcmoodle.v1.GetDeadlinesRequest
+	7,  // 32: vcassist.services.vcmoodle.v1.MoodleService.GetAuthStatus:output_type -> vcassist.services.vcmoodle.v1.GetAuthStatusResponse
+	9,  // 33: vcassist.services.vcmoodle.v1.MoodleService.ProvideUsernamePassword:output_type -> vcassist.services.vcmoodle.v1.ProvideUsernamePasswordResponse
+	26, // 34: vcassist.services.vcmoodle.v1.MoodleService.GetSession:output_type -> vcassist.services.vcmoodle.v1.GetSessionResponse
+	15, // 35: vcassist.services.vcmoodle.v1.MoodleService.GetCourses:output_type -> vcassist.services.vcmoodle.v1.GetCoursesResponse
+	24, // 36: vcassist.services.vcmoodle.v1.MoodleService.RefreshCourses:output_type -> vcassist.services.vcmoodle.v1.RefreshCoursesResponse
+	17, // 37: vcassist.services.vcmoodle.v1.MoodleService.GetChapterContent:output_type -> vcassist.services.vcmoodle.v1.GetChapterContentResponse
+	19, // 38: vcassist.services.vcmoodle.v1.MoodleService.GetFileContent:output_type -> vcassist.services.vcmoodle.v1.GetFileContentResponse
+	22, // 39: vcassist.services.vcmoodle.v1.MoodleService.DownloadFile:output_type -> vcassist.services.vcmoodle.v1.DownloadFileResponse
+	29, // 40: vcassist.services.vcmoodle.v1.MoodleService.SearchMoodle:output_type -> vcassist.services.vcmoodle.v1.SearchMoodleResponse
+	32, // 41: vcassist.services.vcmoodle.v1.MoodleService.GetCourseUpdates:output_type -> vcassist.services.vcmoodle.v1.GetCourseUpdatesResponse
+	37, // 42: vcassist.services.vcmoodle.v1.MoodleService.GetAgenda:output_type -> vcassist.services.vcmoodle.v1.GetAgendaResponse
+	40, // 43: vcassist.services.vcmoodle.v1.MoodleService.GetDeadlines:output_type -> vcassist.services.vcmoodle.v1.GetDeadlinesResponse
+	32, // [32:44] is the sub-list for method output_type
+	20, // [20:32] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_vcassist_services_vcmoodle_v1_api_proto_init() }
@@ -1422,7 +3273,7 @@ func file_vcassist_services_vcmoodle_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*RefreshCoursesRequest); i {
+			switch v := v.(*DownloadFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,7 +3285,7 @@ func file_vcassist_services_vcmoodle_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*RefreshCoursesResponse); i {
+			switch v := v.(*FileMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,7 +3297,7 @@ func file_vcassist_services_vcmoodle_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSessionRequest); i {
+			switch v := v.(*DownloadFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,6 +3309,42 @@ func file_vcassist_services_vcmoodle_v1_api_proto_init() {
 			}
 		}
 		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshCoursesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshCoursesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSessionResponse); i {
 			case 0:
 				return &v.state
@@ -1469,14 +3356,188 @@ func file_vcassist_services_vcmoodle_v1_api_proto_init() {
 				return nil
 			}
 		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchMoodleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchMoodleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*CourseUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCourseUpdatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCourseUpdatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AgendaLessonPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*AgendaMeeting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*AgendaDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAgendaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAgendaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*Deadline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeadlinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeadlinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[16].OneofWrappers = []any{
+		(*DownloadFileResponse_Metadata)(nil),
+		(*DownloadFileResponse_Chunk)(nil),
 	}
+	file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[31].OneofWrappers = []any{}
+	file_vcassist_services_vcmoodle_v1_api_proto_msgTypes[32].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vcassist_services_vcmoodle_v1_api_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      6,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
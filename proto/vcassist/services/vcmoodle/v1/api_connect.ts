@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetAuthStatusRequest, GetAuthStatusResponse, GetChapterContentRequest, GetChapterContentResponse, GetCoursesRequest, GetCoursesResponse, GetFileContentRequest, GetFileContentResponse, GetSessionRequest, GetSessionResponse, ProvideUsernamePasswordRequest, ProvideUsernamePasswordResponse, RefreshCoursesRequest, RefreshCoursesResponse } from "./api_pb.js";
+import { DownloadFileRequest, DownloadFileResponse, GetAgendaRequest, GetAgendaResponse, GetAuthStatusRequest, GetAuthStatusResponse, GetChapterContentRequest, GetChapterContentResponse, GetCourseUpdatesRequest, GetCourseUpdatesResponse, GetCoursesRequest, GetCoursesResponse, GetDeadlinesRequest, GetDeadlinesResponse, GetFileContentRequest, GetFileContentResponse, GetSessionRequest, GetSessionResponse, ProvideUsernamePasswordRequest, ProvideUsernamePasswordResponse, RefreshCoursesRequest, RefreshCoursesResponse, SearchMoodleRequest, SearchMoodleResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -22,6 +22,10 @@ export const MoodleService = {
       kind: MethodKind.Unary,
     },
     /**
+     * ProvideUsernamePassword checks the credentials by logging into moodle
+     * before storing them, it returns InvalidArgument with a
+     * google.rpc.ErrorInfo detail if they are rejected
+     *
      * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.ProvideUsernamePassword
      */
     provideUsernamePassword: {
@@ -75,6 +79,58 @@ export const MoodleService = {
       O: GetFileContentResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * DownloadFile sends a file in chunks, this should be used instead of
+     * GetFileContent for large files
+     *
+     * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.DownloadFile
+     */
+    downloadFile: {
+      name: "DownloadFile",
+      I: DownloadFileRequest,
+      O: DownloadFileResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.SearchMoodle
+     */
+    searchMoodle: {
+      name: "SearchMoodle",
+      I: SearchMoodleRequest,
+      O: SearchMoodleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.GetCourseUpdates
+     */
+    getCourseUpdates: {
+      name: "GetCourseUpdates",
+      I: GetCourseUpdatesRequest,
+      O: GetCourseUpdatesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.GetAgenda
+     */
+    getAgenda: {
+      name: "GetAgenda",
+      I: GetAgendaRequest,
+      O: GetAgendaResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetDeadlines scrapes the assignments, quizzes and upcoming events of
+     * the user from moodle, this requires the user to have provided their
+     * moodle credentials
+     *
+     * @generated from rpc vcassist.services.vcmoodle.v1.MoodleService.GetDeadlines
+     */
+    getDeadlines: {
+      name: "GetDeadlines",
+      I: GetDeadlinesRequest,
+      O: GetDeadlinesResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,6 +5,35 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Meeting } from "../../sis/v1/data_pb.js";
+
+/**
+ * GetAuthStatus
+ *
+ * @generated from enum vcassist.services.vcmoodle.v1.CredentialValidity
+ */
+export enum CredentialValidity {
+  /**
+   * @generated from enum value: CREDENTIAL_UNKNOWN = 0;
+   */
+  CREDENTIAL_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: CREDENTIAL_VALID = 1;
+   */
+  CREDENTIAL_VALID = 1,
+
+  /**
+   * @generated from enum value: CREDENTIAL_INVALID = 2;
+   */
+  CREDENTIAL_INVALID = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CredentialValidity)
+proto3.util.setEnumType(CredentialValidity, "vcassist.services.vcmoodle.v1.CredentialValidity", [
+  { no: 0, name: "CREDENTIAL_UNKNOWN" },
+  { no: 1, name: "CREDENTIAL_VALID" },
+  { no: 2, name: "CREDENTIAL_INVALID" },
+]);
 
 /**
  * GetCourses
@@ -41,8 +70,119 @@ proto3.util.setEnumType(ResourceType, "vcassist.services.vcmoodle.v1.ResourceTyp
 ]);
 
 /**
- * GetAuthStatus
+ * SearchMoodle
+ *
+ * @generated from enum vcassist.services.vcmoodle.v1.SearchResultKind
+ */
+export enum SearchResultKind {
+  /**
+   * @generated from enum value: SEARCH_RESULT_SECTION = 0;
+   */
+  SEARCH_RESULT_SECTION = 0,
+
+  /**
+   * @generated from enum value: SEARCH_RESULT_RESOURCE = 1;
+   */
+  SEARCH_RESULT_RESOURCE = 1,
+
+  /**
+   * @generated from enum value: SEARCH_RESULT_CHAPTER = 2;
+   */
+  SEARCH_RESULT_CHAPTER = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SearchResultKind)
+proto3.util.setEnumType(SearchResultKind, "vcassist.services.vcmoodle.v1.SearchResultKind", [
+  { no: 0, name: "SEARCH_RESULT_SECTION" },
+  { no: 1, name: "SEARCH_RESULT_RESOURCE" },
+  { no: 2, name: "SEARCH_RESULT_CHAPTER" },
+]);
+
+/**
+ * GetCourseUpdates
+ *
+ * @generated from enum vcassist.services.vcmoodle.v1.ContentKind
+ */
+export enum ContentKind {
+  /**
+   * @generated from enum value: CONTENT_SECTION = 0;
+   */
+  CONTENT_SECTION = 0,
+
+  /**
+   * @generated from enum value: CONTENT_RESOURCE = 1;
+   */
+  CONTENT_RESOURCE = 1,
+
+  /**
+   * @generated from enum value: CONTENT_CHAPTER = 2;
+   */
+  CONTENT_CHAPTER = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ContentKind)
+proto3.util.setEnumType(ContentKind, "vcassist.services.vcmoodle.v1.ContentKind", [
+  { no: 0, name: "CONTENT_SECTION" },
+  { no: 1, name: "CONTENT_RESOURCE" },
+  { no: 2, name: "CONTENT_CHAPTER" },
+]);
+
+/**
+ * @generated from enum vcassist.services.vcmoodle.v1.UpdateType
+ */
+export enum UpdateType {
+  /**
+   * @generated from enum value: UPDATE_ADDED = 0;
+   */
+  UPDATE_ADDED = 0,
+
+  /**
+   * @generated from enum value: UPDATE_CHANGED = 1;
+   */
+  UPDATE_CHANGED = 1,
+
+  /**
+   * @generated from enum value: UPDATE_REMOVED = 2;
+   */
+  UPDATE_REMOVED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(UpdateType)
+proto3.util.setEnumType(UpdateType, "vcassist.services.vcmoodle.v1.UpdateType", [
+  { no: 0, name: "UPDATE_ADDED" },
+  { no: 1, name: "UPDATE_CHANGED" },
+  { no: 2, name: "UPDATE_REMOVED" },
+]);
+
+/**
+ * GetDeadlines
  *
+ * @generated from enum vcassist.services.vcmoodle.v1.DeadlineType
+ */
+export enum DeadlineType {
+  /**
+   * @generated from enum value: DEADLINE_ASSIGNMENT = 0;
+   */
+  DEADLINE_ASSIGNMENT = 0,
+
+  /**
+   * @generated from enum value: DEADLINE_QUIZ = 1;
+   */
+  DEADLINE_QUIZ = 1,
+
+  /**
+   * events from the upcoming events calendar that aren't an assignment
+   * or quiz
+   *
+   * @generated from enum value: DEADLINE_EVENT = 2;
+   */
+  DEADLINE_EVENT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DeadlineType)
+proto3.util.setEnumType(DeadlineType, "vcassist.services.vcmoodle.v1.DeadlineType", [
+  { no: 0, name: "DEADLINE_ASSIGNMENT" },
+  { no: 1, name: "DEADLINE_QUIZ" },
+  { no: 2, name: "DEADLINE_EVENT" },
+]);
+
+/**
  * @generated from message vcassist.services.vcmoodle.v1.GetAuthStatusRequest
  */
 export class GetAuthStatusRequest extends Message<GetAuthStatusRequest> {
@@ -82,6 +222,27 @@ export class GetAuthStatusResponse extends Message<GetAuthStatusResponse> {
    */
   provided = false;
 
+  /**
+   * whether the credentials worked the last time they were used to login
+   *
+   * @generated from field: vcassist.services.vcmoodle.v1.CredentialValidity validity = 2;
+   */
+  validity = CredentialValidity.CREDENTIAL_UNKNOWN;
+
+  /**
+   * unix timestamp, this is 0 if the credentials have never been checked
+   *
+   * @generated from field: int64 last_checked = 3;
+   */
+  lastChecked = protoInt64.zero;
+
+  /**
+   * why the credentials are invalid, this is empty if they aren't
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
   constructor(data?: PartialMessage<GetAuthStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -91,6 +252,9 @@ export class GetAuthStatusResponse extends Message<GetAuthStatusResponse> {
   static readonly typeName = "vcassist.services.vcmoodle.v1.GetAuthStatusResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "provided", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "validity", kind: "enum", T: proto3.getEnumType(CredentialValidity) },
+    { no: 3, name: "last_checked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuthStatusResponse {
@@ -586,6 +750,14 @@ export class GetFileContentRequest extends Message<GetFileContentRequest> {
    */
   url = "";
 
+  /**
+   * the etag of a previously fetched copy of the file, if it is still
+   * current the file is not sent again
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag = "";
+
   constructor(data?: PartialMessage<GetFileContentRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -595,6 +767,7 @@ export class GetFileContentRequest extends Message<GetFileContentRequest> {
   static readonly typeName = "vcassist.services.vcmoodle.v1.GetFileContentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFileContentRequest {
@@ -623,6 +796,24 @@ export class GetFileContentResponse extends Message<GetFileContentResponse> {
    */
   file = new Uint8Array(0);
 
+  /**
+   * @generated from field: string etag = 2;
+   */
+  etag = "";
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType = "";
+
+  /**
+   * when this is true the etag in the request is still current and file
+   * is empty
+   *
+   * @generated from field: bool not_modified = 4;
+   */
+  notModified = false;
+
   constructor(data?: PartialMessage<GetFileContentResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -632,6 +823,9 @@ export class GetFileContentResponse extends Message<GetFileContentResponse> {
   static readonly typeName = "vcassist.services.vcmoodle.v1.GetFileContentResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "file", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "not_modified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFileContentResponse {
@@ -651,6 +845,186 @@ export class GetFileContentResponse extends Message<GetFileContentResponse> {
   }
 }
 
+/**
+ * DownloadFile
+ *
+ * @generated from message vcassist.services.vcmoodle.v1.DownloadFileRequest
+ */
+export class DownloadFileRequest extends Message<DownloadFileRequest> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * the byte offset to start sending the file from, this can be used to
+   * resume an interrupted download
+   *
+   * @generated from field: int64 offset = 2;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * the maximum number of bytes to send, 0 means until the end of the file
+   *
+   * @generated from field: int64 length = 3;
+   */
+  length = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DownloadFileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.DownloadFileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "length", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadFileRequest {
+    return new DownloadFileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadFileRequest {
+    return new DownloadFileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadFileRequest {
+    return new DownloadFileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadFileRequest | PlainMessage<DownloadFileRequest> | undefined, b: DownloadFileRequest | PlainMessage<DownloadFileRequest> | undefined): boolean {
+    return proto3.util.equals(DownloadFileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.FileMetadata
+ */
+export class FileMetadata extends Message<FileMetadata> {
+  /**
+   * @generated from field: string content_type = 1;
+   */
+  contentType = "";
+
+  /**
+   * this may be empty if moodle does not give the file a name
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename = "";
+
+  /**
+   * the size of the entire file in bytes regardless of the requested
+   * range, -1 if it is unknown
+   *
+   * @generated from field: int64 length = 3;
+   */
+  length = protoInt64.zero;
+
+  /**
+   * the offset of the first chunk in the file
+   *
+   * @generated from field: int64 offset = 4;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * this changes whenever the file does, so it can be used to check that
+   * a download is resumed on the same file. it may be empty for files
+   * that are not mirrored.
+   *
+   * @generated from field: string etag = 5;
+   */
+  etag = "";
+
+  constructor(data?: PartialMessage<FileMetadata>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.FileMetadata";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "length", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileMetadata {
+    return new FileMetadata().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileMetadata {
+    return new FileMetadata().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileMetadata {
+    return new FileMetadata().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FileMetadata | PlainMessage<FileMetadata> | undefined, b: FileMetadata | PlainMessage<FileMetadata> | undefined): boolean {
+    return proto3.util.equals(FileMetadata, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.DownloadFileResponse
+ */
+export class DownloadFileResponse extends Message<DownloadFileResponse> {
+  /**
+   * @generated from oneof vcassist.services.vcmoodle.v1.DownloadFileResponse.content
+   */
+  content: {
+    /**
+     * this is always sent first and only once
+     *
+     * @generated from field: vcassist.services.vcmoodle.v1.FileMetadata metadata = 2;
+     */
+    value: FileMetadata;
+    case: "metadata";
+  } | {
+    /**
+     * @generated from field: bytes chunk = 1;
+     */
+    value: Uint8Array;
+    case: "chunk";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<DownloadFileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.DownloadFileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 2, name: "metadata", kind: "message", T: FileMetadata, oneof: "content" },
+    { no: 1, name: "chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "content" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadFileResponse {
+    return new DownloadFileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadFileResponse {
+    return new DownloadFileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadFileResponse {
+    return new DownloadFileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadFileResponse | PlainMessage<DownloadFileResponse> | undefined, b: DownloadFileResponse | PlainMessage<DownloadFileResponse> | undefined): boolean {
+    return proto3.util.equals(DownloadFileResponse, a, b);
+  }
+}
+
 /**
  * RefreshCourses
  *
@@ -791,3 +1165,814 @@ export class GetSessionResponse extends Message<GetSessionResponse> {
   }
 }
 
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.SearchResult
+ */
+export class SearchResult extends Message<SearchResult> {
+  /**
+   * @generated from field: vcassist.services.vcmoodle.v1.SearchResultKind kind = 1;
+   */
+  kind = SearchResultKind.SEARCH_RESULT_SECTION;
+
+  /**
+   * @generated from field: int64 course_id = 2;
+   */
+  courseId = protoInt64.zero;
+
+  /**
+   * @generated from field: string course_name = 3;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: int64 section_idx = 4;
+   */
+  sectionIdx = protoInt64.zero;
+
+  /**
+   * this will be 0 for SEARCH_RESULT_SECTION
+   *
+   * @generated from field: int64 resource_idx = 5;
+   */
+  resourceIdx = protoInt64.zero;
+
+  /**
+   * this will be 0 for results that are not SEARCH_RESULT_CHAPTER
+   *
+   * @generated from field: int64 chapter_id = 6;
+   */
+  chapterId = protoInt64.zero;
+
+  /**
+   * @generated from field: string title = 7;
+   */
+  title = "";
+
+  /**
+   * an excerpt of the matched text content, matched terms are wrapped in
+   * <mark></mark>, this will be empty if only the title matched
+   *
+   * @generated from field: string snippet = 8;
+   */
+  snippet = "";
+
+  /**
+   * @generated from field: string url = 9;
+   */
+  url = "";
+
+  constructor(data?: PartialMessage<SearchResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.SearchResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(SearchResultKind) },
+    { no: 2, name: "course_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "section_idx", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "resource_idx", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "chapter_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "snippet", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResult {
+    return new SearchResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResult | PlainMessage<SearchResult> | undefined, b: SearchResult | PlainMessage<SearchResult> | undefined): boolean {
+    return proto3.util.equals(SearchResult, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.SearchMoodleRequest
+ */
+export class SearchMoodleRequest extends Message<SearchMoodleRequest> {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * the maximum number of results to return, defaults to 25 if unset
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<SearchMoodleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.SearchMoodleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchMoodleRequest {
+    return new SearchMoodleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchMoodleRequest {
+    return new SearchMoodleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchMoodleRequest {
+    return new SearchMoodleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchMoodleRequest | PlainMessage<SearchMoodleRequest> | undefined, b: SearchMoodleRequest | PlainMessage<SearchMoodleRequest> | undefined): boolean {
+    return proto3.util.equals(SearchMoodleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.SearchMoodleResponse
+ */
+export class SearchMoodleResponse extends Message<SearchMoodleResponse> {
+  /**
+   * results are sorted from most to least relevant
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.SearchResult results = 1;
+   */
+  results: SearchResult[] = [];
+
+  constructor(data?: PartialMessage<SearchMoodleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.SearchMoodleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: SearchResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchMoodleResponse {
+    return new SearchMoodleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchMoodleResponse {
+    return new SearchMoodleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchMoodleResponse {
+    return new SearchMoodleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchMoodleResponse | PlainMessage<SearchMoodleResponse> | undefined, b: SearchMoodleResponse | PlainMessage<SearchMoodleResponse> | undefined): boolean {
+    return proto3.util.equals(SearchMoodleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.CourseUpdate
+ */
+export class CourseUpdate extends Message<CourseUpdate> {
+  /**
+   * the time the update was found by a scrape
+   *
+   * @generated from field: int64 time = 1;
+   */
+  time = protoInt64.zero;
+
+  /**
+   * @generated from field: vcassist.services.vcmoodle.v1.UpdateType type = 2;
+   */
+  type = UpdateType.UPDATE_ADDED;
+
+  /**
+   * @generated from field: vcassist.services.vcmoodle.v1.ContentKind kind = 3;
+   */
+  kind = ContentKind.CONTENT_SECTION;
+
+  /**
+   * @generated from field: int64 course_id = 4;
+   */
+  courseId = protoInt64.zero;
+
+  /**
+   * @generated from field: string course_name = 5;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: int64 section_idx = 6;
+   */
+  sectionIdx = protoInt64.zero;
+
+  /**
+   * this will be 0 for CONTENT_SECTION
+   *
+   * @generated from field: int64 resource_idx = 7;
+   */
+  resourceIdx = protoInt64.zero;
+
+  /**
+   * this will be 0 for content that is not CONTENT_CHAPTER
+   *
+   * @generated from field: int64 chapter_id = 8;
+   */
+  chapterId = protoInt64.zero;
+
+  /**
+   * this will be empty for html areas
+   *
+   * @generated from field: string name = 9;
+   */
+  name = "";
+
+  /**
+   * this will be empty for UPDATE_REMOVED
+   *
+   * @generated from field: string url = 10;
+   */
+  url = "";
+
+  /**
+   * for UPDATE_CHANGED chapters and html areas, this is the new html
+   * content with removed text wrapped in <del> and added text wrapped in
+   * <ins>, otherwise it is empty
+   *
+   * @generated from field: string diff_html = 11;
+   */
+  diffHtml = "";
+
+  constructor(data?: PartialMessage<CourseUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.CourseUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(UpdateType) },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(ContentKind) },
+    { no: 4, name: "course_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "section_idx", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "resource_idx", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "chapter_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "diff_html", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CourseUpdate {
+    return new CourseUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CourseUpdate {
+    return new CourseUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CourseUpdate {
+    return new CourseUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CourseUpdate | PlainMessage<CourseUpdate> | undefined, b: CourseUpdate | PlainMessage<CourseUpdate> | undefined): boolean {
+    return proto3.util.equals(CourseUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetCourseUpdatesRequest
+ */
+export class GetCourseUpdatesRequest extends Message<GetCourseUpdatesRequest> {
+  /**
+   * unix timestamp, only updates found after this time will be returned
+   *
+   * @generated from field: int64 since = 1;
+   */
+  since = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetCourseUpdatesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetCourseUpdatesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCourseUpdatesRequest {
+    return new GetCourseUpdatesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCourseUpdatesRequest {
+    return new GetCourseUpdatesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCourseUpdatesRequest {
+    return new GetCourseUpdatesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCourseUpdatesRequest | PlainMessage<GetCourseUpdatesRequest> | undefined, b: GetCourseUpdatesRequest | PlainMessage<GetCourseUpdatesRequest> | undefined): boolean {
+    return proto3.util.equals(GetCourseUpdatesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetCourseUpdatesResponse
+ */
+export class GetCourseUpdatesResponse extends Message<GetCourseUpdatesResponse> {
+  /**
+   * updates are sorted from oldest to newest
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.CourseUpdate updates = 1;
+   */
+  updates: CourseUpdate[] = [];
+
+  constructor(data?: PartialMessage<GetCourseUpdatesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetCourseUpdatesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "updates", kind: "message", T: CourseUpdate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCourseUpdatesResponse {
+    return new GetCourseUpdatesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCourseUpdatesResponse {
+    return new GetCourseUpdatesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCourseUpdatesResponse {
+    return new GetCourseUpdatesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCourseUpdatesResponse | PlainMessage<GetCourseUpdatesResponse> | undefined, b: GetCourseUpdatesResponse | PlainMessage<GetCourseUpdatesResponse> | undefined): boolean {
+    return proto3.util.equals(GetCourseUpdatesResponse, a, b);
+  }
+}
+
+/**
+ * GetAgenda
+ *
+ * @generated from message vcassist.services.vcmoodle.v1.AgendaLessonPlan
+ */
+export class AgendaLessonPlan extends Message<AgendaLessonPlan> {
+  /**
+   * @generated from field: int64 course_id = 1;
+   */
+  courseId = protoInt64.zero;
+
+  /**
+   * @generated from field: string course_name = 2;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: vcassist.services.vcmoodle.v1.Chapter chapter = 3;
+   */
+  chapter?: Chapter;
+
+  constructor(data?: PartialMessage<AgendaLessonPlan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.AgendaLessonPlan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "course_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chapter", kind: "message", T: Chapter },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AgendaLessonPlan {
+    return new AgendaLessonPlan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AgendaLessonPlan {
+    return new AgendaLessonPlan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AgendaLessonPlan {
+    return new AgendaLessonPlan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AgendaLessonPlan | PlainMessage<AgendaLessonPlan> | undefined, b: AgendaLessonPlan | PlainMessage<AgendaLessonPlan> | undefined): boolean {
+    return proto3.util.equals(AgendaLessonPlan, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.AgendaMeeting
+ */
+export class AgendaMeeting extends Message<AgendaMeeting> {
+  /**
+   * @generated from field: string course_guid = 1;
+   */
+  courseGuid = "";
+
+  /**
+   * @generated from field: string course_name = 2;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period = "";
+
+  /**
+   * @generated from field: string room = 4;
+   */
+  room = "";
+
+  /**
+   * @generated from field: vcassist.services.sis.v1.Meeting meeting = 5;
+   */
+  meeting?: Meeting;
+
+  constructor(data?: PartialMessage<AgendaMeeting>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.AgendaMeeting";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "course_guid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "period", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "room", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "meeting", kind: "message", T: Meeting },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AgendaMeeting {
+    return new AgendaMeeting().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AgendaMeeting {
+    return new AgendaMeeting().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AgendaMeeting {
+    return new AgendaMeeting().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AgendaMeeting | PlainMessage<AgendaMeeting> | undefined, b: AgendaMeeting | PlainMessage<AgendaMeeting> | undefined): boolean {
+    return proto3.util.equals(AgendaMeeting, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.AgendaDay
+ */
+export class AgendaDay extends Message<AgendaDay> {
+  /**
+   * unix timestamp of the start of the day
+   *
+   * @generated from field: int64 date = 1;
+   */
+  date = protoInt64.zero;
+
+  /**
+   * the lesson plan chapters dated to this day, sorted by course name
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.AgendaLessonPlan lesson_plans = 2;
+   */
+  lessonPlans: AgendaLessonPlan[] = [];
+
+  /**
+   * the course meetings on this day, sorted by start time
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.AgendaMeeting meetings = 3;
+   */
+  meetings: AgendaMeeting[] = [];
+
+  constructor(data?: PartialMessage<AgendaDay>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.AgendaDay";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "date", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "lesson_plans", kind: "message", T: AgendaLessonPlan, repeated: true },
+    { no: 3, name: "meetings", kind: "message", T: AgendaMeeting, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AgendaDay {
+    return new AgendaDay().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AgendaDay {
+    return new AgendaDay().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AgendaDay {
+    return new AgendaDay().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AgendaDay | PlainMessage<AgendaDay> | undefined, b: AgendaDay | PlainMessage<AgendaDay> | undefined): boolean {
+    return proto3.util.equals(AgendaDay, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetAgendaRequest
+ */
+export class GetAgendaRequest extends Message<GetAgendaRequest> {
+  /**
+   * unix timestamps, days that overlap [start, end) are included
+   *
+   * @generated from field: int64 start = 1;
+   */
+  start = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 end = 2;
+   */
+  end = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetAgendaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetAgendaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAgendaRequest {
+    return new GetAgendaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAgendaRequest {
+    return new GetAgendaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAgendaRequest {
+    return new GetAgendaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAgendaRequest | PlainMessage<GetAgendaRequest> | undefined, b: GetAgendaRequest | PlainMessage<GetAgendaRequest> | undefined): boolean {
+    return proto3.util.equals(GetAgendaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetAgendaResponse
+ */
+export class GetAgendaResponse extends Message<GetAgendaResponse> {
+  /**
+   * there is an entry for every day in the range, even if it is empty
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.AgendaDay days = 1;
+   */
+  days: AgendaDay[] = [];
+
+  /**
+   * unix timestamps, the SIS only has the course meetings of the current
+   * week (sunday to saturday) so only days in [meetings_start, meetings_end)
+   * have meetings. these are unset if no meetings could be fetched for the
+   * requested range.
+   *
+   * @generated from field: optional int64 meetings_start = 2;
+   */
+  meetingsStart?: bigint;
+
+  /**
+   * @generated from field: optional int64 meetings_end = 3;
+   */
+  meetingsEnd?: bigint;
+
+  constructor(data?: PartialMessage<GetAgendaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetAgendaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "days", kind: "message", T: AgendaDay, repeated: true },
+    { no: 2, name: "meetings_start", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "meetings_end", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAgendaResponse {
+    return new GetAgendaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAgendaResponse {
+    return new GetAgendaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAgendaResponse {
+    return new GetAgendaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAgendaResponse | PlainMessage<GetAgendaResponse> | undefined, b: GetAgendaResponse | PlainMessage<GetAgendaResponse> | undefined): boolean {
+    return proto3.util.equals(GetAgendaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.Deadline
+ */
+export class Deadline extends Message<Deadline> {
+  /**
+   * @generated from field: vcassist.services.vcmoodle.v1.DeadlineType type = 1;
+   */
+  type = DeadlineType.DEADLINE_ASSIGNMENT;
+
+  /**
+   * this is 0 for events that don't belong to a course
+   *
+   * @generated from field: int64 course_id = 2;
+   */
+  courseId = protoInt64.zero;
+
+  /**
+   * @generated from field: string course_name = 3;
+   */
+  courseName = "";
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * the name of the section the assignment or quiz is in
+   *
+   * @generated from field: string section = 5;
+   */
+  section = "";
+
+  /**
+   * unix timestamp, 0 if there is no due date
+   *
+   * @generated from field: int64 due_date = 6;
+   */
+  dueDate = protoInt64.zero;
+
+  /**
+   * @generated from field: string url = 7;
+   */
+  url = "";
+
+  /**
+   * the submission status as moodle displays it, ex. "Submitted for
+   * grading", this is only set for assignments
+   *
+   * @generated from field: string submission_status = 8;
+   */
+  submissionStatus = "";
+
+  /**
+   * @generated from field: bool is_submitted = 9;
+   */
+  isSubmitted = false;
+
+  /**
+   * these are only set if the grade is visible
+   *
+   * @generated from field: optional float points_earned = 10;
+   */
+  pointsEarned?: number;
+
+  /**
+   * @generated from field: optional float points_possible = 11;
+   */
+  pointsPossible?: number;
+
+  constructor(data?: PartialMessage<Deadline>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.Deadline";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(DeadlineType) },
+    { no: 2, name: "course_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "course_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "section", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "due_date", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "submission_status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "is_submitted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "points_earned", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 11, name: "points_possible", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deadline {
+    return new Deadline().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Deadline {
+    return new Deadline().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Deadline {
+    return new Deadline().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Deadline | PlainMessage<Deadline> | undefined, b: Deadline | PlainMessage<Deadline> | undefined): boolean {
+    return proto3.util.equals(Deadline, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetDeadlinesRequest
+ */
+export class GetDeadlinesRequest extends Message<GetDeadlinesRequest> {
+  constructor(data?: PartialMessage<GetDeadlinesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetDeadlinesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeadlinesRequest {
+    return new GetDeadlinesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeadlinesRequest {
+    return new GetDeadlinesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeadlinesRequest {
+    return new GetDeadlinesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeadlinesRequest | PlainMessage<GetDeadlinesRequest> | undefined, b: GetDeadlinesRequest | PlainMessage<GetDeadlinesRequest> | undefined): boolean {
+    return proto3.util.equals(GetDeadlinesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message vcassist.services.vcmoodle.v1.GetDeadlinesResponse
+ */
+export class GetDeadlinesResponse extends Message<GetDeadlinesResponse> {
+  /**
+   * sorted by due date, deadlines without a due date are last
+   *
+   * @generated from field: repeated vcassist.services.vcmoodle.v1.Deadline deadlines = 1;
+   */
+  deadlines: Deadline[] = [];
+
+  constructor(data?: PartialMessage<GetDeadlinesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "vcassist.services.vcmoodle.v1.GetDeadlinesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deadlines", kind: "message", T: Deadline, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeadlinesResponse {
+    return new GetDeadlinesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeadlinesResponse {
+    return new GetDeadlinesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeadlinesResponse {
+    return new GetDeadlinesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeadlinesResponse | PlainMessage<GetDeadlinesResponse> | undefined, b: GetDeadlinesResponse | PlainMessage<GetDeadlinesResponse> | undefined): boolean {
+    return proto3.util.equals(GetDeadlinesResponse, a, b);
+  }
+}
+
@@ -54,6 +54,20 @@ const (
 	// MoodleServiceGetFileContentProcedure is the fully-qualified name of the MoodleService's
 	// GetFileContent RPC.
 	MoodleServiceGetFileContentProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/GetFileContent"
+	// MoodleServiceDownloadFileProcedure is the fully-qualified name of the MoodleService's
+	// DownloadFile RPC.
+	MoodleServiceDownloadFileProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/DownloadFile"
+	// MoodleServiceSearchMoodleProcedure is the fully-qualified name of the MoodleService's
+	// SearchMoodle RPC.
+	MoodleServiceSearchMoodleProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/SearchMoodle"
+	// MoodleServiceGetCourseUpdatesProcedure is the fully-qualified name of the MoodleService's
+	// GetCourseUpdates RPC.
+	MoodleServiceGetCourseUpdatesProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/GetCourseUpdates"
+	// MoodleServiceGetAgendaProcedure is the fully-qualified name of the MoodleService's GetAgenda RPC.
+	MoodleServiceGetAgendaProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/GetAgenda"
+	// MoodleServiceGetDeadlinesProcedure is the fully-qualified name of the MoodleService's
+	// GetDeadlines RPC.
+	MoodleServiceGetDeadlinesProcedure = "/vcassist.services.vcmoodle.v1.MoodleService/GetDeadlines"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -66,17 +80,35 @@ var (
 	moodleServiceRefreshCoursesMethodDescriptor          = moodleServiceServiceDescriptor.Methods().ByName("RefreshCourses")
 	moodleServiceGetChapterContentMethodDescriptor       = moodleServiceServiceDescriptor.Methods().ByName("GetChapterContent")
 	moodleServiceGetFileContentMethodDescriptor          = moodleServiceServiceDescriptor.Methods().ByName("GetFileContent")
+	moodleServiceDownloadFileMethodDescriptor            = moodleServiceServiceDescriptor.Methods().ByName("DownloadFile")
+	moodleServiceSearchMoodleMethodDescriptor            = moodleServiceServiceDescriptor.Methods().ByName("SearchMoodle")
+	moodleServiceGetCourseUpdatesMethodDescriptor        = moodleServiceServiceDescriptor.Methods().ByName("GetCourseUpdates")
+	moodleServiceGetAgendaMethodDescriptor               = moodleServiceServiceDescriptor.Methods().ByName("GetAgenda")
+	moodleServiceGetDeadlinesMethodDescriptor            = moodleServiceServiceDescriptor.Methods().ByName("GetDeadlines")
 )
 
 // MoodleServiceClient is a client for the vcassist.services.vcmoodle.v1.MoodleService service.
 type MoodleServiceClient interface {
 	GetAuthStatus(context.Context, *connect.Request[v1.GetAuthStatusRequest]) (*connect.Response[v1.GetAuthStatusResponse], error)
+	// ProvideUsernamePassword checks the credentials by logging into moodle
+	// before storing them, it returns InvalidArgument with a
+	// google.rpc.ErrorInfo detail if they are rejected
 	ProvideUsernamePassword(context.Context, *connect.Request[v1.ProvideUsernamePasswordRequest]) (*connect.Response[v1.ProvideUsernamePasswordResponse], error)
 	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
 	GetCourses(context.Context, *connect.Request[v1.GetCoursesRequest]) (*connect.Response[v1.GetCoursesResponse], error)
 	RefreshCourses(context.Context, *connect.Request[v1.RefreshCoursesRequest]) (*connect.Response[v1.RefreshCoursesResponse], error)
 	GetChapterContent(context.Context, *connect.Request[v1.GetChapterContentRequest]) (*connect.Response[v1.GetChapterContentResponse], error)
 	GetFileContent(context.Context, *connect.Request[v1.GetFileContentRequest]) (*connect.Response[v1.GetFileContentResponse], error)
+	// DownloadFile sends a file in chunks, this should be used instead of
+	// GetFileContent for large files
+	DownloadFile(context.Context, *connect.Request[v1.DownloadFileRequest]) (*connect.ServerStreamForClient[v1.DownloadFileResponse], error)
+	SearchMoodle(context.Context, *connect.Request[v1.SearchMoodleRequest]) (*connect.Response[v1.SearchMoodleResponse], error)
+	GetCourseUpdates(context.Context, *connect.Request[v1.GetCourseUpdatesRequest]) (*connect.Response[v1.GetCourseUpdatesResponse], error)
+	GetAgenda(context.Context, *connect.Request[v1.GetAgendaRequest]) (*connect.Response[v1.GetAgendaResponse], error)
+	// GetDeadlines scrapes the assignments, quizzes and upcoming events of
+	// the user from moodle, this requires the user to have provided their
+	// moodle credentials
+	GetDeadlines(context.Context, *connect.Request[v1.GetDeadlinesRequest]) (*connect.Response[v1.GetDeadlinesResponse], error)
 }
 
 // NewMoodleServiceClient constructs a client for the vcassist.services.vcmoodle.v1.MoodleService
@@ -131,6 +163,36 @@ func NewMoodleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(moodleServiceGetFileContentMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		downloadFile: connect.NewClient[v1.DownloadFileRequest, v1.DownloadFileResponse](
+			httpClient,
+			baseURL+MoodleServiceDownloadFileProcedure,
+			connect.WithSchema(moodleServiceDownloadFileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		searchMoodle: connect.NewClient[v1.SearchMoodleRequest, v1.SearchMoodleResponse](
+			httpClient,
+			baseURL+MoodleServiceSearchMoodleProcedure,
+			connect.WithSchema(moodleServiceSearchMoodleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getCourseUpdates: connect.NewClient[v1.GetCourseUpdatesRequest, v1.GetCourseUpdatesResponse](
+			httpClient,
+			baseURL+MoodleServiceGetCourseUpdatesProcedure,
+			connect.WithSchema(moodleServiceGetCourseUpdatesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAgenda: connect.NewClient[v1.GetAgendaRequest, v1.GetAgendaResponse](
+			httpClient,
+			baseURL+MoodleServiceGetAgendaProcedure,
+			connect.WithSchema(moodleServiceGetAgendaMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getDeadlines: connect.NewClient[v1.GetDeadlinesRequest, v1.GetDeadlinesResponse](
+			httpClient,
+			baseURL+MoodleServiceGetDeadlinesProcedure,
+			connect.WithSchema(moodleServiceGetDeadlinesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -143,6 +205,11 @@ type moodleServiceClient struct {
 	refreshCourses          *connect.Client[v1.RefreshCoursesRequest, v1.RefreshCoursesResponse]
 	getChapterContent       *connect.Client[v1.GetChapterContentRequest, v1.GetChapterContentResponse]
 	getFileContent          *connect.Client[v1.GetFileContentRequest, v1.GetFileContentResponse]
+	downloadFile            *connect.Client[v1.DownloadFileRequest, v1.DownloadFileResponse]
+	searchMoodle            *connect.Client[v1.SearchMoodleRequest, v1.SearchMoodleResponse]
+	getCourseUpdates        *connect.Client[v1.GetCourseUpdatesRequest, v1.GetCourseUpdatesResponse]
+	getAgenda               *connect.Client[v1.GetAgendaRequest, v1.GetAgendaResponse]
+	getDeadlines            *connect.Client[v1.GetDeadlinesRequest, v1.GetDeadlinesResponse]
 }
 
 // GetAuthStatus calls vcassist.services.vcmoodle.v1.MoodleService.GetAuthStatus.
@@ -181,16 +248,54 @@ func (c *moodleServiceClient) GetFileContent(ctx context.Context, req *connect.R
 	return c.getFileContent.CallUnary(ctx, req)
 }
 
+// DownloadFile calls vcassist.services.vcmoodle.v1.MoodleService.DownloadFile.
+func (c *moodleServiceClient) DownloadFile(ctx context.Context, req *connect.Request[v1.DownloadFileRequest]) (*connect.ServerStreamForClient[v1.DownloadFileResponse], error) {
+	return c.downloadFile.CallServerStream(ctx, req)
+}
+
+// SearchMoodle calls vcassist.services.vcmoodle.v1.MoodleService.SearchMoodle.
+func (c *moodleServiceClient) SearchMoodle(ctx context.Context, req *connect.Request[v1.SearchMoodleRequest]) (*connect.Response[v1.SearchMoodleResponse], error) {
+	return c.searchMoodle.CallUnary(ctx, req)
+}
+
+// GetCourseUpdates calls vcassist.services.vcmoodle.v1.MoodleService.GetCourseUpdates.
+func (c *moodleServiceClient) GetCourseUpdates(ctx context.Context, req *connect.Request[v1.GetCourseUpdatesRequest]) (*connect.Response[v1.GetCourseUpdatesResponse], error) {
+	return c.getCourseUpdates.CallUnary(ctx, req)
+}
+
+// GetAgenda calls vcassist.services.vcmoodle.v1.MoodleService.GetAgenda.
+func (c *moodleServiceClient) GetAgenda(ctx context.Context, req *connect.Request[v1.GetAgendaRequest]) (*connect.Response[v1.GetAgendaResponse], error) {
+	return c.getAgenda.CallUnary(ctx, req)
+}
+
+// GetDeadlines calls vcassist.services.vcmoodle.v1.MoodleService.GetDeadlines.
+func (c *moodleServiceClient) GetDeadlines(ctx context.Context, req *connect.Request[v1.GetDeadlinesRequest]) (*connect.Response[v1.GetDeadlinesResponse], error) {
+	return c.getDeadlines.CallUnary(ctx, req)
+}
+
 // MoodleServiceHandler is an implementation of the vcassist.services.vcmoodle.v1.MoodleService
 // service.
 type MoodleServiceHandler interface {
 	GetAuthStatus(context.Context, *connect.Request[v1.GetAuthStatusRequest]) (*connect.Response[v1.GetAuthStatusResponse], error)
+	// ProvideUsernamePassword checks the credentials by logging into moodle
+	// before storing them, it returns InvalidArgument with a
+	// google.rpc.ErrorInfo detail if they are rejected
 	ProvideUsernamePassword(context.Context, *connect.Request[v1.ProvideUsernamePasswordRequest]) (*connect.Response[v1.ProvideUsernamePasswordResponse], error)
 	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
 	GetCourses(context.Context, *connect.Request[v1.GetCoursesRequest]) (*connect.Response[v1.GetCoursesResponse], error)
 	RefreshCourses(context.Context, *connect.Request[v1.RefreshCoursesRequest]) (*connect.Response[v1.RefreshCoursesResponse], error)
 	GetChapterContent(context.Context, *connect.Request[v1.GetChapterContentRequest]) (*connect.Response[v1.GetChapterContentResponse], error)
 	GetFileContent(context.Context, *connect.Request[v1.GetFileContentRequest]) (*connect.Response[v1.GetFileContentResponse], error)
+	// DownloadFile sends a file in chunks, this should be used instead of
+	// GetFileContent for large files
+	DownloadFile(context.Context, *connect.Request[v1.DownloadFileRequest], *connect.ServerStream[v1.DownloadFileResponse]) error
+	SearchMoodle(context.Context, *connect.Request[v1.SearchMoodleRequest]) (*connect.Response[v1.SearchMoodleResponse], error)
+	GetCourseUpdates(context.Context, *connect.Request[v1.GetCourseUpdatesRequest]) (*connect.Response[v1.GetCourseUpdatesResponse], error)
+	GetAgenda(context.Context, *connect.Request[v1.GetAgendaRequest]) (*connect.Response[v1.GetAgendaResponse], error)
+	// GetDeadlines scrapes the assignments, quizzes and upcoming events of
+	// the user from moodle, this requires the user to have provided their
+	// moodle credentials
+	GetDeadlines(context.Context, *connect.Request[v1.GetDeadlinesRequest]) (*connect.Response[v1.GetDeadlinesResponse], error)
 }
 
 // NewMoodleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -241,6 +346,36 @@ func NewMoodleServiceHandler(svc MoodleServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(moodleServiceGetFileContentMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	moodleServiceDownloadFileHandler := connect.NewServerStreamHandler(
+		MoodleServiceDownloadFileProcedure,
+		svc.DownloadFile,
+		connect.WithSchema(moodleServiceDownloadFileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	moodleServiceSearchMoodleHandler := connect.NewUnaryHandler(
+		MoodleServiceSearchMoodleProcedure,
+		svc.SearchMoodle,
+		connect.WithSchema(moodleServiceSearchMoodleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	moodleServiceGetCourseUpdatesHandler := connect.NewUnaryHandler(
+		MoodleServiceGetCourseUpdatesProcedure,
+		svc.GetCourseUpdates,
+		connect.WithSchema(moodleServiceGetCourseUpdatesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	moodleServiceGetAgendaHandler := connect.NewUnaryHandler(
+		MoodleServiceGetAgendaProcedure,
+		svc.GetAgenda,
+		connect.WithSchema(moodleServiceGetAgendaMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	moodleServiceGetDeadlinesHandler := connect.NewUnaryHandler(
+		MoodleServiceGetDeadlinesProcedure,
+		svc.GetDeadlines,
+		connect.WithSchema(moodleServiceGetDeadlinesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/vcassist.services.vcmoodle.v1.MoodleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MoodleServiceGetAuthStatusProcedure:
@@ -257,6 +392,16 @@ func NewMoodleServiceHandler(svc MoodleServiceHandler, opts ...connect.HandlerOp
 			moodleServiceGetChapterContentHandler.ServeHTTP(w, r)
 		case MoodleServiceGetFileContentProcedure:
 			moodleServiceGetFileContentHandler.ServeHTTP(w, r)
+		case MoodleServiceDownloadFileProcedure:
+			moodleServiceDownloadFileHandler.ServeHTTP(w, r)
+		case MoodleServiceSearchMoodleProcedure:
+			moodleServiceSearchMoodleHandler.ServeHTTP(w, r)
+		case MoodleServiceGetCourseUpdatesProcedure:
+			moodleServiceGetCourseUpdatesHandler.ServeHTTP(w, r)
+		case MoodleServiceGetAgendaProcedure:
+			moodleServiceGetAgendaHandler.ServeHTTP(w, r)
+		case MoodleServiceGetDeadlinesProcedure:
+			moodleServiceGetDeadlinesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -293,3 +438,23 @@ func (UnimplementedMoodleServiceHandler) GetChapterContent(context.Context, *con
 func (UnimplementedMoodleServiceHandler) GetFileContent(context.Context, *connect.Request[v1.GetFileContentRequest]) (*connect.Response[v1.GetFileContentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.GetFileContent is not implemented"))
 }
+
+func (UnimplementedMoodleServiceHandler) DownloadFile(context.Context, *connect.Request[v1.DownloadFileRequest], *connect.ServerStream[v1.DownloadFileResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.DownloadFile is not implemented"))
+}
+
+func (UnimplementedMoodleServiceHandler) SearchMoodle(context.Context, *connect.Request[v1.SearchMoodleRequest]) (*connect.Response[v1.SearchMoodleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.SearchMoodle is not implemented"))
+}
+
+func (UnimplementedMoodleServiceHandler) GetCourseUpdates(context.Context, *connect.Request[v1.GetCourseUpdatesRequest]) (*connect.Response[v1.GetCourseUpdatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.GetCourseUpdates is not implemented"))
+}
+
+func (UnimplementedMoodleServiceHandler) GetAgenda(context.Context, *connect.Request[v1.GetAgendaRequest]) (*connect.Response[v1.GetAgendaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.GetAgenda is not implemented"))
+}
+
+func (UnimplementedMoodleServiceHandler) GetDeadlines(context.Context, *connect.Request[v1.GetDeadlinesRequest]) (*connect.Response[v1.GetDeadlinesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcassist.services.vcmoodle.v1.MoodleService.GetDeadlines is not implemented"))
+}
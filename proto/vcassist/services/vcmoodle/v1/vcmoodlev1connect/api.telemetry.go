@@ -21,11 +21,11 @@ var (
 )
 
 type InstrumentedMoodleServiceClient struct {
-	inner MoodleServiceClient
+	inner MoodleServiceHandler
 	WithInputOutput bool
 }
 
-func NewInstrumentedMoodleServiceClient(inner MoodleServiceClient) InstrumentedMoodleServiceClient {
+func NewInstrumentedMoodleServiceClient(inner MoodleServiceHandler) InstrumentedMoodleServiceClient {
 	return InstrumentedMoodleServiceClient{inner: inner}
 }
 
@@ -267,3 +267,163 @@ func (c InstrumentedMoodleServiceClient) GetFileContent(ctx context.Context, req
 	return res, nil
 }
 
+func (c InstrumentedMoodleServiceClient) DownloadFile(ctx context.Context, req *connect.Request[v1.DownloadFileRequest], stream *connect.ServerStream[v1.DownloadFileResponse]) error {
+	ctx, span := MoodleServiceTracer.Start(ctx, "DownloadFile")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	err := c.inner.DownloadFile(ctx, req, stream)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (c InstrumentedMoodleServiceClient) SearchMoodle(ctx context.Context, req *connect.Request[v1.SearchMoodleRequest]) (*connect.Response[v1.SearchMoodleResponse], error) {
+	ctx, span := MoodleServiceTracer.Start(ctx, "SearchMoodle")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.SearchMoodle(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedMoodleServiceClient) GetCourseUpdates(ctx context.Context, req *connect.Request[v1.GetCourseUpdatesRequest]) (*connect.Response[v1.GetCourseUpdatesResponse], error) {
+	ctx, span := MoodleServiceTracer.Start(ctx, "GetCourseUpdates")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.GetCourseUpdates(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedMoodleServiceClient) GetAgenda(ctx context.Context, req *connect.Request[v1.GetAgendaRequest]) (*connect.Response[v1.GetAgendaResponse], error) {
+	ctx, span := MoodleServiceTracer.Start(ctx, "GetAgenda")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.GetAgenda(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c InstrumentedMoodleServiceClient) GetDeadlines(ctx context.Context, req *connect.Request[v1.GetDeadlinesRequest]) (*connect.Response[v1.GetDeadlinesResponse], error) {
+	ctx, span := MoodleServiceTracer.Start(ctx, "GetDeadlines")
+	defer span.End()
+
+	if span.IsRecording() && c.WithInputOutput {
+		input, err := protojson.Marshal(req.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("input", string(input)))
+		} else {
+			span.SetAttributes(attribute.String("input", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	res, err := c.inner.GetDeadlines(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if span.IsRecording() && c.WithInputOutput {
+		output, err := protojson.Marshal(res.Msg)
+		if err == nil {
+			span.SetAttributes(attribute.String("output", string(output)))
+		} else {
+			span.SetAttributes(attribute.String("output", "ERROR: FAILED TO SERIALIZE"))
+			span.RecordError(err)
+		}
+	}
+
+	return res, nil
+}
+
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+	"vcassist-backend/proto/vcassist/services/auth/v1/authv1connect"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokens(t *testing.T) {
+	keys, err := verifier.NewKeySet("1", map[string][]byte{"1": bytes.Repeat([]byte{1}, 32)})
+	require.NoError(t, err)
+	service, _ := setupWithOptions(t, Options{AccessTokenKeys: keys})
+	ctx := context.Background()
+
+	res, err := service.ConsumeVerificationCode(ctx, connect.NewRequest(&authv1.ConsumeVerificationCodeRequest{
+		Email:        testEmail,
+		ProvidedCode: testCode,
+	}))
+	require.NoError(t, err)
+	session := res.Msg.GetToken()
+	access := res.Msg.GetAccessToken()
+	require.NotEmpty(t, access)
+	require.NotZero(t, res.Msg.GetAccessTokenExpiresAt())
+
+	// requests made with the access token go through the interceptor like
+	// requests made with session tokens
+	mux := http.NewServeMux()
+	mux.Handle(authv1connect.NewAuthServiceHandler(
+		service,
+		connect.WithInterceptors(verifier.NewAuthInterceptor(service.verifier)),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := authv1connect.NewAuthServiceClient(http.DefaultClient, server.URL)
+
+	verifyWith := func(bearer string) error {
+		req := connect.NewRequest(&authv1.VerifyTokenRequest{Token: bearer})
+		req.Header().Set("Authorization", "Bearer "+bearer)
+		_, err := client.VerifyToken(ctx, req)
+		return err
+	}
+	require.NoError(t, verifyWith(access))
+	require.NoError(t, verifyWith(session))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(verifyWith("invalid.access.token")))
+
+	// access tokens can't be used in place of session tokens
+	_, err = service.RefreshAccessToken(ctx, connect.NewRequest(&authv1.RefreshAccessTokenRequest{Token: access}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	_, err = service.ListSessions(ctx, connect.NewRequest(&authv1.ListSessionsRequest{Token: access}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	refreshed, err := service.RefreshAccessToken(ctx, connect.NewRequest(&authv1.RefreshAccessTokenRequest{Token: session}))
+	require.NoError(t, err)
+	require.NoError(t, verifyWith(refreshed.Msg.GetAccessToken()))
+
+	// logging out stops new access tokens from being issued
+	_, err = service.Logout(ctx, connect.NewRequest(&authv1.LogoutRequest{Token: session}))
+	require.NoError(t, err)
+	_, err = service.RefreshAccessToken(ctx, connect.NewRequest(&authv1.RefreshAccessTokenRequest{Token: session}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestAccessTokensDisabled(t *testing.T) {
+	service, _ := setupSessions(t)
+	ctx := context.Background()
+
+	res, err := service.ConsumeVerificationCode(ctx, connect.NewRequest(&authv1.ConsumeVerificationCodeRequest{
+		Email:        testEmail,
+		ProvidedCode: testCode,
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Msg.GetToken())
+	require.Empty(t, res.Msg.GetAccessToken())
+
+	_, err = service.RefreshAccessToken(ctx, connect.NewRequest(&authv1.RefreshAccessTokenRequest{Token: res.Msg.GetToken()}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
@@ -5,9 +5,32 @@
 package db
 
 type ActiveToken struct {
-	Token     string
-	Useremail string
-	Expiresat int64
+	Token      string
+	Useremail  string
+	Expiresat  int64
+	Createdat  int64
+	Lastusedat int64
+	Device     string
+	Useragent  string
+}
+
+type EmailOutbox struct {
+	ID            int64
+	Recipient     string
+	Subject       string
+	Textbody      string
+	Htmlbody      string
+	Createdat     int64
+	Attempts      int64
+	Nextattemptat int64
+	Lasterror     string
+}
+
+type RateLimit struct {
+	ID          string
+	Hits        int64
+	Windowstart int64
+	Lasthit     int64
 }
 
 type User struct {
@@ -18,4 +41,5 @@ type VerificationCode struct {
 	Code      string
 	Useremail string
 	Expiresat int64
+	Attempts  int64
 }
@@ -15,7 +15,27 @@ insert into User(email) values (?)
 on conflict do nothing;
 
 -- name: CreateToken :exec
-insert into ActiveToken(token, userEmail, expiresAt) values (?, ?, ?);
+insert into ActiveToken(token, userEmail, expiresAt, createdAt, lastUsedAt, device, userAgent)
+values (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetToken :one
+select * from ActiveToken where token = ?;
+
+-- name: TouchToken :exec
+update ActiveToken set lastUsedAt = ?, expiresAt = ? where token = ?;
+
+-- name: GetUserTokens :many
+select * from ActiveToken where userEmail = ?
+order by lastUsedAt desc;
+
+-- name: DeleteUserTokens :exec
+delete from ActiveToken where userEmail = ?;
+
+-- name: DeleteExpiredTokens :execrows
+delete from ActiveToken where expiresAt != 0 and expiresAt < ?;
+
+-- name: ExpireLegacyTokens :exec
+update ActiveToken set expiresAt = ? where expiresAt = 0;
 
 -- name: CreateVerificationCode :exec
 insert into VerificationCode(code, userEmail, expiresAt) values (?, ?, ?);
@@ -26,3 +46,41 @@ delete from VerificationCode where code = ?;
 -- name: DeleteToken :exec
 delete from ActiveToken where token = ?;
 
+-- name: DeleteExpiredVerificationCodes :execrows
+delete from VerificationCode where expiresAt < ?;
+
+-- name: GetVerificationCode :one
+select * from VerificationCode where code = ? and userEmail = ?;
+
+-- name: RecordFailedCodeAttempt :exec
+update VerificationCode set attempts = attempts + 1 where userEmail = ?;
+
+-- name: DeleteExhaustedVerificationCodes :execrows
+delete from VerificationCode where userEmail = ? and attempts >= ?;
+
+-- name: GetRateLimit :one
+select * from RateLimit where id = ?;
+
+-- name: SetRateLimit :exec
+insert into RateLimit(id, hits, windowStart, lastHit) values (?, ?, ?, ?)
+on conflict do update set
+    hits = EXCLUDED.hits,
+    windowStart = EXCLUDED.windowStart,
+    lastHit = EXCLUDED.lastHit;
+
+-- name: DeleteRateLimitsBefore :execrows
+delete from RateLimit where windowStart < ?;
+
+-- name: EnqueueEmail :exec
+insert into EmailOutbox(recipient, subject, textBody, htmlBody, createdAt, nextAttemptAt)
+values (?, ?, ?, ?, ?, ?);
+
+-- name: GetDueEmails :many
+select * from EmailOutbox where nextAttemptAt <= ?
+order by nextAttemptAt limit ?;
+
+-- name: RescheduleEmail :exec
+update EmailOutbox set attempts = ?, nextAttemptAt = ?, lastError = ? where id = ?;
+
+-- name: DeleteEmail :exec
+delete from EmailOutbox where id = ?;
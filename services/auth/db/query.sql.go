@@ -10,17 +10,30 @@ import (
 )
 
 const createToken = `-- name: CreateToken :exec
-insert into ActiveToken(token, userEmail, expiresAt) values (?, ?, ?)
+insert into ActiveToken(token, userEmail, expiresAt, createdAt, lastUsedAt, device, userAgent)
+values (?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateTokenParams struct {
-	Token     string
-	Useremail string
-	Expiresat int64
+	Token      string
+	Useremail  string
+	Expiresat  int64
+	Createdat  int64
+	Lastusedat int64
+	Device     string
+	Useragent  string
 }
 
 func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) error {
-	_, err := q.db.ExecContext(ctx, createToken, arg.Token, arg.Useremail, arg.Expiresat)
+	_, err := q.db.ExecContext(ctx, createToken,
+		arg.Token,
+		arg.Useremail,
+		arg.Expiresat,
+		arg.Createdat,
+		arg.Lastusedat,
+		arg.Device,
+		arg.Useragent,
+	)
 	return err
 }
 
@@ -39,6 +52,68 @@ func (q *Queries) CreateVerificationCode(ctx context.Context, arg CreateVerifica
 	return err
 }
 
+const deleteEmail = `-- name: DeleteEmail :exec
+delete from EmailOutbox where id = ?
+`
+
+func (q *Queries) DeleteEmail(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteEmail, id)
+	return err
+}
+
+const deleteExhaustedVerificationCodes = `-- name: DeleteExhaustedVerificationCodes :execrows
+delete from VerificationCode where userEmail = ? and attempts >= ?
+`
+
+type DeleteExhaustedVerificationCodesParams struct {
+	Useremail string
+	Attempts  int64
+}
+
+func (q *Queries) DeleteExhaustedVerificationCodes(ctx context.Context, arg DeleteExhaustedVerificationCodesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExhaustedVerificationCodes, arg.Useremail, arg.Attempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredTokens = `-- name: DeleteExpiredTokens :execrows
+delete from ActiveToken where expiresAt != 0 and expiresAt < ?
+`
+
+func (q *Queries) DeleteExpiredTokens(ctx context.Context, expiresat int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredTokens, expiresat)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredVerificationCodes = `-- name: DeleteExpiredVerificationCodes :execrows
+delete from VerificationCode where expiresAt < ?
+`
+
+func (q *Queries) DeleteExpiredVerificationCodes(ctx context.Context, expiresat int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredVerificationCodes, expiresat)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRateLimitsBefore = `-- name: DeleteRateLimitsBefore :execrows
+delete from RateLimit where windowStart < ?
+`
+
+func (q *Queries) DeleteRateLimitsBefore(ctx context.Context, windowstart int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRateLimitsBefore, windowstart)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteToken = `-- name: DeleteToken :exec
 delete from ActiveToken where token = ?
 `
@@ -48,6 +123,15 @@ func (q *Queries) DeleteToken(ctx context.Context, token string) error {
 	return err
 }
 
+const deleteUserTokens = `-- name: DeleteUserTokens :exec
+delete from ActiveToken where userEmail = ?
+`
+
+func (q *Queries) DeleteUserTokens(ctx context.Context, useremail string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserTokens, useremail)
+	return err
+}
+
 const deleteVerificationCode = `-- name: DeleteVerificationCode :exec
 delete from VerificationCode where code = ?
 `
@@ -57,6 +141,32 @@ func (q *Queries) DeleteVerificationCode(ctx context.Context, code string) error
 	return err
 }
 
+const enqueueEmail = `-- name: EnqueueEmail :exec
+insert into EmailOutbox(recipient, subject, textBody, htmlBody, createdAt, nextAttemptAt)
+values (?, ?, ?, ?, ?, ?)
+`
+
+type EnqueueEmailParams struct {
+	Recipient     string
+	Subject       string
+	Textbody      string
+	Htmlbody      string
+	Createdat     int64
+	Nextattemptat int64
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) error {
+	_, err := q.db.ExecContext(ctx, enqueueEmail,
+		arg.Recipient,
+		arg.Subject,
+		arg.Textbody,
+		arg.Htmlbody,
+		arg.Createdat,
+		arg.Nextattemptat,
+	)
+	return err
+}
+
 const ensureUserExists = `-- name: EnsureUserExists :exec
 insert into User(email) values (?)
 on conflict do nothing
@@ -67,10 +177,97 @@ func (q *Queries) EnsureUserExists(ctx context.Context, email string) error {
 	return err
 }
 
+const expireLegacyTokens = `-- name: ExpireLegacyTokens :exec
+update ActiveToken set expiresAt = ? where expiresAt = 0
+`
+
+func (q *Queries) ExpireLegacyTokens(ctx context.Context, expiresat int64) error {
+	_, err := q.db.ExecContext(ctx, expireLegacyTokens, expiresat)
+	return err
+}
+
+const getDueEmails = `-- name: GetDueEmails :many
+select id, recipient, subject, textbody, htmlbody, createdat, attempts, nextattemptat, lasterror from EmailOutbox where nextAttemptAt <= ?
+order by nextAttemptAt limit ?
+`
+
+type GetDueEmailsParams struct {
+	Nextattemptat int64
+	Limit         int64
+}
+
+func (q *Queries) GetDueEmails(ctx context.Context, arg GetDueEmailsParams) ([]EmailOutbox, error) {
+	rows, err := q.db.QueryContext(ctx, getDueEmails, arg.Nextattemptat, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Subject,
+			&i.Textbody,
+			&i.Htmlbody,
+			&i.Createdat,
+			&i.Attempts,
+			&i.Nextattemptat,
+			&i.Lasterror,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRateLimit = `-- name: GetRateLimit :one
+select id, hits, windowstart, lasthit from RateLimit where id = ?
+`
+
+func (q *Queries) GetRateLimit(ctx context.Context, id string) (RateLimit, error) {
+	row := q.db.QueryRowContext(ctx, getRateLimit, id)
+	var i RateLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Hits,
+		&i.Windowstart,
+		&i.Lasthit,
+	)
+	return i, err
+}
+
+const getToken = `-- name: GetToken :one
+select token, useremail, expiresat, createdat, lastusedat, device, useragent from ActiveToken where token = ?
+`
+
+func (q *Queries) GetToken(ctx context.Context, token string) (ActiveToken, error) {
+	row := q.db.QueryRowContext(ctx, getToken, token)
+	var i ActiveToken
+	err := row.Scan(
+		&i.Token,
+		&i.Useremail,
+		&i.Expiresat,
+		&i.Createdat,
+		&i.Lastusedat,
+		&i.Device,
+		&i.Useragent,
+	)
+	return i, err
+}
+
 const getUserFromCode = `-- name: GetUserFromCode :one
 select email from User
 inner join (
-    select code, useremail, expiresat from VerificationCode where code = ?
+    select code, useremail, expiresat, attempts from VerificationCode where code = ?
 ) as code on code.userEmail = User.email
 `
 
@@ -84,7 +281,7 @@ func (q *Queries) GetUserFromCode(ctx context.Context, code string) (string, err
 const getUserFromToken = `-- name: GetUserFromToken :one
 select email from User
 inner join (
-    select token, useremail, expiresat from ActiveToken where token = ?
+    select token, useremail, expiresat, createdat, lastusedat, device, useragent from ActiveToken where token = ?
 ) as token on token.userEmail = User.email
 `
 
@@ -94,3 +291,130 @@ func (q *Queries) GetUserFromToken(ctx context.Context, token string) (string, e
 	err := row.Scan(&email)
 	return email, err
 }
+
+const getUserTokens = `-- name: GetUserTokens :many
+select token, useremail, expiresat, createdat, lastusedat, device, useragent from ActiveToken where userEmail = ?
+order by lastUsedAt desc
+`
+
+func (q *Queries) GetUserTokens(ctx context.Context, useremail string) ([]ActiveToken, error) {
+	rows, err := q.db.QueryContext(ctx, getUserTokens, useremail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActiveToken
+	for rows.Next() {
+		var i ActiveToken
+		if err := rows.Scan(
+			&i.Token,
+			&i.Useremail,
+			&i.Expiresat,
+			&i.Createdat,
+			&i.Lastusedat,
+			&i.Device,
+			&i.Useragent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVerificationCode = `-- name: GetVerificationCode :one
+select code, useremail, expiresat, attempts from VerificationCode where code = ? and userEmail = ?
+`
+
+type GetVerificationCodeParams struct {
+	Code      string
+	Useremail string
+}
+
+func (q *Queries) GetVerificationCode(ctx context.Context, arg GetVerificationCodeParams) (VerificationCode, error) {
+	row := q.db.QueryRowContext(ctx, getVerificationCode, arg.Code, arg.Useremail)
+	var i VerificationCode
+	err := row.Scan(
+		&i.Code,
+		&i.Useremail,
+		&i.Expiresat,
+		&i.Attempts,
+	)
+	return i, err
+}
+
+const recordFailedCodeAttempt = `-- name: RecordFailedCodeAttempt :exec
+update VerificationCode set attempts = attempts + 1 where userEmail = ?
+`
+
+func (q *Queries) RecordFailedCodeAttempt(ctx context.Context, useremail string) error {
+	_, err := q.db.ExecContext(ctx, recordFailedCodeAttempt, useremail)
+	return err
+}
+
+const rescheduleEmail = `-- name: RescheduleEmail :exec
+update EmailOutbox set attempts = ?, nextAttemptAt = ?, lastError = ? where id = ?
+`
+
+type RescheduleEmailParams struct {
+	Attempts      int64
+	Nextattemptat int64
+	Lasterror     string
+	ID            int64
+}
+
+func (q *Queries) RescheduleEmail(ctx context.Context, arg RescheduleEmailParams) error {
+	_, err := q.db.ExecContext(ctx, rescheduleEmail,
+		arg.Attempts,
+		arg.Nextattemptat,
+		arg.Lasterror,
+		arg.ID,
+	)
+	return err
+}
+
+const setRateLimit = `-- name: SetRateLimit :exec
+insert into RateLimit(id, hits, windowStart, lastHit) values (?, ?, ?, ?)
+on conflict do update set
+    hits = EXCLUDED.hits,
+    windowStart = EXCLUDED.windowStart,
+    lastHit = EXCLUDED.lastHit
+`
+
+type SetRateLimitParams struct {
+	ID          string
+	Hits        int64
+	Windowstart int64
+	Lasthit     int64
+}
+
+func (q *Queries) SetRateLimit(ctx context.Context, arg SetRateLimitParams) error {
+	_, err := q.db.ExecContext(ctx, setRateLimit,
+		arg.ID,
+		arg.Hits,
+		arg.Windowstart,
+		arg.Lasthit,
+	)
+	return err
+}
+
+const touchToken = `-- name: TouchToken :exec
+update ActiveToken set lastUsedAt = ?, expiresAt = ? where token = ?
+`
+
+type TouchTokenParams struct {
+	Lastusedat int64
+	Expiresat  int64
+	Token      string
+}
+
+func (q *Queries) TouchToken(ctx context.Context, arg TouchTokenParams) error {
+	_, err := q.db.ExecContext(ctx, touchToken, arg.Lastusedat, arg.Expiresat, arg.Token)
+	return err
+}
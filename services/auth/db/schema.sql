@@ -2,10 +2,18 @@ create table User (
     email text not null primary key
 );
 
+-- expiresAt slides forward every time the token is used, it is 0 for
+-- tokens created before tokens expired, these are given an expiry by the
+-- purge daemon.
 create table ActiveToken (
     token text not null primary key,
     userEmail text not null,
     expiresAt int not null,
+    createdAt int not null default 0,
+    lastUsedAt int not null default 0,
+    -- the name the client gave the device it is running on
+    device text not null default '',
+    userAgent text not null default '',
     foreign key (userEmail) references User(email)
 );
 
@@ -13,6 +21,32 @@ create table VerificationCode (
     code text not null primary key,
     userEmail text not null,
     expiresAt int not null,
+    -- the number of wrong codes entered for the user since this code was
+    -- sent, the code is deleted once there are too many
+    attempts int not null default 0,
     foreign key (userEmail) references User(email)
 );
 
+-- a fixed window counter of the hits against a rate limit, id is the name
+-- of the limit followed by what it limits (ex. "start_login:email:<email>")
+create table RateLimit (
+    id text not null primary key,
+    hits int not null,
+    windowStart int not null,
+    lastHit int not null
+);
+
+
+-- emails waiting to be sent, failed sends are retried with a backoff until
+-- they run out of attempts
+create table EmailOutbox (
+    id integer primary key autoincrement,
+    recipient text not null,
+    subject text not null,
+    textBody text not null,
+    htmlBody text not null,
+    createdAt int not null,
+    attempts int not null default 0,
+    nextAttemptAt int not null,
+    lastError text not null default ''
+);
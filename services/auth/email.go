@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"vcassist-backend/lib/mail"
+)
+
+//go:embed templates
+var templateFs embed.FS
+
+var (
+	verificationHtml = htmltemplate.Must(htmltemplate.ParseFS(templateFs, "templates/verification_code.html"))
+	verificationText = texttemplate.Must(texttemplate.ParseFS(templateFs, "templates/verification_code.txt"))
+)
+
+// Branding is how emails sent by the service look.
+type Branding struct {
+	Name string
+	// a css color used for the header of html emails
+	Color string
+	// an image shown next to the name in the header of html emails, this
+	// should be an absolute https url
+	LogoUrl string
+}
+
+var defaultBranding = Branding{
+	Name:  mail.DefaultFromName,
+	Color: "#2563eb",
+}
+
+func (b Branding) withDefaults() Branding {
+	if b.Name == "" {
+		b.Name = defaultBranding.Name
+	}
+	if b.Color == "" {
+		b.Color = defaultBranding.Color
+	}
+	return b
+}
+
+type verificationStrings struct {
+	Subject string
+	// formatted with the name of the app
+	Instructions string
+	// codes expire after verificationCodeLifetime
+	Expiry string
+	Ignore string
+}
+
+const defaultLocale = "en"
+
+var verificationLocales = map[string]verificationStrings{
+	"en": {
+		Subject:      "Verification Code",
+		Instructions: "Please enter the following verification code for your %s account when prompted.",
+		Expiry:       "This code expires in 1 hour.",
+		Ignore:       "If you don't recognize this account, please ignore this email.",
+	},
+	"es": {
+		Subject:      "Código de verificación",
+		Instructions: "Introduce el siguiente código de verificación para tu cuenta de %s cuando se te solicite.",
+		Expiry:       "Este código caduca en 1 hora.",
+		Ignore:       "Si no reconoces esta cuenta, ignora este correo.",
+	},
+	"zh": {
+		Subject:      "验证码",
+		Instructions: "请在提示时输入以下 %s 帐户验证码。",
+		Expiry:       "此验证码将在 1 小时后失效。",
+		Ignore:       "如果您不认识此帐户，请忽略此邮件。",
+	},
+}
+
+// pickLocale returns the first language in an Accept-Language header that
+// emails can be sent in, quality values are ignored since clients list
+// languages in order of preference anyways.
+func pickLocale(acceptLanguage string) string {
+	for _, lang := range strings.Split(acceptLanguage, ",") {
+		lang, _, _ = strings.Cut(lang, ";")
+		lang, _, _ = strings.Cut(strings.TrimSpace(lang), "-")
+		lang = strings.ToLower(lang)
+		_, ok := verificationLocales[lang]
+		if ok {
+			return lang
+		}
+	}
+	return defaultLocale
+}
+
+type verificationEmail struct {
+	Locale   string
+	Branding Branding
+	Text     verificationStrings
+	Code     string
+}
+
+func (s Service) renderVerificationEmail(to, locale, code string) (mail.Message, error) {
+	text, ok := verificationLocales[locale]
+	if !ok {
+		locale = defaultLocale
+		text = verificationLocales[locale]
+	}
+	text.Instructions = fmt.Sprintf(text.Instructions, s.branding.Name)
+
+	data := verificationEmail{
+		Locale:   locale,
+		Branding: s.branding,
+		Text:     text,
+		Code:     code,
+	}
+
+	var html bytes.Buffer
+	err := verificationHtml.Execute(&html, data)
+	if err != nil {
+		return mail.Message{}, err
+	}
+	var plain bytes.Buffer
+	err = verificationText.Execute(&plain, data)
+	if err != nil {
+		return mail.Message{}, err
+	}
+
+	return mail.Message{
+		To:      []string{to},
+		Subject: text.Subject,
+		Text:    plain.String(),
+		Html:    html.String(),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"vcassist-backend/lib/oauth"
+	"vcassist-backend/lib/timezone"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+
+	"connectrpc.com/connect"
+	"github.com/go-resty/resty/v2"
+)
+
+type GoogleOptions struct {
+	// the oauth client ids id tokens may be issued to (ex. the ios,
+	// android and web clients), sign in with google is disabled if this is
+	// empty. the first one is used to exchange authorization codes.
+	ClientIds []string
+	// this is only needed to exchange authorization codes for confidential
+	// (web) clients
+	ClientSecret string
+	// these default to google's endpoints
+	JwksUrl  string
+	TokenUrl string
+}
+
+// googleLogin verifies the id tokens of google accounts.
+type googleLogin struct {
+	options  GoogleOptions
+	client   *resty.Client
+	verifier oauth.IdTokenVerifier
+}
+
+func newGoogleLogin(options GoogleOptions) *googleLogin {
+	if len(options.ClientIds) == 0 {
+		return nil
+	}
+	if options.JwksUrl == "" {
+		options.JwksUrl = oauth.GoogleJwksUrl
+	}
+	if options.TokenUrl == "" {
+		options.TokenUrl = oauth.GoogleTokenUrl
+	}
+	client := resty.New()
+	return &googleLogin{
+		options: options,
+		client:  client,
+		verifier: oauth.IdTokenVerifier{
+			Keys:      oauth.NewKeySet(options.JwksUrl, client),
+			Issuers:   oauth.GoogleIssuers,
+			ClientIds: options.ClientIds,
+		},
+	}
+}
+
+// idToken returns the id token of the request, exchanging the
+// authorization code for one if needed.
+func (g *googleLogin) idToken(ctx context.Context, req *authv1.LoginWithGoogleRequest) (string, error) {
+	if req.GetIdToken() != "" {
+		return req.GetIdToken(), nil
+	}
+	if req.GetAuthorizationCode() == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("an id token or authorization code must be specified"))
+	}
+
+	token, err := oauth.ExchangeCode(ctx, g.client, g.options.TokenUrl, oauth.TokenRequest{
+		ClientId:     g.options.ClientIds[0],
+		AuthCode:     req.GetAuthorizationCode(),
+		RedirectUri:  req.GetRedirectUri(),
+		CodeVerifier: req.GetCodeVerifier(),
+	}, g.options.ClientSecret)
+	if err != nil {
+		return "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if token.IdToken == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no id token was issued, the openid scope may be missing"))
+	}
+	return token.IdToken, nil
+}
+
+func (s Service) LoginWithGoogle(ctx context.Context, req *connect.Request[authv1.LoginWithGoogleRequest]) (*connect.Response[authv1.LoginWithGoogleResponse], error) {
+	if s.google == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("sign in with google is not enabled"))
+	}
+
+	err := s.takeLimits(
+		ctx, timezone.Now(),
+		limitHit{limit: googleLoginIpLimit, key: s.clientIp(req.Peer(), req.Header())},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := s.google.idToken(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.google.verifier.Verify(ctx, idToken, req.Msg.GetNonce())
+	if errors.Is(err, oauth.InvalidIdToken) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("the email of this google account has not been verified"))
+	}
+	email := normalizeEmail(claims.Email)
+	// google will verify an address on any domain for whoever controls
+	// it, only the hosted domain says the account belongs to the school's
+	// workspace
+	if !s.hasAllowedDomain(email) ||
+		(len(s.config.AllowedDomains) > 0 && !s.isAllowedDomain(claims.HostedDomain)) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("Invalid email domain, please use a different email address."))
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	err = txqry.EnsureUserExists(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.createToken(ctx, txqry, email, tokenMetadata{
+		device:    strings.TrimSpace(req.Msg.GetDevice()),
+		userAgent: req.Header().Get("User-Agent"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.issueAccessToken(email)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[authv1.LoginWithGoogleResponse]{
+		Msg: &authv1.LoginWithGoogleResponse{
+			Token:                token,
+			AccessToken:          accessToken.GetAccessToken(),
+			AccessTokenExpiresAt: accessToken.GetAccessTokenExpiresAt(),
+		},
+	}, nil
+}
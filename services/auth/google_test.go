@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"vcassist-backend/lib/oauth/oauthtest"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+const googleClientId = "test-client.apps.googleusercontent.com"
+
+// fakeGoogle serves a JWKS and a token endpoint like google's, the token
+// endpoint accepts the authorization code "valid-code".
+type fakeGoogle struct {
+	jwks   *oauthtest.Jwks
+	server *httptest.Server
+	// the claims of the id token issued for "valid-code"
+	exchangeClaims map[string]any
+}
+
+func newFakeGoogle(t testing.TB) *fakeGoogle {
+	google := &fakeGoogle{jwks: oauthtest.NewJwks()}
+	google.jwks.AddKey(t, "test-key")
+
+	mux := http.NewServeMux()
+	mux.Handle("/certs", google.jwks)
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("code") != "valid-code" ||
+			r.Form.Get("code_verifier") != "verifier" ||
+			r.Form.Get("client_id") != googleClientId {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"id_token":     google.sign(t, google.exchangeClaims),
+			"expires_in":   3600,
+			"token_type":   "Bearer",
+		})
+	})
+	google.server = httptest.NewServer(mux)
+	t.Cleanup(google.server.Close)
+	return google
+}
+
+func (g *fakeGoogle) sign(t testing.TB, claims map[string]any) string {
+	return g.jwks.Sign(t, "test-key", "RS256", claims)
+}
+
+func (g *fakeGoogle) options() GoogleOptions {
+	return GoogleOptions{
+		ClientIds: []string{googleClientId},
+		JwksUrl:   g.server.URL + "/certs",
+		TokenUrl:  g.server.URL + "/token",
+	}
+}
+
+func googleClaims(email string) map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":            "https://accounts.google.com",
+		"sub":            "1234",
+		"aud":            googleClientId,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"email":          email,
+		"email_verified": true,
+		"hd":             "warriorlife.net",
+	}
+}
+
+func loginWithGoogle(service Service, msg *authv1.LoginWithGoogleRequest) (string, error) {
+	res, err := service.LoginWithGoogle(context.Background(), connect.NewRequest(msg))
+	if err != nil {
+		return "", err
+	}
+	return res.Msg.GetToken(), nil
+}
+
+func TestLoginWithGoogle(t *testing.T) {
+	google := newFakeGoogle(t)
+	service, _ := setupWithOptions(t, Options{
+		AllowedDomains: []string{"@warriorlife.net"},
+		Google:         google.options(),
+	})
+	ctx := context.Background()
+
+	token, err := loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, googleClaims("Student@warriorlife.net")),
+		Device:  "phone",
+	})
+	require.NoError(t, err)
+	res, err := service.VerifyToken(ctx, connect.NewRequest(&authv1.VerifyTokenRequest{Token: token}))
+	require.NoError(t, err)
+	require.Equal(t, "student@warriorlife.net", res.Msg.GetEmail())
+
+	// google logins are sessions like any other
+	sessions := listSessions(t, service, token)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "phone", sessions[0].GetDevice())
+
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, googleClaims("someone@gmail.com")),
+	})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// a domain that merely ends with an allowed one isn't allowed
+	lookalike := googleClaims("student@evilwarriorlife.net")
+	lookalike["hd"] = "evilwarriorlife.net"
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, lookalike),
+	})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// the account must belong to the school's workspace, not just have
+	// an address on its domain
+	personal := googleClaims("student@warriorlife.net")
+	delete(personal, "hd")
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, personal),
+	})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	unverified := googleClaims("student@warriorlife.net")
+	unverified["email_verified"] = false
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, unverified),
+	})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	otherApp := googleClaims("student@warriorlife.net")
+	otherApp["aud"] = "other-app.apps.googleusercontent.com"
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, otherApp),
+	})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	withNonce := googleClaims("student@warriorlife.net")
+	withNonce["nonce"] = "expected"
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		IdToken: google.sign(t, withNonce),
+		Nonce:   "different",
+	})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestHasAllowedDomain(t *testing.T) {
+	service := Service{config: Options{
+		AllowedDomains: []string{"@warriorlife.net", "vcs.net"},
+	}}
+	require.True(t, service.hasAllowedDomain("student@warriorlife.net"))
+	require.True(t, service.hasAllowedDomain("teacher@VCS.net"))
+	require.False(t, service.hasAllowedDomain("x@evilvcs.net"))
+	require.False(t, service.hasAllowedDomain("x@vcs.net.evil.com"))
+	require.False(t, service.hasAllowedDomain("vcs.net"))
+
+	require.True(t, Service{}.hasAllowedDomain("anyone@gmail.com"))
+}
+
+func TestLoginWithGoogleCode(t *testing.T) {
+	google := newFakeGoogle(t)
+	google.exchangeClaims = googleClaims("student@warriorlife.net")
+	service, _ := setupWithOptions(t, Options{
+		Google: google.options(),
+	})
+
+	token, err := loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		AuthorizationCode: "valid-code",
+		CodeVerifier:      "verifier",
+		RedirectUri:       "com.vcassist://oauth",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	_, err = loginWithGoogle(service, &authv1.LoginWithGoogleRequest{
+		AuthorizationCode: "valid-code",
+		CodeVerifier:      "wrong",
+	})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestLoginWithGoogleDisabled(t *testing.T) {
+	service, _ := setupSessions(t)
+	_, err := loginWithGoogle(service, &authv1.LoginWithGoogleRequest{IdToken: "token"})
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"vcassist-backend/lib/mail"
+	"vcassist-backend/lib/timezone"
+	"vcassist-backend/services/auth/db"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	outboxPollInterval = time.Second * 15
+	outboxBatchSize    = 20
+	// the retries add up to about 20 minutes, verification codes expire
+	// before it would be worth trying any longer
+	maxEmailAttempts     = 8
+	emailRetryBackoff    = time.Second * 10
+	maxEmailRetryBackoff = time.Minute * 10
+	// a stalled mail server must not hold up the rest of the outbox
+	emailSendTimeout = time.Minute
+)
+
+// enqueueEmail adds an email to the outbox, it is sent by the outbox daemon
+// once the transaction is committed and wakeOutbox is called.
+func (s Service) enqueueEmail(ctx context.Context, txqry *db.Queries, msg mail.Message) error {
+	now := timezone.Now().Unix()
+	for _, to := range msg.To {
+		err := txqry.EnqueueEmail(ctx, db.EnqueueEmailParams{
+			Recipient:     to,
+			Subject:       msg.Subject,
+			Textbody:      msg.Text,
+			Htmlbody:      msg.Html,
+			Createdat:     now,
+			Nextattemptat: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wakeOutbox makes the outbox daemon send queued emails now instead of
+// waiting for the next poll.
+func (s Service) wakeOutbox() {
+	select {
+	case s.outbox <- struct{}{}:
+	default:
+	}
+}
+
+// retryDelay doubles with every failed attempt up to maxEmailRetryBackoff
+func retryDelay(attempts int64) time.Duration {
+	delay := emailRetryBackoff
+	for i := int64(1); i < attempts && delay < maxEmailRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxEmailRetryBackoff)
+}
+
+// deliverOutbox sends the emails in the outbox that are due, emails that
+// fail to send are rescheduled until they run out of attempts.
+func (s Service) deliverOutbox(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "deliverOutbox")
+	defer span.End()
+
+	now := timezone.Now()
+	rows, err := s.qry.GetDueEmails(ctx, db.GetDueEmailsParams{
+		Nextattemptat: now.Unix(),
+		Limit:         outboxBatchSize,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get due emails")
+		return err
+	}
+	span.SetAttributes(attribute.Int("emails", len(rows)))
+
+	for _, row := range rows {
+		sendCtx, cancel := context.WithTimeout(ctx, emailSendTimeout)
+		sendErr := s.mailer.Send(sendCtx, mail.Message{
+			To:      []string{row.Recipient},
+			Subject: row.Subject,
+			Text:    row.Textbody,
+			Html:    row.Htmlbody,
+		})
+		cancel()
+		if sendErr == nil {
+			err = s.qry.DeleteEmail(ctx, row.ID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		span.RecordError(sendErr)
+		attempts := row.Attempts + 1
+		if attempts >= maxEmailAttempts {
+			slog.ErrorContext(ctx, "giving up on sending email", "recipient", row.Recipient, "attempts", attempts, "err", sendErr)
+			err = s.qry.DeleteEmail(ctx, row.ID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		retryAt := now.Add(retryDelay(attempts))
+		slog.WarnContext(ctx, "failed to send email, will retry", "recipient", row.Recipient, "attempts", attempts, "retry_at", retryAt, "err", sendErr)
+		err = s.qry.RescheduleEmail(ctx, db.RescheduleEmailParams{
+			Attempts:      attempts,
+			Nextattemptat: retryAt.Unix(),
+			Lasterror:     sendErr.Error(),
+			ID:            row.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Service) outboxDaemon(ctx context.Context) {
+	slog.InfoContext(ctx, "start daemon", "task", "send queued emails")
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+	for {
+		err := s.deliverOutbox(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to deliver outbox", "err", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.outbox:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
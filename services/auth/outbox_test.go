@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"vcassist-backend/lib/mail"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+	"vcassist-backend/services/auth/db"
+
+	"connectrpc.com/connect"
+	"github.com/jordan-wright/email"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyMailer fails to send until it is fixed, like an smtp server that is
+// temporarily down.
+type flakyMailer struct {
+	lock     *sync.Mutex
+	down     *bool
+	attempts *int
+	sent     *[]mail.Message
+}
+
+func newFlakyMailer() flakyMailer {
+	down := true
+	return flakyMailer{
+		lock:     &sync.Mutex{},
+		down:     &down,
+		attempts: new(int),
+		sent:     &[]mail.Message{},
+	}
+}
+
+func (m flakyMailer) Send(ctx context.Context, msg mail.Message) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	*m.attempts++
+	if *m.down {
+		return fmt.Errorf("connection refused")
+	}
+	*m.sent = append(*m.sent, msg)
+	return nil
+}
+
+func (m flakyMailer) setDown(down bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	*m.down = down
+}
+
+func (m flakyMailer) state() (attempts int, sent []mail.Message) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return *m.attempts, *m.sent
+}
+
+func startLogin(t testing.TB, service Service, email, acceptLanguage string) {
+	req := connect.NewRequest(&authv1.StartLoginRequest{Email: email})
+	if acceptLanguage != "" {
+		req.Header().Set("Accept-Language", acceptLanguage)
+	}
+	_, err := service.StartLogin(context.Background(), req)
+	require.NoError(t, err)
+}
+
+func TestMaildirLogin(t *testing.T) {
+	service, _ := setupSessions(t)
+	maildir := service.mailer.(mail.MaildirMailer)
+
+	startLogin(t, service, "student@example.com", "es-MX,es;q=0.9,en;q=0.8")
+
+	var paths []string
+	require.Eventually(t, func() bool {
+		var err error
+		paths, err = maildir.Delivered()
+		require.NoError(t, err)
+		return len(paths) > 0
+	}, time.Second*5, time.Millisecond*10)
+
+	f, err := os.Open(paths[0])
+	require.NoError(t, err)
+	defer f.Close()
+	parsed, err := email.NewEmailFromReader(f)
+	require.NoError(t, err)
+	require.Equal(t, verificationLocales["es"].Subject, parsed.Subject)
+	require.Contains(t, string(parsed.HTML), defaultBranding.Color)
+
+	code := strings.Split(strings.ReplaceAll(string(parsed.Text), "\r\n", "\n"), "\n\n")[1]
+	require.Contains(t, string(parsed.HTML), code)
+
+	res, err := service.ConsumeVerificationCode(context.Background(), connect.NewRequest(&authv1.ConsumeVerificationCodeRequest{
+		Email:        "student@example.com",
+		ProvidedCode: code,
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Msg.GetToken())
+}
+
+// queuedEmails returns the emails in the outbox including the ones that
+// aren't due yet
+func queuedEmails(t testing.TB, qry *db.Queries) []db.EmailOutbox {
+	rows, err := qry.GetDueEmails(context.Background(), db.GetDueEmailsParams{
+		Nextattemptat: time.Now().Add(time.Hour).Unix(),
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	return rows
+}
+
+// retryNow makes a queued email due and wakes the outbox daemon, then waits
+// for the daemon to finish the attempt
+func retryNow(t testing.TB, service Service, qry *db.Queries, row db.EmailOutbox) {
+	err := qry.RescheduleEmail(context.Background(), db.RescheduleEmailParams{
+		Attempts:      row.Attempts,
+		Nextattemptat: 0,
+		Lasterror:     row.Lasterror,
+		ID:            row.ID,
+	})
+	require.NoError(t, err)
+	service.wakeOutbox()
+	require.Eventually(t, func() bool {
+		rows := queuedEmails(t, qry)
+		return len(rows) == 0 || rows[0].Attempts > row.Attempts
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestOutboxRetry(t *testing.T) {
+	mailer := newFlakyMailer()
+	service, sqlite := setupWithOptions(t, Options{Mailer: mailer})
+	qry := db.New(sqlite)
+
+	// StartLogin doesn't fail when the email can't be sent
+	startLogin(t, service, "student@example.com", "")
+
+	var rows []db.EmailOutbox
+	require.Eventually(t, func() bool {
+		rows = queuedEmails(t, qry)
+		return len(rows) == 1 && rows[0].Attempts == 1
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, "connection refused", rows[0].Lasterror)
+	require.Greater(t, rows[0].Nextattemptat, time.Now().Unix())
+
+	// emails aren't retried before their backoff is over
+	service.wakeOutbox()
+	time.Sleep(time.Millisecond * 50)
+	attempts, sent := mailer.state()
+	require.Equal(t, 1, attempts)
+	require.Empty(t, sent)
+
+	mailer.setDown(false)
+	retryNow(t, service, qry, rows[0])
+
+	attempts, sent = mailer.state()
+	require.Equal(t, 2, attempts)
+	require.Len(t, sent, 1)
+	require.Equal(t, []string{"student@example.com"}, sent[0].To)
+	require.Equal(t, verificationLocales[defaultLocale].Subject, sent[0].Subject)
+	require.Empty(t, queuedEmails(t, qry))
+}
+
+func TestOutboxGiveUp(t *testing.T) {
+	mailer := newFlakyMailer()
+	service, sqlite := setupWithOptions(t, Options{Mailer: mailer})
+	qry := db.New(sqlite)
+
+	startLogin(t, service, "student@example.com", "")
+	require.Eventually(t, func() bool {
+		return len(queuedEmails(t, qry)) == 1 && queuedEmails(t, qry)[0].Attempts == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	for {
+		rows := queuedEmails(t, qry)
+		if len(rows) == 0 {
+			break
+		}
+		retryNow(t, service, qry, rows[0])
+	}
+
+	attempts, sent := mailer.state()
+	require.Equal(t, maxEmailAttempts, attempts)
+	require.Empty(t, sent)
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, emailRetryBackoff, retryDelay(1))
+	require.Equal(t, emailRetryBackoff*2, retryDelay(2))
+	require.Equal(t, emailRetryBackoff*4, retryDelay(3))
+	require.Equal(t, maxEmailRetryBackoff, retryDelay(maxEmailAttempts))
+}
+
+func TestPickLocale(t *testing.T) {
+	require.Equal(t, "en", pickLocale(""))
+	require.Equal(t, "en", pickLocale("fr-FR,fr;q=0.9"))
+	require.Equal(t, "es", pickLocale("es-MX,es;q=0.9,en;q=0.8"))
+	require.Equal(t, "zh", pickLocale("fr, zh-Hans-CN;q=0.8"))
+}
+
+func TestVerificationEmailEscaping(t *testing.T) {
+	service, _ := setupSessions(t)
+	service.branding = Branding{Name: "<script>alert(1)</script>"}.withDefaults()
+
+	msg, err := service.renderVerificationEmail("student@example.com", "en", "abcd1234")
+	require.NoError(t, err)
+	require.NotContains(t, msg.Html, "<script>")
+	require.Contains(t, msg.Html, "abcd1234")
+	require.Contains(t, msg.Text, "abcd1234")
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"vcassist-backend/services/auth/db"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// limit allows at most max hits in every window, if backoff is specified
+// each hit must also be at least backoff * 2^(hits so far - 1) after the
+// previous one.
+type limit struct {
+	name    string
+	max     int64
+	window  time.Duration
+	backoff time.Duration
+}
+
+var (
+	// the backoff makes resends wait 30s, 1m, 2m, 4m, ...
+	startLoginEmailLimit  = limit{name: "start_login:email", max: 8, window: time.Hour * 24, backoff: time.Second * 30}
+	startLoginIpLimit     = limit{name: "start_login:ip", max: 20, window: time.Hour}
+	consumeCodeIpLimit    = limit{name: "consume_code:ip", max: 30, window: time.Hour}
+	consumeCodeEmailLimit = limit{name: "consume_code:email", max: 15, window: time.Hour}
+	googleLoginIpLimit    = limit{name: "google_login:ip", max: 30, window: time.Hour}
+)
+
+// the longest window of any limit, rate limits that started before this are
+// no longer relevant and can be purged
+const maxLimitWindow = time.Hour * 24
+
+// a code is deleted once this many wrong codes have been entered for the
+// user, which leaves an 8 character code essentially unguessable
+const maxCodeAttempts = 5
+
+// limitHit is a hit against a limit for a specific email or ip
+type limitHit struct {
+	limit limit
+	key   string
+}
+
+func (h limitHit) id() string {
+	return h.limit.name + ":" + h.key
+}
+
+// retryAfter returns how long until the hit would be allowed given the
+// current state of its rate limit, it is 0 if the hit is allowed now.
+func (h limitHit) retryAfter(row db.RateLimit, now time.Time) time.Duration {
+	windowEnd := time.Unix(row.Windowstart, 0).Add(h.limit.window)
+	if !now.Before(windowEnd) {
+		return 0
+	}
+	if row.Hits >= h.limit.max {
+		return windowEnd.Sub(now)
+	}
+	if h.limit.backoff > 0 && row.Hits > 0 {
+		wait := time.Duration(float64(h.limit.backoff) * math.Pow(2, float64(row.Hits-1)))
+		next := time.Unix(row.Lasthit, 0).Add(wait)
+		if now.Before(next) {
+			return next.Sub(now)
+		}
+	}
+	return 0
+}
+
+// rateLimitError creates a ResourceExhausted error that tells the client
+// how long to wait with both a Retry-After header and a
+// google.rpc.RetryInfo detail.
+func rateLimitError(retryAfter time.Duration) error {
+	// round up so clients that wait the given time aren't a second early
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	err := connect.NewError(
+		connect.CodeResourceExhausted,
+		fmt.Errorf("too many attempts, please try again in %s", time.Duration(seconds)*time.Second),
+	)
+	err.Meta().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+	})
+	if detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// takeLimits records the given hits if all of them are allowed, otherwise
+// nothing is recorded and a rate limit error with the longest wait is
+// returned.
+func (s Service) takeLimits(ctx context.Context, now time.Time, hits ...limitHit) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	rows := make([]db.RateLimit, len(hits))
+	var wait time.Duration
+	for i, hit := range hits {
+		row, err := txqry.GetRateLimit(ctx, hit.id())
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		rows[i] = row
+		wait = max(wait, hit.retryAfter(row, now))
+	}
+	if wait > 0 {
+		return rateLimitError(wait)
+	}
+
+	for i, hit := range hits {
+		row := rows[i]
+		if now.Unix()-row.Windowstart >= int64(hit.limit.window/time.Second) {
+			row.Hits = 0
+			row.Windowstart = now.Unix()
+		}
+		err = txqry.SetRateLimit(ctx, db.SetRateLimitParams{
+			ID:          hit.id(),
+			Hits:        row.Hits + 1,
+			Windowstart: row.Windowstart,
+			Lasthit:     now.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// clientIp returns the ip address a request was made from. the
+// X-Forwarded-For header is only used if the server is configured to run
+// behind proxies, and only the entries added by those proxies are
+// trusted since clients can put anything in front of them.
+func (s Service) clientIp(peer connect.Peer, header http.Header) string {
+	if s.config.TrustedProxies > 0 {
+		var forwarded []string
+		for _, value := range header.Values("X-Forwarded-For") {
+			for _, entry := range strings.Split(value, ",") {
+				entry = strings.TrimSpace(entry)
+				if entry != "" {
+					forwarded = append(forwarded, entry)
+				}
+			}
+		}
+		if len(forwarded) > 0 {
+			// the last proxy appends the address that connected to it, so
+			// the client is n entries from the right
+			idx := max(len(forwarded)-s.config.TrustedProxies, 0)
+			return forwarded[idx]
+		}
+	}
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		return peer.Addr
+	}
+	return host
+}
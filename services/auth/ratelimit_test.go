@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+	"vcassist-backend/lib/timezone"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+	"vcassist-backend/services/auth/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func retryInfo(t testing.TB, err error) *errdetails.RetryInfo {
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		info, ok := value.(*errdetails.RetryInfo)
+		if ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func TestRateLimit(t *testing.T) {
+	service, _ := setupSessions(t)
+	ctx := context.Background()
+	now := time.Unix(timezone.Now().Unix(), 0)
+
+	hit := limitHit{limit: startLoginEmailLimit, key: "student@example.com"}
+
+	err := service.takeLimits(ctx, now, hit)
+	require.NoError(t, err)
+
+	// the second resend has to wait for the backoff
+	err = service.takeLimits(ctx, now.Add(time.Second*10), hit)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	require.Equal(t, "20", connectErr.Meta().Get("Retry-After"))
+	info := retryInfo(t, err)
+	require.NotNil(t, info)
+	require.Equal(t, time.Second*20, info.GetRetryDelay().AsDuration())
+
+	err = service.takeLimits(ctx, now.Add(time.Second*30), hit)
+	require.NoError(t, err)
+	// the backoff doubles after every hit
+	err = service.takeLimits(ctx, now.Add(time.Second*80), hit)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	err = service.takeLimits(ctx, now.Add(time.Second*90), hit)
+	require.NoError(t, err)
+
+	// a denied hit doesn't count against any of the other limits
+	other := limitHit{limit: startLoginIpLimit, key: "127.0.0.1"}
+	err = service.takeLimits(ctx, now.Add(time.Second*91), hit, other)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	for i := 0; i < int(startLoginIpLimit.max); i++ {
+		err = service.takeLimits(ctx, now.Add(time.Second*91), other)
+		require.NoError(t, err)
+	}
+	err = service.takeLimits(ctx, now.Add(time.Second*91), other)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	// the limit is reset once the window has passed
+	err = service.takeLimits(ctx, now.Add(time.Second*91+startLoginIpLimit.window), other)
+	require.NoError(t, err)
+}
+
+func TestCodeAttempts(t *testing.T) {
+	service, sqlite := setupSessions(t)
+	ctx := context.Background()
+	qry := db.New(sqlite)
+
+	email := "student@example.com"
+	err := qry.EnsureUserExists(ctx, email)
+	require.NoError(t, err)
+	err = qry.CreateVerificationCode(ctx, db.CreateVerificationCodeParams{
+		Code:      "correct",
+		Useremail: email,
+		Expiresat: timezone.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	consume := func(code string) error {
+		_, err := service.ConsumeVerificationCode(ctx, connect.NewRequest(&authv1.ConsumeVerificationCodeRequest{
+			Email:        email,
+			ProvidedCode: code,
+		}))
+		return err
+	}
+
+	for i := 0; i < maxCodeAttempts; i++ {
+		_, err = qry.GetUserFromCode(ctx, "correct")
+		require.NoError(t, err)
+		err = consume("wrong")
+		require.ErrorIs(t, err, errInvalidCode)
+	}
+
+	// the correct code no longer works after too many wrong guesses
+	err = consume("correct")
+	require.ErrorIs(t, err, errInvalidCode)
+
+	// eventually the client is rate limited entirely
+	for i := maxCodeAttempts + 1; i < int(consumeCodeEmailLimit.max); i++ {
+		err = consume("wrong")
+		require.ErrorIs(t, err, errInvalidCode)
+	}
+	err = consume("wrong")
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
+
+func TestPurgeRateLimits(t *testing.T) {
+	service, sqlite := setupSessions(t)
+	ctx := context.Background()
+	qry := db.New(sqlite)
+	now := timezone.Now()
+
+	err := service.takeLimits(ctx, now.Add(-maxLimitWindow-time.Minute), limitHit{limit: startLoginIpLimit, key: "old"})
+	require.NoError(t, err)
+	err = service.takeLimits(ctx, now, limitHit{limit: startLoginIpLimit, key: "new"})
+	require.NoError(t, err)
+
+	err = service.purge(ctx)
+	require.NoError(t, err)
+
+	rows, err := qry.DeleteRateLimitsBefore(ctx, now.Add(time.Minute).Unix())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+}
+
+func TestClientIp(t *testing.T) {
+	peer := connect.Peer{Addr: "10.0.0.2:41000"}
+
+	cases := []struct {
+		name      string
+		proxies   int
+		forwarded []string
+		expected  string
+	}{
+		{
+			name:     "no proxies",
+			expected: "10.0.0.2",
+		},
+		{
+			name:      "header ignored without proxies",
+			forwarded: []string{"203.0.113.7"},
+			expected:  "10.0.0.2",
+		},
+		{
+			name:      "single proxy",
+			proxies:   1,
+			forwarded: []string{"203.0.113.7"},
+			expected:  "203.0.113.7",
+		},
+		{
+			name:      "spoofed prefix",
+			proxies:   1,
+			forwarded: []string{"1.2.3.4, 5.6.7.8, 203.0.113.7"},
+			expected:  "203.0.113.7",
+		},
+		{
+			name:      "spoofed prefix behind two proxies",
+			proxies:   2,
+			forwarded: []string{"1.2.3.4, 203.0.113.7, 198.51.100.1"},
+			expected:  "203.0.113.7",
+		},
+		{
+			name:      "spoofed header split across lines",
+			proxies:   1,
+			forwarded: []string{"1.2.3.4", "203.0.113.7"},
+			expected:  "203.0.113.7",
+		},
+		{
+			name:      "fewer entries than proxies",
+			proxies:   3,
+			forwarded: []string{"203.0.113.7, 198.51.100.1"},
+			expected:  "203.0.113.7",
+		},
+		{
+			name:     "proxy without header",
+			proxies:  1,
+			expected: "10.0.0.2",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := Service{config: Options{TrustedProxies: c.proxies}}
+			header := http.Header{}
+			for _, value := range c.forwarded {
+				header.Add("X-Forwarded-For", value)
+			}
+			require.Equal(t, c.expected, service.clientIp(peer, header))
+		})
+	}
+}
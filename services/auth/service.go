@@ -6,9 +6,10 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
-	"net/smtp"
+	"log/slog"
 	"strings"
 	"time"
+	"vcassist-backend/lib/mail"
 	"vcassist-backend/lib/telemetry"
 	"vcassist-backend/lib/timezone"
 	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
@@ -16,7 +17,6 @@ import (
 	"vcassist-backend/services/auth/verifier"
 
 	"connectrpc.com/connect"
-	"github.com/jordan-wright/email"
 	"github.com/mazen160/go-random"
 	"go.opentelemetry.io/otel/codes"
 
@@ -25,18 +25,28 @@ import (
 
 var tracer = telemetry.Tracer("vcassist.services.auth")
 
-type SmtpConfig struct {
-	Server       string
-	Port         int
-	EmailAddress string
-	Password     string
-}
+// how long verification codes can be used for, this is also stated in the
+// verification email
+const verificationCodeLifetime = time.Hour
 
 type Options struct {
-	Smtp                 SmtpConfig
+	// sends verification codes, this must not be nil
+	Mailer mail.Mailer
+	// how the emails sent to users look, unset fields use the defaults
+	Branding             Branding
 	AllowedDomains       []string
 	TestEmail            string
 	TestVerificationCode string
+	// enables sign in with google
+	Google GoogleOptions
+	// the keys access tokens are signed with, access tokens are not issued
+	// if this is disabled
+	AccessTokenKeys verifier.KeySet
+	// the number of reverse proxies in front of the server that append to
+	// X-Forwarded-For, the ip address of clients for rate limiting is taken
+	// from that many entries from the right of the header. if this is 0 the
+	// header is ignored.
+	TrustedProxies int
 }
 
 type Service struct {
@@ -44,15 +54,34 @@ type Service struct {
 	qry      *db.Queries
 	verifier verifier.Verifier
 	config   Options
+	mailer   mail.Mailer
+	branding Branding
+	// nil if sign in with google is disabled
+	google *googleLogin
+	// signals the outbox daemon that there are new emails to send
+	outbox chan struct{}
 }
 
-func NewService(database *sql.DB, options Options) Service {
-	return Service{
+func NewService(ctx context.Context, database *sql.DB, options Options) Service {
+	if options.Mailer == nil {
+		panic("nil mailer")
+	}
+
+	s := Service{
 		db:       database,
 		qry:      db.New(database),
-		verifier: verifier.NewVerifier(database),
+		verifier: verifier.NewVerifier(database, options.AccessTokenKeys),
 		config:   options,
+		mailer:   options.Mailer,
+		branding: options.Branding.withDefaults(),
+		google:   newGoogleLogin(options.Google),
+		outbox:   make(chan struct{}, 1),
 	}
+
+	go s.purgeDaemon(ctx)
+	go s.outboxDaemon(ctx)
+
+	return s
 }
 
 func normalizeEmail(email string) string {
@@ -72,7 +101,7 @@ func (s Service) createVerificationCode(ctx context.Context, txqry *db.Queries,
 	err = txqry.CreateVerificationCode(ctx, db.CreateVerificationCodeParams{
 		Code:      code,
 		Useremail: normalizeEmail(email),
-		Expiresat: timezone.Now().Add(time.Hour).Unix(),
+		Expiresat: timezone.Now().Add(verificationCodeLifetime).Unix(),
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -83,56 +112,43 @@ func (s Service) createVerificationCode(ctx context.Context, txqry *db.Queries,
 	return code, nil
 }
 
-func (s Service) sendVerificationCode(ctx context.Context, userEmail, code string) error {
-	ctx, span := tracer.Start(ctx, "sendVerificationCode")
-	defer span.End()
-
-	mail := email.NewEmail()
-	mail.From = fmt.Sprintf("VC Assist <%s>", s.config.Smtp.EmailAddress)
-	mail.To = []string{userEmail}
-	mail.Subject = "Verification Code"
-
-	body := fmt.Sprintf(`Please enter the following verification code for your VC Assist account when prompted.
-
-%s
-
-If you don't recognize this account, please ignore this email.`, code)
-	mail.Text = []byte(body)
-
-	err := mail.Send(
-		fmt.Sprintf("%s:%d", s.config.Smtp.Server, s.config.Smtp.Port),
-		smtp.PlainAuth("", s.config.Smtp.EmailAddress, s.config.Smtp.Password, s.config.Smtp.Server),
-	)
-	if err != nil && strings.Contains(err.Error(), "server doesn't support AUTH") {
-		err = mail.Send(fmt.Sprintf("%s:%d", s.config.Smtp.Server, s.config.Smtp.Port), nil)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "failed to send email")
-			return err
-		}
-	}
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "failed to send email")
-		return err
-	}
-
-	return nil
-}
-
-func (s Service) hasAllowedDomain(email string) bool {
+// isAllowedDomain returns true if domain is exactly one of the allowed
+// domains, which may be written with or without a leading "@".
+func (s Service) isAllowedDomain(domain string) bool {
 	if len(s.config.AllowedDomains) == 0 {
 		return true
 	}
 	for _, d := range s.config.AllowedDomains {
-		if strings.HasSuffix(email, d) {
+		if strings.EqualFold(domain, strings.TrimPrefix(d, "@")) {
 			return true
 		}
 	}
 	return false
 }
 
+func (s Service) hasAllowedDomain(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return len(s.config.AllowedDomains) == 0
+	}
+	return s.isAllowedDomain(email[at+1:])
+}
+
 func (s Service) StartLogin(ctx context.Context, req *connect.Request[authv1.StartLoginRequest]) (*connect.Response[authv1.StartLoginResponse], error) {
+	email := normalizeEmail(req.Msg.GetEmail())
+	if !s.hasAllowedDomain(email) {
+		return nil, fmt.Errorf("Invalid email domain, please use a different email address.")
+	}
+
+	err := s.takeLimits(
+		ctx, timezone.Now(),
+		limitHit{limit: startLoginEmailLimit, key: email},
+		limitHit{limit: startLoginIpLimit, key: s.clientIp(req.Peer(), req.Header())},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -140,11 +156,6 @@ func (s Service) StartLogin(ctx context.Context, req *connect.Request[authv1.Sta
 	defer tx.Rollback()
 	txqry := s.qry.WithTx(tx)
 
-	email := normalizeEmail(req.Msg.GetEmail())
-	if !s.hasAllowedDomain(email) {
-		return nil, fmt.Errorf("Invalid email domain, please use a different email address.")
-	}
-
 	err = txqry.EnsureUserExists(ctx, email)
 	if err != nil {
 		return nil, err
@@ -153,32 +164,43 @@ func (s Service) StartLogin(ctx context.Context, req *connect.Request[authv1.Sta
 	if err != nil {
 		return nil, err
 	}
-
-	err = tx.Commit()
+	msg, err := s.renderVerificationEmail(email, pickLocale(req.Header().Get("Accept-Language")), code)
+	if err != nil {
+		return nil, err
+	}
+	// the email is sent asynchronously so an smtp server that is down
+	// doesn't fail the login, it is retried until it goes through
+	err = s.enqueueEmail(ctx, txqry, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.sendVerificationCode(ctx, email, code)
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	s.wakeOutbox()
 
 	return &connect.Response[authv1.StartLoginResponse]{Msg: &authv1.StartLoginResponse{}}, nil
 }
 
+var errInvalidCode = fmt.Errorf("invalid verification code")
+
 func (s Service) verifyAndDeleteCode(ctx context.Context, txqry *db.Queries, email, code string) error {
 	ctx, span := tracer.Start(ctx, "verifyAndDeleteCode")
 	defer span.End()
 
-	email, err := txqry.GetUserFromCode(ctx, code)
-	if err == sql.ErrNoRows {
+	row, err := txqry.GetVerificationCode(ctx, db.GetVerificationCodeParams{
+		Code:      code,
+		Useremail: email,
+	})
+	if err == sql.ErrNoRows || (err == nil && row.Expiresat < timezone.Now().Unix()) {
 		span.SetStatus(codes.Error, "invalid verification code")
-		return fmt.Errorf("invalid verification code")
+		return errInvalidCode
 	}
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "failed to get user from code")
+		span.SetStatus(codes.Error, "failed to get verification code")
 		return err
 	}
 	err = txqry.DeleteVerificationCode(ctx, code)
@@ -191,7 +213,41 @@ func (s Service) verifyAndDeleteCode(ctx context.Context, txqry *db.Queries, ema
 	return nil
 }
 
-func (s Service) createToken(ctx context.Context, txqry *db.Queries, email string) (string, error) {
+// recordFailedAttempt counts a wrong code against every code sent to the
+// user, codes are deleted once too many wrong codes have been entered so
+// they can't be guessed.
+func (s Service) recordFailedAttempt(ctx context.Context, email string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	err = txqry.RecordFailedCodeAttempt(ctx, email)
+	if err != nil {
+		return err
+	}
+	deleted, err := txqry.DeleteExhaustedVerificationCodes(ctx, db.DeleteExhaustedVerificationCodesParams{
+		Useremail: email,
+		Attempts:  maxCodeAttempts,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		slog.WarnContext(ctx, "invalidated verification codes after too many failed attempts", "email", email)
+	}
+	return tx.Commit()
+}
+
+// tokenMetadata describes the client a token is created for
+type tokenMetadata struct {
+	device    string
+	userAgent string
+}
+
+func (s Service) createToken(ctx context.Context, txqry *db.Queries, email string, metadata tokenMetadata) (string, error) {
 	ctx, span := tracer.Start(ctx, "createToken")
 	defer span.End()
 
@@ -203,9 +259,15 @@ func (s Service) createToken(ctx context.Context, txqry *db.Queries, email strin
 		return "", err
 	}
 	token := hex.EncodeToString(nonce)
+	now := timezone.Now()
 	err = txqry.CreateToken(ctx, db.CreateTokenParams{
-		Useremail: email,
-		Token:     token,
+		Useremail:  email,
+		Token:      token,
+		Expiresat:  now.Add(verifier.TokenLifetime).Unix(),
+		Createdat:  now.Unix(),
+		Lastusedat: now.Unix(),
+		Device:     metadata.device,
+		Useragent:  metadata.userAgent,
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -217,6 +279,18 @@ func (s Service) createToken(ctx context.Context, txqry *db.Queries, email strin
 }
 
 func (s Service) ConsumeVerificationCode(ctx context.Context, req *connect.Request[authv1.ConsumeVerificationCodeRequest]) (*connect.Response[authv1.ConsumeVerificationCodeResponse], error) {
+	email := normalizeEmail(req.Msg.GetEmail())
+	providedCode := strings.Trim(req.Msg.GetProvidedCode(), " \t\n")
+
+	err := s.takeLimits(
+		ctx, timezone.Now(),
+		limitHit{limit: consumeCodeEmailLimit, key: email},
+		limitHit{limit: consumeCodeIpLimit, key: s.clientIp(req.Peer(), req.Header())},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -224,12 +298,18 @@ func (s Service) ConsumeVerificationCode(ctx context.Context, req *connect.Reque
 	defer tx.Rollback()
 	txqry := s.qry.WithTx(tx)
 
-	email := normalizeEmail(req.Msg.GetEmail())
-	providedCode := strings.Trim(req.Msg.GetProvidedCode(), " \t\n")
+	metadata := tokenMetadata{
+		device:    strings.TrimSpace(req.Msg.GetDevice()),
+		userAgent: req.Header().Get("User-Agent"),
+	}
 
 	// hard coded bypass for app store reviewers
 	if s.config.TestEmail != "" && email == s.config.TestEmail && providedCode == s.config.TestVerificationCode {
-		token, err := s.createToken(ctx, txqry, email)
+		err := txqry.EnsureUserExists(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		token, err := s.createToken(ctx, txqry, email, metadata)
 		if err != nil {
 			return nil, err
 		}
@@ -237,18 +317,34 @@ func (s Service) ConsumeVerificationCode(ctx context.Context, req *connect.Reque
 		if err != nil {
 			return nil, err
 		}
+		accessToken, err := s.issueAccessToken(email)
+		if err != nil {
+			return nil, err
+		}
 		return &connect.Response[authv1.ConsumeVerificationCodeResponse]{
 			Msg: &authv1.ConsumeVerificationCodeResponse{
-				Token: token,
+				Token:                token,
+				AccessToken:          accessToken.GetAccessToken(),
+				AccessTokenExpiresAt: accessToken.GetAccessTokenExpiresAt(),
 			},
 		}, nil
 	}
 
 	err = s.verifyAndDeleteCode(ctx, txqry, email, providedCode)
+	if err == errInvalidCode {
+		// the attempt has to be recorded outside of the transaction since
+		// it is rolled back
+		tx.Rollback()
+		recordErr := s.recordFailedAttempt(ctx, email)
+		if recordErr != nil {
+			slog.ErrorContext(ctx, "failed to record failed verification attempt", "email", email, "err", recordErr)
+		}
+		return nil, err
+	}
 	if err != nil {
 		return nil, err
 	}
-	token, err := s.createToken(ctx, txqry, email)
+	token, err := s.createToken(ctx, txqry, email, metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -258,9 +354,15 @@ func (s Service) ConsumeVerificationCode(ctx context.Context, req *connect.Reque
 		return nil, err
 	}
 
+	accessToken, err := s.issueAccessToken(email)
+	if err != nil {
+		return nil, err
+	}
 	return &connect.Response[authv1.ConsumeVerificationCodeResponse]{
 		Msg: &authv1.ConsumeVerificationCodeResponse{
-			Token: token,
+			Token:                token,
+			AccessToken:          accessToken.GetAccessToken(),
+			AccessTokenExpiresAt: accessToken.GetAccessTokenExpiresAt(),
 		},
 	}, nil
 }
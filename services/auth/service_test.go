@@ -7,6 +7,8 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
+	"vcassist-backend/lib/mail"
 	"vcassist-backend/lib/telemetry"
 	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
 	"vcassist-backend/proto/vcassist/services/auth/v1/authv1connect"
@@ -56,13 +58,17 @@ func setup(t testing.TB) (authv1connect.AuthServiceClient, func()) {
 		t.Fatal(err)
 	}
 
-	service := NewService(sqlite, Options{
-		Smtp: SmtpConfig{
-			Server:       "localhost",
-			Port:         1025,
-			EmailAddress: "alice@email.com",
-			Password:     "default",
-		},
+	mailer, err := mail.NewSmtpMailer(mail.SmtpConfig{
+		Server:       "localhost",
+		Port:         1025,
+		EmailAddress: "alice@email.com",
+		Password:     "default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := NewService(context.Background(), sqlite, Options{
+		Mailer: mailer,
 	})
 
 	return service, func() {
@@ -77,12 +83,19 @@ func setup(t testing.TB) (authv1connect.AuthServiceClient, func()) {
 var globalClient = resty.New()
 
 func getVerificationCodeFromEmail(t testing.TB) string {
-	res, err := globalClient.R().
-		Get("http://127.0.0.1:1090/messages/1.plain")
-	if err != nil {
-		t.Fatal(err)
-	}
-	contents := res.String()
+	// emails are sent asynchronously by the outbox
+	var contents string
+	require.Eventually(t, func() bool {
+		res, err := globalClient.R().
+			Get("http://127.0.0.1:1090/messages/1.plain")
+		if err != nil {
+			// this runs on another goroutine where t.Fatal isn't allowed
+			t.Log(err)
+			return false
+		}
+		contents = res.String()
+		return res.IsSuccess()
+	}, time.Second*10, time.Millisecond*100)
 	return strings.Split(contents, "\n\n")[1]
 }
 
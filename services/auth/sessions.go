@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"vcassist-backend/lib/timezone"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+	"vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+)
+
+// sessionId identifies the session of a token without revealing the token
+// itself, so it is safe to show to the user.
+func sessionId(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// authenticate verifies the token a session management request is made
+// with, this must be a session token since access tokens aren't sessions.
+func (s Service) authenticate(ctx context.Context, token string) (db.User, error) {
+	user, err := s.verifier.VerifySessionToken(ctx, token)
+	if errors.Is(err, verifier.InvalidToken) {
+		return db.User{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return user, err
+}
+
+// issueAccessToken signs an access token for the user, the response is
+// empty if access tokens are disabled.
+func (s Service) issueAccessToken(email string) (*authv1.RefreshAccessTokenResponse, error) {
+	if !s.config.AccessTokenKeys.Enabled() {
+		return &authv1.RefreshAccessTokenResponse{}, nil
+	}
+	token, expiresAt, err := s.config.AccessTokenKeys.Sign(email, timezone.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.RefreshAccessTokenResponse{
+		AccessToken:          token,
+		AccessTokenExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+func (s Service) RefreshAccessToken(ctx context.Context, req *connect.Request[authv1.RefreshAccessTokenRequest]) (*connect.Response[authv1.RefreshAccessTokenResponse], error) {
+	if !s.config.AccessTokenKeys.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("access tokens are not enabled"))
+	}
+	user, err := s.authenticate(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.issueAccessToken(user.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[authv1.RefreshAccessTokenResponse]{Msg: res}, nil
+}
+
+func (s Service) ListSessions(ctx context.Context, req *connect.Request[authv1.ListSessionsRequest]) (*connect.Response[authv1.ListSessionsResponse], error) {
+	user, err := s.authenticate(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.qry.GetUserTokens(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timezone.Now().Unix()
+	sessions := []*authv1.Session{}
+	for _, row := range rows {
+		// expired tokens that haven't been purged yet can't be used, so
+		// they aren't sessions anymore
+		if row.Expiresat != 0 && row.Expiresat < now {
+			continue
+		}
+		sessions = append(sessions, &authv1.Session{
+			Id:         sessionId(row.Token),
+			Device:     row.Device,
+			UserAgent:  row.Useragent,
+			CreatedAt:  row.Createdat,
+			LastUsedAt: row.Lastusedat,
+			ExpiresAt:  row.Expiresat,
+			Current:    row.Token == req.Msg.GetToken(),
+		})
+	}
+
+	return &connect.Response[authv1.ListSessionsResponse]{
+		Msg: &authv1.ListSessionsResponse{
+			Sessions: sessions,
+		},
+	}, nil
+}
+
+func (s Service) Logout(ctx context.Context, req *connect.Request[authv1.LogoutRequest]) (*connect.Response[authv1.LogoutResponse], error) {
+	user, err := s.authenticate(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	token := req.Msg.GetToken()
+	if req.Msg.GetSessionId() != "" {
+		rows, err := s.qry.GetUserTokens(ctx, user.Email)
+		if err != nil {
+			return nil, err
+		}
+		token = ""
+		for _, row := range rows {
+			if sessionId(row.Token) == req.Msg.GetSessionId() {
+				token = row.Token
+				break
+			}
+		}
+		if token == "" {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found"))
+		}
+	}
+
+	err = s.qry.DeleteToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[authv1.LogoutResponse]{Msg: &authv1.LogoutResponse{}}, nil
+}
+
+func (s Service) RevokeAllSessions(ctx context.Context, req *connect.Request[authv1.RevokeAllSessionsRequest]) (*connect.Response[authv1.RevokeAllSessionsResponse], error) {
+	user, err := s.authenticate(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Msg.GetKeepCurrent() {
+		err = s.qry.DeleteUserTokens(ctx, user.Email)
+		if err != nil {
+			return nil, err
+		}
+		return &connect.Response[authv1.RevokeAllSessionsResponse]{Msg: &authv1.RevokeAllSessionsResponse{}}, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	rows, err := txqry.GetUserTokens(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if row.Token == req.Msg.GetToken() {
+			continue
+		}
+		err = txqry.DeleteToken(ctx, row.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[authv1.RevokeAllSessionsResponse]{Msg: &authv1.RevokeAllSessionsResponse{}}, nil
+}
+
+// purge deletes expired tokens, verification codes and rate limits, tokens without an
+// expiry are given one so they are eventually purged if never used again.
+func (s Service) purge(ctx context.Context) error {
+	now := timezone.Now()
+
+	err := s.qry.ExpireLegacyTokens(ctx, now.Add(verifier.TokenLifetime).Unix())
+	if err != nil {
+		return err
+	}
+	tokens, err := s.qry.DeleteExpiredTokens(ctx, now.Unix())
+	if err != nil {
+		return err
+	}
+	codes, err := s.qry.DeleteExpiredVerificationCodes(ctx, now.Unix())
+	if err != nil {
+		return err
+	}
+	limits, err := s.qry.DeleteRateLimitsBefore(ctx, now.Add(-maxLimitWindow).Unix())
+	if err != nil {
+		return err
+	}
+
+	if tokens > 0 || codes > 0 || limits > 0 {
+		slog.DebugContext(ctx, "purged expired auth rows", "tokens", tokens, "verification_codes", codes, "rate_limits", limits)
+	}
+	return nil
+}
+
+func (s Service) purgeDaemon(ctx context.Context) {
+	slog.InfoContext(ctx, "start daemon", "task", "purge expired tokens, verification codes and rate limits every 30 minutes")
+
+	err := s.purge(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to purge expired tokens", "err", err)
+	}
+
+	ticker := time.NewTicker(time.Minute * 30)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			err := s.purge(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to purge expired tokens", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"vcassist-backend/lib/mail"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/lib/timezone"
+	authv1 "vcassist-backend/proto/vcassist/services/auth/v1"
+	"vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+const testEmail = "reviewer@example.com"
+const testCode = "12345678"
+
+// setupSessions creates a service that doesn't need an smtp server, logins
+// go through the verification code bypass instead.
+func setupSessions(t testing.TB) (Service, *sql.DB) {
+	return setupWithOptions(t, Options{})
+}
+
+// setupWithOptions is setupSessions with a custom config, emails are
+// written to a maildir if no mailer is given.
+func setupWithOptions(t testing.TB, options Options) (Service, *sql.DB) {
+	cleanup := telemetry.SetupForTesting("test:auth")
+	t.Cleanup(cleanup)
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(schemaSql)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if options.Mailer == nil {
+		options.Mailer, err = mail.NewMaildirMailer(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	options.TestEmail = testEmail
+	options.TestVerificationCode = testCode
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	service := NewService(ctx, sqlite, options)
+	return service, sqlite
+}
+
+func login(t testing.TB, service Service, device string) string {
+	req := connect.NewRequest(&authv1.ConsumeVerificationCodeRequest{
+		Email:        testEmail,
+		ProvidedCode: testCode,
+		Device:       device,
+	})
+	req.Header().Set("User-Agent", "test-agent")
+	res, err := service.ConsumeVerificationCode(context.Background(), req)
+	require.NoError(t, err)
+	return res.Msg.GetToken()
+}
+
+func listSessions(t testing.TB, service Service, token string) []*authv1.Session {
+	res, err := service.ListSessions(context.Background(), &connect.Request[authv1.ListSessionsRequest]{
+		Msg: &authv1.ListSessionsRequest{Token: token},
+	})
+	require.NoError(t, err)
+	return res.Msg.GetSessions()
+}
+
+func TestSessions(t *testing.T) {
+	service, _ := setupSessions(t)
+	ctx := context.Background()
+
+	phone := login(t, service, "phone")
+	laptop := login(t, service, "laptop")
+	tablet := login(t, service, "tablet")
+
+	sessions := listSessions(t, service, phone)
+	require.Len(t, sessions, 3)
+	var current *authv1.Session
+	for _, s := range sessions {
+		require.Equal(t, "test-agent", s.GetUserAgent())
+		require.NotContains(t, []string{phone, laptop, tablet}, s.GetId())
+		require.Greater(t, s.GetExpiresAt(), timezone.Now().Unix())
+		if s.GetCurrent() {
+			current = s
+		}
+	}
+	require.NotNil(t, current)
+	require.Equal(t, "phone", current.GetDevice())
+
+	// log out the laptop from the phone
+	var laptopId string
+	for _, s := range sessions {
+		if s.GetDevice() == "laptop" {
+			laptopId = s.GetId()
+		}
+	}
+	_, err := service.Logout(ctx, &connect.Request[authv1.LogoutRequest]{
+		Msg: &authv1.LogoutRequest{Token: phone, SessionId: laptopId},
+	})
+	require.NoError(t, err)
+	_, err = service.VerifyToken(ctx, &connect.Request[authv1.VerifyTokenRequest]{
+		Msg: &authv1.VerifyTokenRequest{Token: laptop},
+	})
+	require.Error(t, err)
+
+	_, err = service.Logout(ctx, &connect.Request[authv1.LogoutRequest]{
+		Msg: &authv1.LogoutRequest{Token: phone, SessionId: "unknown"},
+	})
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// revoking everything else keeps the phone logged in
+	_, err = service.RevokeAllSessions(ctx, &connect.Request[authv1.RevokeAllSessionsRequest]{
+		Msg: &authv1.RevokeAllSessionsRequest{Token: phone, KeepCurrent: true},
+	})
+	require.NoError(t, err)
+	sessions = listSessions(t, service, phone)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].GetCurrent())
+
+	_, err = service.ListSessions(ctx, &connect.Request[authv1.ListSessionsRequest]{
+		Msg: &authv1.ListSessionsRequest{Token: tablet},
+	})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	_, err = service.Logout(ctx, &connect.Request[authv1.LogoutRequest]{
+		Msg: &authv1.LogoutRequest{Token: phone},
+	})
+	require.NoError(t, err)
+	_, err = service.ListSessions(ctx, &connect.Request[authv1.ListSessionsRequest]{
+		Msg: &authv1.ListSessionsRequest{Token: phone},
+	})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestSlidingExpiration(t *testing.T) {
+	service, sqlite := setupSessions(t)
+	ctx := context.Background()
+	qry := db.New(sqlite)
+
+	token := login(t, service, "")
+	now := timezone.Now()
+
+	// a token that is about to expire is extended when used
+	err := qry.TouchToken(ctx, db.TouchTokenParams{
+		Lastusedat: now.Add(-verifier.TokenLifetime).Unix(),
+		Expiresat:  now.Add(time.Minute).Unix(),
+		Token:      token,
+	})
+	require.NoError(t, err)
+	_, err = service.verifier.VerifyToken(ctx, token)
+	require.NoError(t, err)
+	row, err := qry.GetToken(ctx, token)
+	require.NoError(t, err)
+	require.Greater(t, row.Expiresat, now.Add(verifier.TokenLifetime-time.Minute).Unix())
+
+	// a token that has expired is rejected and deleted
+	err = qry.TouchToken(ctx, db.TouchTokenParams{
+		Lastusedat: now.Add(-verifier.TokenLifetime * 2).Unix(),
+		Expiresat:  now.Add(-time.Minute).Unix(),
+		Token:      token,
+	})
+	require.NoError(t, err)
+	_, err = service.verifier.VerifyToken(ctx, token)
+	require.ErrorIs(t, err, verifier.InvalidToken)
+	_, err = qry.GetToken(ctx, token)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestPurge(t *testing.T) {
+	service, sqlite := setupSessions(t)
+	ctx := context.Background()
+	qry := db.New(sqlite)
+	now := timezone.Now()
+
+	err := qry.EnsureUserExists(ctx, testEmail)
+	require.NoError(t, err)
+	for token, expiresAt := range map[string]int64{
+		"expired": now.Add(-time.Hour).Unix(),
+		"active":  now.Add(time.Hour).Unix(),
+		"legacy":  0,
+	} {
+		err = qry.CreateToken(ctx, db.CreateTokenParams{
+			Token:     token,
+			Useremail: testEmail,
+			Expiresat: expiresAt,
+		})
+		require.NoError(t, err)
+	}
+	err = qry.CreateVerificationCode(ctx, db.CreateVerificationCodeParams{
+		Code:      "expired",
+		Useremail: testEmail,
+		Expiresat: now.Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	err = service.purge(ctx)
+	require.NoError(t, err)
+
+	_, err = qry.GetToken(ctx, "expired")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = qry.GetToken(ctx, "active")
+	require.NoError(t, err)
+	legacy, err := qry.GetToken(ctx, "legacy")
+	require.NoError(t, err)
+	require.NotZero(t, legacy.Expiresat)
+	_, err = qry.GetUserFromCode(ctx, "expired")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,193 @@
+package verifier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// access tokens can't be revoked since they are verified without the db,
+// so they only live long enough to save a db lookup on most requests
+const AccessTokenLifetime = time.Minute * 15
+
+// the iss claim of access tokens
+const accessTokenIssuer = "vcassist"
+
+// access tokens must be signed with keys at least this long
+const minAccessKeySize = 32
+
+// KeySet holds the keys access tokens are signed with.
+//
+// access tokens are JWTs signed with HS256 under the current key, the id
+// of the key is put in the kid header so tokens signed with any key in the
+// set can be verified. to rotate keys, add a new key and make it current,
+// the old key can be removed once the tokens signed with it have expired
+// (after AccessTokenLifetime).
+type KeySet struct {
+	currentId string
+	keys      map[string][]byte
+}
+
+// NewKeySet creates a key set from a map of key id -> key, currentId is
+// the key that new tokens will be signed with.
+//
+// if no keys are given, the key set is disabled and no access tokens are
+// issued or accepted.
+func NewKeySet(currentId string, keys map[string][]byte) (KeySet, error) {
+	if len(keys) == 0 {
+		return KeySet{}, nil
+	}
+	if currentId == "" {
+		return KeySet{}, fmt.Errorf("current key id must be specified")
+	}
+	for id, key := range keys {
+		if id == "" {
+			return KeySet{}, fmt.Errorf("key id cannot be empty")
+		}
+		if len(key) < minAccessKeySize {
+			return KeySet{}, fmt.Errorf("key '%s' must be at least %d bytes long, got %d", id, minAccessKeySize, len(key))
+		}
+	}
+	if _, ok := keys[currentId]; !ok {
+		return KeySet{}, fmt.Errorf("current key '%s' is not in the list of keys", currentId)
+	}
+	return KeySet{
+		currentId: currentId,
+		keys:      keys,
+	}, nil
+}
+
+// ParseKeySet is NewKeySet but with base64 encoded keys.
+func ParseKeySet(currentId string, keys map[string]string) (KeySet, error) {
+	decoded := make(map[string][]byte, len(keys))
+	for id, key := range keys {
+		buff, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return KeySet{}, fmt.Errorf("decode key '%s': %w", id, err)
+		}
+		decoded[id] = buff
+	}
+	return NewKeySet(currentId, decoded)
+}
+
+// Enabled returns true if the key set has keys to sign access tokens with.
+func (k KeySet) Enabled() bool {
+	return k.currentId != ""
+}
+
+type accessTokenHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+type accessTokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func encodeSegment(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSegment(segment string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func signature(key []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// Sign creates an access token for the given email that expires
+// AccessTokenLifetime after now.
+func (k KeySet) Sign(email string, now time.Time) (token string, expiresAt time.Time, err error) {
+	if !k.Enabled() {
+		return "", time.Time{}, fmt.Errorf("access tokens are not enabled")
+	}
+
+	expiresAt = now.Add(AccessTokenLifetime)
+	header, err := encodeSegment(accessTokenHeader{
+		Alg: "HS256",
+		Typ: "JWT",
+		Kid: k.currentId,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	claims, err := encodeSegment(accessTokenClaims{
+		Issuer:    accessTokenIssuer,
+		Subject:   email,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	signed := header + "." + claims
+	sig := signature(k.keys[k.currentId], signed)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), expiresAt, nil
+}
+
+// isAccessToken tells access tokens apart from session tokens, which are
+// hex encoded and so never contain a '.'
+func isAccessToken(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// verify returns the email of a valid access token, it returns
+// InvalidToken for tokens that are malformed, have been tampered with, are
+// signed with an unknown key or have expired.
+func (k KeySet) verify(token string, now time.Time) (string, error) {
+	if !k.Enabled() {
+		return "", InvalidToken
+	}
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return "", InvalidToken
+	}
+
+	var header accessTokenHeader
+	err := decodeSegment(segments[0], &header)
+	if err != nil || header.Alg != "HS256" {
+		return "", InvalidToken
+	}
+	key, ok := k.keys[header.Kid]
+	if !ok {
+		return "", InvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil {
+		return "", InvalidToken
+	}
+	if !hmac.Equal(sig, signature(key, segments[0]+"."+segments[1])) {
+		return "", InvalidToken
+	}
+
+	var claims accessTokenClaims
+	err = decodeSegment(segments[1], &claims)
+	if err != nil {
+		return "", InvalidToken
+	}
+	if claims.Issuer != accessTokenIssuer || claims.Subject == "" || now.Unix() >= claims.ExpiresAt {
+		return "", InvalidToken
+	}
+
+	return claims.Subject, nil
+}
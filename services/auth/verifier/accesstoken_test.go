@@ -0,0 +1,120 @@
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/timezone"
+
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestNewKeySet(t *testing.T) {
+	keys, err := NewKeySet("", nil)
+	require.NoError(t, err)
+	require.False(t, keys.Enabled())
+	_, _, err = keys.Sign("student@example.com", timezone.Now())
+	require.Error(t, err)
+
+	_, err = NewKeySet("", map[string][]byte{"1": testKey(1)})
+	require.Error(t, err)
+	_, err = NewKeySet("2", map[string][]byte{"1": testKey(1)})
+	require.Error(t, err)
+	_, err = NewKeySet("1", map[string][]byte{"1": []byte("too short")})
+	require.Error(t, err)
+
+	keys, err = ParseKeySet("1", map[string]string{"1": base64.StdEncoding.EncodeToString(testKey(1))})
+	require.NoError(t, err)
+	require.True(t, keys.Enabled())
+}
+
+func TestAccessToken(t *testing.T) {
+	keys, err := NewKeySet("1", map[string][]byte{"1": testKey(1)})
+	require.NoError(t, err)
+
+	now := timezone.Now()
+	token, expiresAt, err := keys.Sign("student@example.com", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(AccessTokenLifetime).Unix(), expiresAt.Unix())
+	require.True(t, isAccessToken(token))
+
+	email, err := keys.verify(token, now)
+	require.NoError(t, err)
+	require.Equal(t, "student@example.com", email)
+
+	_, err = keys.verify(token, now.Add(AccessTokenLifetime))
+	require.ErrorIs(t, err, InvalidToken)
+
+	// changing the claims invalidates the signature
+	segments := strings.Split(token, ".")
+	claims, err := encodeSegment(accessTokenClaims{
+		Issuer:    accessTokenIssuer,
+		Subject:   "admin@example.com",
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	_, err = keys.verify(segments[0]+"."+claims+"."+segments[2], now)
+	require.ErrorIs(t, err, InvalidToken)
+
+	// tokens signed with a key that isn't in the set are rejected
+	other, err := NewKeySet("1", map[string][]byte{"1": testKey(2)})
+	require.NoError(t, err)
+	_, err = other.verify(token, now)
+	require.ErrorIs(t, err, InvalidToken)
+}
+
+func TestKeyRotation(t *testing.T) {
+	now := timezone.Now()
+	old, err := NewKeySet("1", map[string][]byte{"1": testKey(1)})
+	require.NoError(t, err)
+	oldToken, _, err := old.Sign("student@example.com", now)
+	require.NoError(t, err)
+
+	// tokens signed with the previous key are accepted while it is still
+	// in the set
+	rotated, err := NewKeySet("2", map[string][]byte{"1": testKey(1), "2": testKey(2)})
+	require.NoError(t, err)
+	_, err = rotated.verify(oldToken, now)
+	require.NoError(t, err)
+	newToken, _, err := rotated.Sign("student@example.com", now)
+	require.NoError(t, err)
+	_, err = old.verify(newToken, now)
+	require.ErrorIs(t, err, InvalidToken)
+
+	removed, err := NewKeySet("2", map[string][]byte{"2": testKey(2)})
+	require.NoError(t, err)
+	_, err = removed.verify(oldToken, now)
+	require.ErrorIs(t, err, InvalidToken)
+	_, err = removed.verify(newToken, now)
+	require.NoError(t, err)
+}
+
+func TestVerifyAccessTokenWithoutDb(t *testing.T) {
+	keys, err := NewKeySet("1", map[string][]byte{"1": testKey(1)})
+	require.NoError(t, err)
+
+	// the database is closed so any query would fail
+	database, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	database.Close()
+	verifier := NewVerifier(database, keys)
+
+	token, _, err := keys.Sign("student@example.com", timezone.Now())
+	require.NoError(t, err)
+	user, err := verifier.VerifyToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, "student@example.com", user.Email)
+
+	_, err = verifier.VerifyToken(context.Background(), "a.b.c")
+	require.ErrorIs(t, err, InvalidToken)
+}
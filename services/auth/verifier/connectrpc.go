@@ -2,6 +2,7 @@ package verifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"vcassist-backend/services/auth/db"
@@ -30,11 +31,15 @@ func (i AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 
 		token := split[1]
 		user, err := i.verifier.VerifyToken(ctx, token)
+		if errors.Is(err, InvalidToken) {
+			// clients refresh their access token when they get this
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		ctx = context.WithValue(ctx, profileCtxKey, user)
+		ctx = ContextWithProfile(ctx, user)
 		return next(ctx, req)
 	}
 }
@@ -48,10 +53,13 @@ func (i AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc)
 		}
 		token := split[1]
 		user, err := i.verifier.VerifyToken(ctx, token)
+		if errors.Is(err, InvalidToken) {
+			return connect.NewError(connect.CodeUnauthenticated, err)
+		}
 		if err != nil {
 			return err
 		}
-		ctx = context.WithValue(ctx, profileCtxKey, user)
+		ctx = ContextWithProfile(ctx, user)
 		return next(ctx, conn)
 	}
 }
@@ -64,6 +72,12 @@ func NewAuthInterceptor(verifier Verifier) AuthInterceptor {
 	return AuthInterceptor{verifier: verifier}
 }
 
+// ContextWithProfile returns a copy of ctx that carries the given profile,
+// this is what the AuthInterceptor does after verifying a token.
+func ContextWithProfile(ctx context.Context, profile db.User) context.Context {
+	return context.WithValue(ctx, profileCtxKey, profile)
+}
+
 func ProfileFromContext(ctx context.Context) db.User {
 	span := trace.SpanFromContext(ctx)
 	profile, ok := ctx.Value(profileCtxKey).(db.User)
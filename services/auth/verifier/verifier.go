@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/lib/timezone"
 	"vcassist-backend/services/auth/db"
 
 	"go.opentelemetry.io/otel"
@@ -42,28 +43,81 @@ func init() {
 }
 
 type Verifier struct {
-	qry *db.Queries
+	qry  *db.Queries
+	keys KeySet
 }
 
-func NewVerifier(database *sql.DB) Verifier {
-	return Verifier{qry: db.New(database)}
+// NewVerifier creates a verifier that accepts session tokens and, if the
+// key set is enabled, access tokens signed with it.
+func NewVerifier(database *sql.DB, keys KeySet) Verifier {
+	return Verifier{qry: db.New(database), keys: keys}
+}
+
+func trackLogin(email string) {
+	defer loginTrackerMutex.Unlock()
+	loginTrackerMutex.Lock()
+
+	loginTracker[email] = struct{}{}
 }
 
 var InvalidToken = fmt.Errorf("invalid token")
 
+// tokens expire once they haven't been used for this long
+const TokenLifetime = time.Hour * 24 * 30
+
+// the last use of a token is only written once the previous one is at
+// least this old, so not every request has to write to the db
+const touchInterval = time.Minute
+
+// VerifyToken returns the user an access token or session token belongs
+// to, access tokens are verified without touching the db.
 func (v Verifier) VerifyToken(ctx context.Context, token string) (db.User, error) {
-	email, err := v.qry.GetUserFromToken(ctx, token)
+	if !isAccessToken(token) {
+		return v.VerifySessionToken(ctx, token)
+	}
+
+	email, err := v.keys.verify(token, timezone.Now())
+	if err != nil {
+		return db.User{}, err
+	}
+	trackLogin(email)
+	return db.User{Email: email}, nil
+}
+
+// VerifySessionToken returns the user a session token (the long lived
+// token that access tokens are refreshed with) belongs to, using the token
+// pushes its expiry back to TokenLifetime from now.
+func (v Verifier) VerifySessionToken(ctx context.Context, token string) (db.User, error) {
+	row, err := v.qry.GetToken(ctx, token)
 	if sql.ErrNoRows == err {
 		return db.User{}, InvalidToken
 	} else if err != nil {
-		slog.ErrorContext(ctx, "failed to read user from token in db", "err", err)
+		slog.ErrorContext(ctx, "failed to read token from db", "err", err)
 		return db.User{}, err
 	}
 
-	defer loginTrackerMutex.Unlock()
-	loginTrackerMutex.Lock()
-
-	loginTracker[email] = struct{}{}
+	now := timezone.Now()
+	// tokens without an expiry predate expiration, they are given one
+	// below the same as any other token that is used
+	if row.Expiresat != 0 && row.Expiresat < now.Unix() {
+		err = v.qry.DeleteToken(ctx, token)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to delete expired token", "err", err)
+		}
+		return db.User{}, InvalidToken
+	}
+	if now.Unix()-row.Lastusedat >= int64(touchInterval/time.Second) || row.Expiresat == 0 {
+		err = v.qry.TouchToken(ctx, db.TouchTokenParams{
+			Lastusedat: now.Unix(),
+			Expiresat:  now.Add(TokenLifetime).Unix(),
+			Token:      token,
+		})
+		// the token is still valid even if its use couldn't be recorded
+		if err != nil {
+			slog.WarnContext(ctx, "failed to update token last used", "err", err)
+		}
+	}
 
-	return db.User{Email: email}, nil
+	trackLogin(row.Useremail)
+	return db.User{Email: row.Useremail}, nil
 }
@@ -0,0 +1,14 @@
+-- name: GetEvents :many
+select * from Event
+where date >= sqlc.arg(start) and date < sqlc.arg(stop)
+order by date asc;
+
+-- name: GetAllEvents :many
+select * from Event order by date asc;
+
+-- name: CreateEvent :exec
+insert into Event(name, date) values (?, ?)
+on conflict do nothing;
+
+-- name: DeleteAllEvents :exec
+delete from Event;
@@ -0,0 +1,5 @@
+create table Event (
+    name text not null,
+    date integer not null,
+    primary key (name, date)
+);
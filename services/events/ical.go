@@ -0,0 +1,92 @@
+package events
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"vcassist-backend/lib/timezone"
+	"vcassist-backend/services/events/db"
+)
+
+const icalDateFormat = "20060102"
+const icalTimestampFormat = "20060102T150405Z"
+
+var icalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// icalLine writes a single content line, folding it so that no line
+// exceeds 75 octets as required by RFC 5545
+func icalLine(w io.Writer, line string) {
+	for len(line) > 75 {
+		cut := 75
+		// don't split a multi-byte utf-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		fmt.Fprintf(w, "%s\r\n", line[:cut])
+		// the leading space of the continuation line counts towards its length
+		line = " " + line[cut:]
+	}
+	fmt.Fprintf(w, "%s\r\n", line)
+}
+
+func eventUid(e db.Event) string {
+	hash := sha1.Sum([]byte(fmt.Sprintf("%d:%s", e.Date, e.Name)))
+	return hex.EncodeToString(hash[:]) + "@vcassist"
+}
+
+// writeICalendar writes the given events as an all-day event iCalendar feed
+func writeICalendar(w io.Writer, events []db.Event, now time.Time) {
+	stamp := now.UTC().Format(icalTimestampFormat)
+
+	icalLine(w, "BEGIN:VCALENDAR")
+	icalLine(w, "VERSION:2.0")
+	icalLine(w, "PRODID:-//vcassist//events//EN")
+	icalLine(w, "CALSCALE:GREGORIAN")
+	icalLine(w, "METHOD:PUBLISH")
+	icalLine(w, "X-WR-CALNAME:VCS Events")
+	icalLine(w, "X-WR-TIMEZONE:"+timezone.Location.String())
+	for _, e := range events {
+		date := time.Unix(e.Date, 0).In(timezone.Location)
+		start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, timezone.Location)
+		end := start.AddDate(0, 0, 1)
+
+		icalLine(w, "BEGIN:VEVENT")
+		icalLine(w, "UID:"+eventUid(e))
+		icalLine(w, "DTSTAMP:"+stamp)
+		icalLine(w, "DTSTART;VALUE=DATE:"+start.Format(icalDateFormat))
+		icalLine(w, "DTEND;VALUE=DATE:"+end.Format(icalDateFormat))
+		icalLine(w, "SUMMARY:"+icalEscaper.Replace(strings.TrimSpace(e.Name)))
+		icalLine(w, "TRANSP:TRANSPARENT")
+		icalLine(w, "END:VEVENT")
+	}
+	icalLine(w, "END:VCALENDAR")
+}
+
+// ServeICalendar serves all cached events as an iCalendar (.ics) feed that
+// can be subscribed to from a calendar app.
+func (s Service) ServeICalendar(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "ServeICalendar")
+	defer span.End()
+
+	events, err := s.qry.GetAllEvents(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "get all events", "err", err)
+		http.Error(w, "failed to get events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("content-type", "text/calendar; charset=utf-8")
+	w.Header().Set("content-disposition", `inline; filename="events.ics"`)
+	writeICalendar(w, events, timezone.Now())
+}
@@ -0,0 +1,135 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+	"vcassist-backend/lib/scrapers/vcsnet"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/lib/timezone"
+	eventsv1 "vcassist-backend/proto/vcassist/services/events/v1"
+	"vcassist-backend/services/events/db"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/codes"
+
+	_ "modernc.org/sqlite"
+)
+
+var tracer = telemetry.Tracer("vcassist.services.events")
+
+type Service struct {
+	db  *sql.DB
+	qry *db.Queries
+}
+
+// NewService creates the events service and starts the daemon that keeps
+// the cached school calendar up to date.
+func NewService(ctx context.Context, database *sql.DB) Service {
+	s := Service{
+		db:  database,
+		qry: db.New(database),
+	}
+	go s.fetchEventsDaemon(ctx)
+	return s
+}
+
+func (s Service) cacheEvents(ctx context.Context, events []vcsnet.Event) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	err = txqry.DeleteAllEvents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		err = txqry.CreateEvent(ctx, db.CreateEventParams{
+			Name: e.Name,
+			Date: e.Date.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s Service) fetchEvents(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "fetchEvents")
+	defer span.End()
+
+	events, err := vcsnet.FetchEvents(ctx, timezone.Location)
+	if err != nil {
+		// FetchEvents can return a partial calendar when some of the pages
+		// fail, we don't want to overwrite a complete cache with that
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch events")
+		return err
+	}
+
+	err = s.cacheEvents(ctx, events)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to cache events")
+		return err
+	}
+	return nil
+}
+
+func (s Service) fetchEventsDaemon(ctx context.Context) {
+	slog.InfoContext(ctx, "start daemon", "task", "fetch school events at startup and at 3:00 every day")
+
+	err := s.fetchEvents(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "fetch events", "err", err)
+	}
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if timezone.Now().Hour() != 3 {
+				continue
+			}
+
+			slog.InfoContext(ctx, "fetching school events...")
+			err := s.fetchEvents(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "fetch events", "err", err)
+			}
+		}
+	}
+}
+
+func (s Service) GetEvents(ctx context.Context, req *connect.Request[eventsv1.GetEventsRequest]) (*connect.Response[eventsv1.GetEventsResponse], error) {
+	rows, err := s.qry.GetEvents(ctx, db.GetEventsParams{
+		Start: req.Msg.GetStart(),
+		Stop:  req.Msg.GetEnd(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*eventsv1.Event, len(rows))
+	for i, r := range rows {
+		events[i] = &eventsv1.Event{
+			Name: r.Name,
+			Date: r.Date,
+		}
+	}
+
+	return &connect.Response[eventsv1.GetEventsResponse]{
+		Msg: &eventsv1.GetEventsResponse{
+			Events: events,
+		},
+	}, nil
+}
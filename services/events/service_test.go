@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/scrapers/vcsnet"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/lib/timezone"
+	eventsv1 "vcassist-backend/proto/vcassist/services/events/v1"
+	"vcassist-backend/services/events/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:events")
+	defer cleanup()
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// not using NewService here because it would start fetching
+	// from vcs.net
+	service := Service{
+		db:  sqlite,
+		qry: db.New(sqlite),
+	}
+
+	tz := timezone.Location
+	err = service.cacheEvents(ctx, []vcsnet.Event{
+		{Name: "First Day of School", Date: time.Date(2024, 8, 14, 0, 0, 0, 0, tz)},
+		{Name: "Labor Day, No School", Date: time.Date(2024, 9, 2, 0, 0, 0, 0, tz)},
+		{Name: "Thanksgiving Break", Date: time.Date(2024, 11, 25, 0, 0, 0, 0, tz)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := service.GetEvents(ctx, &connect.Request[eventsv1.GetEventsRequest]{
+		Msg: &eventsv1.GetEventsRequest{
+			Start: time.Date(2024, 8, 1, 0, 0, 0, 0, tz).Unix(),
+			End:   time.Date(2024, 10, 1, 0, 0, 0, 0, tz).Unix(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, res.Msg.GetEvents(), 2)
+	require.Equal(t, "First Day of School", res.Msg.GetEvents()[0].GetName())
+	require.Equal(t, "Labor Day, No School", res.Msg.GetEvents()[1].GetName())
+
+	recorder := httptest.NewRecorder()
+	service.ServeICalendar(recorder, httptest.NewRequest("GET", "/events.ics", nil))
+	require.Equal(t, 200, recorder.Code)
+
+	body := recorder.Body.String()
+	require.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	require.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	require.Equal(t, 3, strings.Count(body, "BEGIN:VEVENT\r\n"))
+	require.Contains(t, body, "DTSTART;VALUE=DATE:20240902\r\n")
+	require.Contains(t, body, "DTEND;VALUE=DATE:20240903\r\n")
+	require.Contains(t, body, `SUMMARY:Labor Day\, No School`)
+}
+
+func TestICalLineFolding(t *testing.T) {
+	builder := &strings.Builder{}
+	icalLine(builder, "SUMMARY:"+strings.Repeat("é", 100))
+
+	lines := strings.Split(strings.TrimSuffix(builder.String(), "\r\n"), "\r\n")
+	require.Greater(t, len(lines), 1)
+	unfolded := ""
+	for i, l := range lines {
+		require.LessOrEqual(t, len(l), 75)
+		if i > 0 {
+			require.True(t, strings.HasPrefix(l, " "))
+			l = l[1:]
+		}
+		unfolded += l
+	}
+	require.Equal(t, "SUMMARY:"+strings.Repeat("é", 100), unfolded)
+}
@@ -0,0 +1,76 @@
+package gradesnapshots
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"vcassist-backend/lib/gradestore"
+	gradesnapshotsv1 "vcassist-backend/proto/vcassist/services/gradesnapshots/v1"
+
+	"connectrpc.com/connect"
+)
+
+type Service struct {
+	store gradestore.Store
+}
+
+func NewService(database *sql.DB) Service {
+	return Service{
+		store: gradestore.NewStore(database),
+	}
+}
+
+func (s Service) Push(ctx context.Context, req *connect.Request[gradesnapshotsv1.PushRequest]) (*connect.Response[gradesnapshotsv1.PushResponse], error) {
+	courses := make([]gradestore.CourseSnapshot, len(req.Msg.GetCourses()))
+	for i, c := range req.Msg.GetCourses() {
+		courses[i] = gradestore.CourseSnapshot{
+			Course: c.GetCourse(),
+			Value:  float64(c.GetValue()),
+		}
+	}
+
+	err := s.store.Push(ctx, gradestore.PushRequest{
+		Time: time.Unix(req.Msg.GetTime(), 0),
+		Users: []gradestore.UserSnapshot{
+			{
+				User:    req.Msg.GetUser(),
+				Courses: courses,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[gradesnapshotsv1.PushResponse]{
+		Msg: &gradesnapshotsv1.PushResponse{},
+	}, nil
+}
+
+func (s Service) Pull(ctx context.Context, req *connect.Request[gradesnapshotsv1.PullRequest]) (*connect.Response[gradesnapshotsv1.PullResponse], error) {
+	series, err := s.store.Pull(ctx, req.Msg.GetUser())
+	if err != nil {
+		return nil, err
+	}
+
+	courses := make([]*gradesnapshotsv1.PullResponse_Course, len(series))
+	for i, c := range series {
+		snapshots := make([]*gradesnapshotsv1.PullResponse_Course_Snapshot, len(c.Snapshots))
+		for j, s := range c.Snapshots {
+			snapshots[j] = &gradesnapshotsv1.PullResponse_Course_Snapshot{
+				Time:  s.Time.Unix(),
+				Value: s.Value,
+			}
+		}
+		courses[i] = &gradesnapshotsv1.PullResponse_Course{
+			Course:    c.Course,
+			Snapshots: snapshots,
+		}
+	}
+
+	return &connect.Response[gradesnapshotsv1.PullResponse]{
+		Msg: &gradesnapshotsv1.PullResponse{
+			Courses: courses,
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package gradesnapshots
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"vcassist-backend/lib/gradestore/db"
+	"vcassist-backend/lib/telemetry"
+	gradesnapshotsv1 "vcassist-backend/proto/vcassist/services/gradesnapshots/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:gradesnapshots")
+	defer cleanup()
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	service := NewService(sqlite)
+
+	now := time.Now().Unix()
+	_, err = service.Push(ctx, &connect.Request[gradesnapshotsv1.PushRequest]{
+		Msg: &gradesnapshotsv1.PushRequest{
+			User: "user1",
+			Time: now,
+			Courses: []*gradesnapshotsv1.PushRequest_Course{
+				{Course: "course1", Value: 90},
+				{Course: "course2", Value: 85.5},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := service.Pull(ctx, &connect.Request[gradesnapshotsv1.PullRequest]{
+		Msg: &gradesnapshotsv1.PullRequest{User: "user1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	courses := res.Msg.GetCourses()
+	require.Len(t, courses, 2)
+	values := map[string]float32{}
+	for _, c := range courses {
+		require.Len(t, c.GetSnapshots(), 1)
+		require.Equal(t, now, c.GetSnapshots()[0].GetTime())
+		values[c.GetCourse()] = c.GetSnapshots()[0].GetValue()
+	}
+	require.Equal(t, float32(90), values["course1"])
+	require.Equal(t, float32(85.5), values["course2"])
+
+	res, err = service.Pull(ctx, &connect.Request[gradesnapshotsv1.PullRequest]{
+		Msg: &gradesnapshotsv1.PullRequest{User: "unknown-user"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, res.Msg.GetCourses())
+}
@@ -11,11 +11,18 @@ type OAuth struct {
 	RefreshUrl string
 	ClientID   string
 	ExpiresAt  int64
+	KeyID      string
+	DataKey    string
 }
 
 type UsernamePassword struct {
-	Namespace string
-	ID        string
-	Username  string
-	Password  string
+	Namespace   string
+	ID          string
+	Username    string
+	Password    string
+	KeyID       string
+	DataKey     string
+	Validity    int64
+	CheckedAt   int64
+	CheckReason string
 }
@@ -5,24 +5,41 @@ select * from OAuth where expires_at < ?;
 delete from OAuth where expires_at < ?;
 
 -- name: GetUsernamePassword :one
-select username, password from UsernamePassword where
+select username, password, key_id, data_key, validity, checked_at, check_reason from UsernamePassword where
 namespace = ? and id = ?;
 
 -- name: GetOAuth :one
-select token, refresh_url, client_id, expires_at from OAuth where
+select token, refresh_url, client_id, expires_at, key_id, data_key from OAuth where
 namespace = ? and id = ?;
 
 -- name: CreateOAuth :exec
-insert into OAuth(namespace, id, token, refresh_url, client_id, expires_at) values (?, ?, ?, ?, ?, ?)
+insert into OAuth(namespace, id, token, refresh_url, client_id, expires_at, key_id, data_key) values (?, ?, ?, ?, ?, ?, ?, ?)
 on conflict do update set
     token = EXCLUDED.token,
     refresh_url = EXCLUDED.refresh_url,
     client_id = EXCLUDED.client_id,
-    expires_at = EXCLUDED.expires_at;
+    expires_at = EXCLUDED.expires_at,
+    key_id = EXCLUDED.key_id,
+    data_key = EXCLUDED.data_key;
 
 -- name: CreateUsernamePassword :exec
-insert into UsernamePassword(namespace, id, username, password) values (?, ?, ?, ?)
+insert into UsernamePassword(namespace, id, username, password, key_id, data_key, validity, checked_at, check_reason) values (?, ?, ?, ?, ?, ?, ?, ?, ?)
 on conflict do update set
     username = EXCLUDED.username,
-    password = EXCLUDED.password;
+    password = EXCLUDED.password,
+    key_id = EXCLUDED.key_id,
+    data_key = EXCLUDED.data_key,
+    validity = EXCLUDED.validity,
+    checked_at = EXCLUDED.checked_at,
+    check_reason = EXCLUDED.check_reason;
+
+-- name: SetUsernamePasswordCheck :execrows
+update UsernamePassword set validity = ?, checked_at = ?, check_reason = ?
+where namespace = ? and id = ?;
+
+-- name: GetOAuthNotUnderKey :many
+select * from OAuth where key_id != ?;
+
+-- name: GetUsernamePasswordNotUnderKey :many
+select * from UsernamePassword where key_id != ?;
 
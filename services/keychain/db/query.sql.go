@@ -10,12 +10,14 @@ import (
 )
 
 const createOAuth = `-- name: CreateOAuth :exec
-insert into OAuth(namespace, id, token, refresh_url, client_id, expires_at) values (?, ?, ?, ?, ?, ?)
+insert into OAuth(namespace, id, token, refresh_url, client_id, expires_at, key_id, data_key) values (?, ?, ?, ?, ?, ?, ?, ?)
 on conflict do update set
     token = EXCLUDED.token,
     refresh_url = EXCLUDED.refresh_url,
     client_id = EXCLUDED.client_id,
-    expires_at = EXCLUDED.expires_at
+    expires_at = EXCLUDED.expires_at,
+    key_id = EXCLUDED.key_id,
+    data_key = EXCLUDED.data_key
 `
 
 type CreateOAuthParams struct {
@@ -25,6 +27,8 @@ type CreateOAuthParams struct {
 	RefreshUrl string
 	ClientID   string
 	ExpiresAt  int64
+	KeyID      string
+	DataKey    string
 }
 
 func (q *Queries) CreateOAuth(ctx context.Context, arg CreateOAuthParams) error {
@@ -35,22 +39,34 @@ func (q *Queries) CreateOAuth(ctx context.Context, arg CreateOAuthParams) error
 		arg.RefreshUrl,
 		arg.ClientID,
 		arg.ExpiresAt,
+		arg.KeyID,
+		arg.DataKey,
 	)
 	return err
 }
 
 const createUsernamePassword = `-- name: CreateUsernamePassword :exec
-insert into UsernamePassword(namespace, id, username, password) values (?, ?, ?, ?)
+insert into UsernamePassword(namespace, id, username, password, key_id, data_key, validity, checked_at, check_reason) values (?, ?, ?, ?, ?, ?, ?, ?, ?)
 on conflict do update set
     username = EXCLUDED.username,
-    password = EXCLUDED.password
+    password = EXCLUDED.password,
+    key_id = EXCLUDED.key_id,
+    data_key = EXCLUDED.data_key,
+    validity = EXCLUDED.validity,
+    checked_at = EXCLUDED.checked_at,
+    check_reason = EXCLUDED.check_reason
 `
 
 type CreateUsernamePasswordParams struct {
-	Namespace string
-	ID        string
-	Username  string
-	Password  string
+	Namespace   string
+	ID          string
+	Username    string
+	Password    string
+	KeyID       string
+	DataKey     string
+	Validity    int64
+	CheckedAt   int64
+	CheckReason string
 }
 
 func (q *Queries) CreateUsernamePassword(ctx context.Context, arg CreateUsernamePasswordParams) error {
@@ -59,6 +75,11 @@ func (q *Queries) CreateUsernamePassword(ctx context.Context, arg CreateUsername
 		arg.ID,
 		arg.Username,
 		arg.Password,
+		arg.KeyID,
+		arg.DataKey,
+		arg.Validity,
+		arg.CheckedAt,
+		arg.CheckReason,
 	)
 	return err
 }
@@ -73,7 +94,7 @@ func (q *Queries) DeleteOAuthBefore(ctx context.Context, expiresAt int64) error
 }
 
 const getOAuth = `-- name: GetOAuth :one
-select token, refresh_url, client_id, expires_at from OAuth where
+select token, refresh_url, client_id, expires_at, key_id, data_key from OAuth where
 namespace = ? and id = ?
 `
 
@@ -87,6 +108,8 @@ type GetOAuthRow struct {
 	RefreshUrl string
 	ClientID   string
 	ExpiresAt  int64
+	KeyID      string
+	DataKey    string
 }
 
 func (q *Queries) GetOAuth(ctx context.Context, arg GetOAuthParams) (GetOAuthRow, error) {
@@ -97,12 +120,14 @@ func (q *Queries) GetOAuth(ctx context.Context, arg GetOAuthParams) (GetOAuthRow
 		&i.RefreshUrl,
 		&i.ClientID,
 		&i.ExpiresAt,
+		&i.KeyID,
+		&i.DataKey,
 	)
 	return i, err
 }
 
 const getOAuthBefore = `-- name: GetOAuthBefore :many
-select namespace, id, token, refresh_url, client_id, expires_at from OAuth where expires_at < ?
+select namespace, id, token, refresh_url, client_id, expires_at, key_id, data_key from OAuth where expires_at < ?
 `
 
 func (q *Queries) GetOAuthBefore(ctx context.Context, expiresAt int64) ([]OAuth, error) {
@@ -121,6 +146,44 @@ func (q *Queries) GetOAuthBefore(ctx context.Context, expiresAt int64) ([]OAuth,
 			&i.RefreshUrl,
 			&i.ClientID,
 			&i.ExpiresAt,
+			&i.KeyID,
+			&i.DataKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOAuthNotUnderKey = `-- name: GetOAuthNotUnderKey :many
+select namespace, id, token, refresh_url, client_id, expires_at, key_id, data_key from OAuth where key_id != ?
+`
+
+func (q *Queries) GetOAuthNotUnderKey(ctx context.Context, keyID string) ([]OAuth, error) {
+	rows, err := q.db.QueryContext(ctx, getOAuthNotUnderKey, keyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OAuth
+	for rows.Next() {
+		var i OAuth
+		if err := rows.Scan(
+			&i.Namespace,
+			&i.ID,
+			&i.Token,
+			&i.RefreshUrl,
+			&i.ClientID,
+			&i.ExpiresAt,
+			&i.KeyID,
+			&i.DataKey,
 		); err != nil {
 			return nil, err
 		}
@@ -136,7 +199,7 @@ func (q *Queries) GetOAuthBefore(ctx context.Context, expiresAt int64) ([]OAuth,
 }
 
 const getUsernamePassword = `-- name: GetUsernamePassword :one
-select username, password from UsernamePassword where
+select username, password, key_id, data_key, validity, checked_at, check_reason from UsernamePassword where
 namespace = ? and id = ?
 `
 
@@ -146,13 +209,90 @@ type GetUsernamePasswordParams struct {
 }
 
 type GetUsernamePasswordRow struct {
-	Username string
-	Password string
+	Username    string
+	Password    string
+	KeyID       string
+	DataKey     string
+	Validity    int64
+	CheckedAt   int64
+	CheckReason string
 }
 
 func (q *Queries) GetUsernamePassword(ctx context.Context, arg GetUsernamePasswordParams) (GetUsernamePasswordRow, error) {
 	row := q.db.QueryRowContext(ctx, getUsernamePassword, arg.Namespace, arg.ID)
 	var i GetUsernamePasswordRow
-	err := row.Scan(&i.Username, &i.Password)
+	err := row.Scan(
+		&i.Username,
+		&i.Password,
+		&i.KeyID,
+		&i.DataKey,
+		&i.Validity,
+		&i.CheckedAt,
+		&i.CheckReason,
+	)
 	return i, err
 }
+
+const getUsernamePasswordNotUnderKey = `-- name: GetUsernamePasswordNotUnderKey :many
+select namespace, id, username, password, key_id, data_key, validity, checked_at, check_reason from UsernamePassword where key_id != ?
+`
+
+func (q *Queries) GetUsernamePasswordNotUnderKey(ctx context.Context, keyID string) ([]UsernamePassword, error) {
+	rows, err := q.db.QueryContext(ctx, getUsernamePasswordNotUnderKey, keyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsernamePassword
+	for rows.Next() {
+		var i UsernamePassword
+		if err := rows.Scan(
+			&i.Namespace,
+			&i.ID,
+			&i.Username,
+			&i.Password,
+			&i.KeyID,
+			&i.DataKey,
+			&i.Validity,
+			&i.CheckedAt,
+			&i.CheckReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUsernamePasswordCheck = `-- name: SetUsernamePasswordCheck :execrows
+update UsernamePassword set validity = ?, checked_at = ?, check_reason = ?
+where namespace = ? and id = ?
+`
+
+type SetUsernamePasswordCheckParams struct {
+	Validity    int64
+	CheckedAt   int64
+	CheckReason string
+	Namespace   string
+	ID          string
+}
+
+func (q *Queries) SetUsernamePasswordCheck(ctx context.Context, arg SetUsernamePasswordCheckParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setUsernamePasswordCheck,
+		arg.Validity,
+		arg.CheckedAt,
+		arg.CheckReason,
+		arg.Namespace,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
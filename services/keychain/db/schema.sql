@@ -1,3 +1,7 @@
+-- secrets (token, username, password) are encrypted with a per-row
+-- data key, the data key is in turn encrypted with the master key
+-- identified by key_id. an empty key_id means the row is stored in
+-- plaintext (this is the case for rows that predate encryption).
 create table OAuth (
     namespace text not null,
     id text not null,
@@ -5,6 +9,8 @@ create table OAuth (
     refresh_url text not null,
     client_id text not null,
     expires_at integer not null,
+    key_id text not null default '',
+    data_key text not null default '',
     primary key (namespace, id)
 );
 
@@ -13,6 +19,13 @@ create table UsernamePassword (
     id text not null,
     username text not null,
     password text not null,
+    key_id text not null default '',
+    data_key text not null default '',
+    -- the result of the last login with the key, see KeyValidity in
+    -- the keychain proto. checked_at is 0 if it has never been checked.
+    validity integer not null default 0,
+    checked_at integer not null default 0,
+    check_reason text not null default '',
     primary key (namespace, id)
 );
 
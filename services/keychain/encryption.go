@@ -0,0 +1,202 @@
+package keychain
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// Keyring holds the master keys used for envelope encryption.
+//
+// every row gets its own randomly generated data key which is used to
+// encrypt the secrets in that row, the data key is then encrypted with
+// the current master key and stored alongside the row with the id of the
+// master key. this means rotating the master key only requires the data
+// keys to be re-encrypted, and that old master keys can be kept around to
+// read rows which haven't been rotated yet.
+type Keyring struct {
+	currentId string
+	keys      map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring from a map of key id -> 32 byte AES-256 key,
+// currentId is the key that new secrets will be encrypted with.
+//
+// if no keys are given, the keyring is disabled and secrets will be stored
+// in plaintext.
+func NewKeyring(currentId string, keys map[string][]byte) (Keyring, error) {
+	if len(keys) == 0 {
+		return Keyring{}, nil
+	}
+	if currentId == "" {
+		return Keyring{}, fmt.Errorf("current key id must be specified")
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if id == "" {
+			return Keyring{}, fmt.Errorf("key id cannot be empty")
+		}
+		if len(key) != 32 {
+			return Keyring{}, fmt.Errorf("key '%s' must be 32 bytes long, got %d", id, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return Keyring{}, err
+		}
+		aeads[id] = aead
+	}
+	if _, ok := aeads[currentId]; !ok {
+		return Keyring{}, fmt.Errorf("current key '%s' is not in the list of keys", currentId)
+	}
+
+	return Keyring{
+		currentId: currentId,
+		keys:      aeads,
+	}, nil
+}
+
+// ParseKeyring is NewKeyring but with base64 encoded keys.
+func ParseKeyring(currentId string, keys map[string]string) (Keyring, error) {
+	decoded := make(map[string][]byte, len(keys))
+	for id, key := range keys {
+		buff, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return Keyring{}, fmt.Errorf("decode key '%s': %w", id, err)
+		}
+		decoded[id] = buff
+	}
+	return NewKeyring(currentId, decoded)
+}
+
+// CurrentKeyId returns the id of the key that new secrets are encrypted
+// with, this will be an empty string if encryption is disabled.
+func (k Keyring) CurrentKeyId() string {
+	return k.currentId
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		panic(err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData)
+}
+
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+	nonce := sealed[:aead.NonceSize()]
+	return aead.Open(nil, nonce, sealed[aead.NonceSize():], additionalData)
+}
+
+// rowCipher encrypts and decrypts the secrets of a single row.
+type rowCipher struct {
+	// keyId and dataKey are what should be stored in the
+	// key_id and data_key columns of the row
+	keyId   string
+	dataKey string
+	aead    cipher.AEAD
+	// the namespace and id of the row, this is used as additional
+	// data so that ciphertext cannot be moved between rows
+	additionalData []byte
+}
+
+func rowAdditionalData(table, namespace, id string) []byte {
+	return []byte(table + "\x00" + namespace + "\x00" + id)
+}
+
+// newRow generates a new data key for a row and encrypts it with the
+// current master key.
+func (k Keyring) newRow(table, namespace, id string) (rowCipher, error) {
+	additionalData := rowAdditionalData(table, namespace, id)
+	if k.currentId == "" {
+		return rowCipher{additionalData: additionalData}, nil
+	}
+
+	dataKey := make([]byte, 32)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return rowCipher{}, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return rowCipher{}, err
+	}
+
+	master := k.keys[k.currentId]
+	wrapped := seal(master, dataKey, []byte(k.currentId))
+
+	return rowCipher{
+		keyId:          k.currentId,
+		dataKey:        base64.StdEncoding.EncodeToString(wrapped),
+		aead:           aead,
+		additionalData: additionalData,
+	}, nil
+}
+
+// existingRow decrypts the data key of an existing row.
+func (k Keyring) existingRow(table, namespace, id, keyId, dataKey string) (rowCipher, error) {
+	additionalData := rowAdditionalData(table, namespace, id)
+	if keyId == "" {
+		return rowCipher{additionalData: additionalData}, nil
+	}
+
+	master, ok := k.keys[keyId]
+	if !ok {
+		return rowCipher{}, fmt.Errorf("unknown key id '%s'", keyId)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(dataKey)
+	if err != nil {
+		return rowCipher{}, fmt.Errorf("decode data key: %w", err)
+	}
+	unwrapped, err := open(master, wrapped, []byte(keyId))
+	if err != nil {
+		return rowCipher{}, fmt.Errorf("decrypt data key: %w", err)
+	}
+	aead, err := newAEAD(unwrapped)
+	if err != nil {
+		return rowCipher{}, err
+	}
+
+	return rowCipher{
+		keyId:          keyId,
+		dataKey:        dataKey,
+		aead:           aead,
+		additionalData: additionalData,
+	}, nil
+}
+
+func (r rowCipher) encrypt(plaintext string) string {
+	if r.aead == nil {
+		return plaintext
+	}
+	return base64.StdEncoding.EncodeToString(seal(r.aead, []byte(plaintext), r.additionalData))
+}
+
+func (r rowCipher) decrypt(value string) (string, error) {
+	if r.aead == nil {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(r.aead, sealed, r.additionalData)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,182 @@
+package keychain
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/telemetry"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	"vcassist-backend/services/keychain/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:keychain")
+	defer cleanup()
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	qry := db.New(sqlite)
+
+	// rows written before encryption was introduced
+	err = qry.CreateUsernamePassword(ctx, db.CreateUsernamePasswordParams{
+		Namespace: "vcmoodle",
+		ID:        "alice",
+		Username:  "alice_user",
+		Password:  "alice_pass",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = qry.CreateOAuth(ctx, db.CreateOAuthParams{
+		Namespace:  "powerschool",
+		ID:         "alice",
+		Token:      "alice_token",
+		RefreshUrl: "https://example.url/refresh_url",
+		ClientID:   "client_id",
+		ExpiresAt:  time.Now().Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key1 := bytes.Repeat([]byte{1}, 32)
+	key2 := bytes.Repeat([]byte{2}, 32)
+
+	keyring, err := NewKeyring("key1", map[string][]byte{"key1": key1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Rotate(ctx, sqlite, keyring)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requireEncrypted := func(keyId string) {
+		var dump strings.Builder
+		rows, err := qry.GetOAuthNotUnderKey(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range rows {
+			require.Equal(t, keyId, r.KeyID)
+			dump.WriteString(r.Token)
+		}
+		upRows, err := qry.GetUsernamePasswordNotUnderKey(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range upRows {
+			require.Equal(t, keyId, r.KeyID)
+			dump.WriteString(r.Username)
+			dump.WriteString(r.Password)
+		}
+		require.Len(t, rows, 1)
+		require.Len(t, upRows, 1)
+		require.NotContains(t, dump.String(), "alice")
+	}
+	requireReadable := func(keyring Keyring) {
+		service := NewService(ctx, sqlite, keyring)
+
+		oauthRes, err := service.GetOAuth(ctx, &connect.Request[keychainv1.GetOAuthRequest]{
+			Msg: &keychainv1.GetOAuthRequest{
+				Namespace: "powerschool",
+				Id:        "alice",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, "alice_token", oauthRes.Msg.GetKey().GetToken())
+
+		upRes, err := service.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+			Msg: &keychainv1.GetUsernamePasswordRequest{
+				Namespace: "vcmoodle",
+				Id:        "alice",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, "alice_user", upRes.Msg.GetKey().GetUsername())
+		require.Equal(t, "alice_pass", upRes.Msg.GetKey().GetPassword())
+	}
+
+	requireEncrypted("key1")
+	requireReadable(keyring)
+
+	rotated, err := NewKeyring("key2", map[string][]byte{
+		"key1": key1,
+		"key2": key2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Rotate(ctx, sqlite, rotated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requireEncrypted("key2")
+
+	// the old key should no longer be needed
+	onlyNew, err := NewKeyring("key2", map[string][]byte{"key2": key2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireReadable(onlyNew)
+
+	// ciphertext should not be readable once it is moved to another row
+	row, err := qry.GetUsernamePassword(ctx, db.GetUsernamePasswordParams{
+		Namespace: "vcmoodle",
+		ID:        "alice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = qry.CreateUsernamePassword(ctx, db.CreateUsernamePasswordParams{
+		Namespace: "vcmoodle",
+		ID:        "mallory",
+		Username:  row.Username,
+		Password:  row.Password,
+		KeyID:     row.KeyID,
+		DataKey:   row.DataKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewService(ctx, sqlite, onlyNew).GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+		Msg: &keychainv1.GetUsernamePasswordRequest{
+			Namespace: "vcmoodle",
+			Id:        "mallory",
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestNewKeyring(t *testing.T) {
+	_, err := NewKeyring("key1", map[string][]byte{"key1": []byte("too short")})
+	require.Error(t, err)
+
+	_, err = NewKeyring("missing", map[string][]byte{"key1": bytes.Repeat([]byte{1}, 32)})
+	require.Error(t, err)
+
+	keyring, err := NewKeyring("", nil)
+	require.NoError(t, err)
+	require.Equal(t, "", keyring.CurrentKeyId())
+}
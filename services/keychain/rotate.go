@@ -0,0 +1,91 @@
+package keychain
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"vcassist-backend/services/keychain/db"
+)
+
+// Rotate re-encrypts every row that is not encrypted under the current
+// key of the keyring. this is used both to migrate rows that were stored
+// in plaintext and to rotate onto a new master key.
+//
+// to rotate keys, add the new key to the keyring, make it the current key
+// and call Rotate, the old key can be removed once this returns.
+func Rotate(ctx context.Context, database *sql.DB, keyring Keyring) error {
+	ctx, span := tracer.Start(ctx, "Rotate")
+	defer span.End()
+
+	if keyring.CurrentKeyId() == "" {
+		slog.WarnContext(ctx, "keychain encryption is disabled, secrets will be stored in plaintext")
+		return nil
+	}
+
+	s := Service{
+		db:      database,
+		qry:     db.New(database),
+		keyring: keyring,
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	oauthRows, err := txqry.GetOAuthNotUnderKey(ctx, keyring.CurrentKeyId())
+	if err != nil {
+		return err
+	}
+	for _, row := range oauthRows {
+		token, err := s.readOAuthToken(row.Namespace, row.ID, row.KeyID, row.DataKey, row.Token)
+		if err != nil {
+			return fmt.Errorf("decrypt oauth (%s, %s): %w", row.Namespace, row.ID, err)
+		}
+		row.Token = token
+		err = s.writeOAuth(ctx, txqry, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	usernamePasswordRows, err := txqry.GetUsernamePasswordNotUnderKey(ctx, keyring.CurrentKeyId())
+	if err != nil {
+		return err
+	}
+	for _, row := range usernamePasswordRows {
+		username, password, err := s.readUsernamePassword(
+			row.Namespace,
+			row.ID,
+			row.KeyID,
+			row.DataKey,
+			row.Username,
+			row.Password,
+		)
+		if err != nil {
+			return fmt.Errorf("decrypt username/password (%s, %s): %w", row.Namespace, row.ID, err)
+		}
+		row.Username = username
+		row.Password = password
+		err = s.writeUsernamePassword(ctx, txqry, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(
+		ctx, "rotated keychain secrets",
+		"key_id", keyring.CurrentKeyId(),
+		"oauth", len(oauthRows),
+		"username_password", len(usernamePasswordRows),
+	)
+	return nil
+}
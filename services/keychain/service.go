@@ -22,13 +22,17 @@ import (
 	_ "modernc.org/sqlite"
 )
 
+const oauthTable = "OAuth"
+const usernamePasswordTable = "UsernamePassword"
+
 type Service struct {
-	db     *sql.DB
-	qry    *db.Queries
-	client *resty.Client
+	db      *sql.DB
+	qry     *db.Queries
+	client  *resty.Client
+	keyring Keyring
 }
 
-func NewService(ctx context.Context, database *sql.DB) keychainv1connect.KeychainServiceClient {
+func NewService(ctx context.Context, database *sql.DB, keyring Keyring) keychainv1connect.KeychainServiceClient {
 	client := resty.New()
 	client.SetHeader("user-agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Safari/537.36")
 	client.SetTimeout(time.Second * 30)
@@ -36,9 +40,10 @@ func NewService(ctx context.Context, database *sql.DB) keychainv1connect.Keychai
 	restyutil.InstrumentClient(client, nil, restyInstrumentOutput)
 
 	s := Service{
-		db:     database,
-		qry:    db.New(database),
-		client: client,
+		db:      database,
+		qry:     db.New(database),
+		client:  client,
+		keyring: keyring,
 	}
 
 	go s.refreshOAuthDaemon(ctx)
@@ -47,9 +52,83 @@ func NewService(ctx context.Context, database *sql.DB) keychainv1connect.Keychai
 	return s
 }
 
+// writeOAuth encrypts the token in the given row and then upserts it.
+func (s Service) writeOAuth(ctx context.Context, qry *db.Queries, row db.OAuth) error {
+	cipher, err := s.keyring.newRow(oauthTable, row.Namespace, row.ID)
+	if err != nil {
+		return err
+	}
+	return qry.CreateOAuth(ctx, db.CreateOAuthParams{
+		Namespace:  row.Namespace,
+		ID:         row.ID,
+		Token:      cipher.encrypt(row.Token),
+		RefreshUrl: row.RefreshUrl,
+		ClientID:   row.ClientID,
+		ExpiresAt:  row.ExpiresAt,
+		KeyID:      cipher.keyId,
+		DataKey:    cipher.dataKey,
+	})
+}
+
+// readOAuthToken decrypts the token of a row read from the db.
+func (s Service) readOAuthToken(namespace, id, keyId, dataKey, token string) (string, error) {
+	cipher, err := s.keyring.existingRow(oauthTable, namespace, id, keyId, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return cipher.decrypt(token)
+}
+
+// writeUsernamePassword encrypts the username and password then upserts them.
+func (s Service) writeUsernamePassword(ctx context.Context, qry *db.Queries, row db.UsernamePassword) error {
+	cipher, err := s.keyring.newRow(usernamePasswordTable, row.Namespace, row.ID)
+	if err != nil {
+		return err
+	}
+	return qry.CreateUsernamePassword(ctx, db.CreateUsernamePasswordParams{
+		Namespace:   row.Namespace,
+		ID:          row.ID,
+		Username:    cipher.encrypt(row.Username),
+		Password:    cipher.encrypt(row.Password),
+		KeyID:       cipher.keyId,
+		DataKey:     cipher.dataKey,
+		Validity:    row.Validity,
+		CheckedAt:   row.CheckedAt,
+		CheckReason: row.CheckReason,
+	})
+}
+
+// readUsernamePassword decrypts the username and password of a row read from the db.
+func (s Service) readUsernamePassword(namespace, id, keyId, dataKey, username, password string) (string, string, error) {
+	cipher, err := s.keyring.existingRow(usernamePasswordTable, namespace, id, keyId, dataKey)
+	if err != nil {
+		return "", "", err
+	}
+	username, err = cipher.decrypt(username)
+	if err != nil {
+		return "", "", err
+	}
+	password, err = cipher.decrypt(password)
+	if err != nil {
+		return "", "", err
+	}
+	return username, password, nil
+}
+
 func (s Service) refreshOAuthKey(ctx context.Context, originalRow db.OAuth) error {
+	token, err := s.readOAuthToken(
+		originalRow.Namespace,
+		originalRow.ID,
+		originalRow.KeyID,
+		originalRow.DataKey,
+		originalRow.Token,
+	)
+	if err != nil {
+		return err
+	}
+
 	var originalToken oauth.OpenIdToken
-	err := json.Unmarshal([]byte(originalRow.Token), &originalToken)
+	err = json.Unmarshal([]byte(token), &originalToken)
 	if err != nil {
 		return err
 	}
@@ -88,9 +167,9 @@ func (s Service) refreshOAuthKey(ctx context.Context, originalRow db.OAuth) erro
 		return err
 	}
 
-	slog.DebugContext(ctx, "refreshed oauth token", "new_token", string(newTokenJson))
+	slog.DebugContext(ctx, "refreshed oauth token", "namespace", originalRow.Namespace, "id", originalRow.ID)
 
-	err = s.qry.CreateOAuth(ctx, db.CreateOAuthParams{
+	err = s.writeOAuth(ctx, s.qry, db.OAuth{
 		ID:         originalRow.ID,
 		Namespace:  originalRow.Namespace,
 		RefreshUrl: originalRow.RefreshUrl,
@@ -169,7 +248,7 @@ func (s Service) deleteOAuthDaemon(ctx context.Context) {
 }
 
 func (s Service) SetOAuth(ctx context.Context, req *connect.Request[keychainv1.SetOAuthRequest]) (*connect.Response[keychainv1.SetOAuthResponse], error) {
-	err := s.qry.CreateOAuth(ctx, db.CreateOAuthParams{
+	err := s.writeOAuth(ctx, s.qry, db.OAuth{
 		Namespace:  req.Msg.GetNamespace(),
 		ID:         req.Msg.GetId(),
 		Token:      req.Msg.GetKey().GetToken(),
@@ -202,10 +281,21 @@ func (s Service) GetOAuth(ctx context.Context, req *connect.Request[keychainv1.G
 		return nil, err
 	}
 
+	token, err := s.readOAuthToken(
+		req.Msg.GetNamespace(),
+		req.Msg.GetId(),
+		row.KeyID,
+		row.DataKey,
+		row.Token,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &connect.Response[keychainv1.GetOAuthResponse]{
 		Msg: &keychainv1.GetOAuthResponse{
 			Key: &keychainv1.OAuthKey{
-				Token:      row.Token,
+				Token:      token,
 				RefreshUrl: row.RefreshUrl,
 				ClientId:   row.ClientID,
 				ExpiresAt:  row.ExpiresAt,
@@ -214,12 +304,26 @@ func (s Service) GetOAuth(ctx context.Context, req *connect.Request[keychainv1.G
 	}, nil
 }
 
+// checkedAt returns the time a check was made at, checks that have a result
+// but no time are assumed to have just been made.
+func checkedAt(check *keychainv1.KeyCheck) int64 {
+	if check.GetCheckedAt() == 0 && check.GetValidity() != keychainv1.KeyValidity_KEY_UNKNOWN {
+		return timezone.Now().Unix()
+	}
+	return check.GetCheckedAt()
+}
+
 func (s Service) SetUsernamePassword(ctx context.Context, req *connect.Request[keychainv1.SetUsernamePasswordRequest]) (*connect.Response[keychainv1.SetUsernamePasswordResponse], error) {
-	err := s.qry.CreateUsernamePassword(ctx, db.CreateUsernamePasswordParams{
-		Namespace: req.Msg.GetNamespace(),
-		ID:        req.Msg.GetId(),
-		Username:  req.Msg.GetKey().GetUsername(),
-		Password:  req.Msg.GetKey().GetPassword(),
+	// a new key replaces the check of the previous one
+	check := req.Msg.GetCheck()
+	err := s.writeUsernamePassword(ctx, s.qry, db.UsernamePassword{
+		Namespace:   req.Msg.GetNamespace(),
+		ID:          req.Msg.GetId(),
+		Username:    req.Msg.GetKey().GetUsername(),
+		Password:    req.Msg.GetKey().GetPassword(),
+		Validity:    int64(check.GetValidity()),
+		CheckedAt:   checkedAt(check),
+		CheckReason: check.GetReason(),
 	})
 	if err != nil {
 		return nil, err
@@ -246,12 +350,50 @@ func (s Service) GetUsernamePassword(ctx context.Context, req *connect.Request[k
 		return nil, err
 	}
 
+	username, password, err := s.readUsernamePassword(
+		req.Msg.GetNamespace(),
+		req.Msg.GetId(),
+		row.KeyID,
+		row.DataKey,
+		row.Username,
+		row.Password,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &connect.Response[keychainv1.GetUsernamePasswordResponse]{
 		Msg: &keychainv1.GetUsernamePasswordResponse{
 			Key: &keychainv1.UsernamePasswordKey{
-				Username: row.Username,
-				Password: row.Password,
+				Username: username,
+				Password: password,
+			},
+			Check: &keychainv1.KeyCheck{
+				Validity:  keychainv1.KeyValidity(row.Validity),
+				CheckedAt: row.CheckedAt,
+				Reason:    row.CheckReason,
 			},
 		},
 	}, nil
 }
+
+func (s Service) SetUsernamePasswordCheck(ctx context.Context, req *connect.Request[keychainv1.SetUsernamePasswordCheckRequest]) (*connect.Response[keychainv1.SetUsernamePasswordCheckResponse], error) {
+	check := req.Msg.GetCheck()
+	updated, err := s.qry.SetUsernamePasswordCheck(ctx, db.SetUsernamePasswordCheckParams{
+		Validity:    int64(check.GetValidity()),
+		CheckedAt:   checkedAt(check),
+		CheckReason: check.GetReason(),
+		Namespace:   req.Msg.GetNamespace(),
+		ID:          req.Msg.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("username and password not found"))
+	}
+
+	return &connect.Response[keychainv1.SetUsernamePasswordCheckResponse]{
+		Msg: &keychainv1.SetUsernamePasswordCheckResponse{},
+	}, nil
+}
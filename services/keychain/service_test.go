@@ -29,7 +29,7 @@ func TestService(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 
-	service := NewService(ctx, sqlite)
+	service := NewService(ctx, sqlite, Keyring{})
 
 	{
 		res, err := service.GetOAuth(ctx, &connect.Request[keychainv1.GetOAuthRequest]{
@@ -143,4 +143,59 @@ func TestService(t *testing.T) {
 		require.Equal(t, "bob_user", res.Msg.GetKey().GetUsername())
 		require.Equal(t, "bob_pass", res.Msg.GetKey().GetPassword())
 	}
+	{
+		// keys that have never been used to login are unknown
+		res, err := service.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+			Msg: &keychainv1.GetUsernamePasswordRequest{
+				Namespace: "powerschool",
+				Id:        "bob",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, keychainv1.KeyValidity_KEY_UNKNOWN, res.Msg.GetCheck().GetValidity())
+		require.Zero(t, res.Msg.GetCheck().GetCheckedAt())
+	}
+	{
+		_, err := service.SetUsernamePasswordCheck(ctx, &connect.Request[keychainv1.SetUsernamePasswordCheckRequest]{
+			Msg: &keychainv1.SetUsernamePasswordCheckRequest{
+				Namespace: "powerschool",
+				Id:        "bob",
+				Check: &keychainv1.KeyCheck{
+					Validity: keychainv1.KeyValidity_KEY_INVALID,
+					Reason:   "wrong password",
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := service.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+			Msg: &keychainv1.GetUsernamePasswordRequest{
+				Namespace: "powerschool",
+				Id:        "bob",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, keychainv1.KeyValidity_KEY_INVALID, res.Msg.GetCheck().GetValidity())
+		require.Equal(t, "wrong password", res.Msg.GetCheck().GetReason())
+		require.NotZero(t, res.Msg.GetCheck().GetCheckedAt())
+		require.Equal(t, "bob_pass", res.Msg.GetKey().GetPassword())
+	}
+	{
+		_, err := service.SetUsernamePasswordCheck(ctx, &connect.Request[keychainv1.SetUsernamePasswordCheckRequest]{
+			Msg: &keychainv1.SetUsernamePasswordCheckRequest{
+				Namespace: "powerschool",
+				Id:        "unknown-id",
+				Check: &keychainv1.KeyCheck{
+					Validity: keychainv1.KeyValidity_KEY_VALID,
+				},
+			},
+		})
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	}
 }
@@ -1,9 +1,14 @@
 package keychain
 
-import "vcassist-backend/lib/restyutil"
+import (
+	"vcassist-backend/lib/restyutil"
+	"vcassist-backend/lib/telemetry"
+)
 
 var restyInstrumentOutput restyutil.InstrumentOutput
 
 func SetRestyInstrumentOutput(out restyutil.InstrumentOutput) {
 	restyInstrumentOutput = out
 }
+
+var tracer = telemetry.Tracer("vcassist.services.keychain")
@@ -2,6 +2,9 @@
 select * from ExplicitLink
 where (leftSet = ?1 and rightSet = ?2);
 
+-- name: GetAllExplicitLinks :many
+select * from ExplicitLink;
+
 -- name: CreateExplicitLink :exec
 insert into ExplicitLink(leftSet, leftKey, rightSet, rightKey) values (?, ?, ?, ?)
 on conflict do nothing;
@@ -116,6 +116,38 @@ func (q *Queries) DeleteKnownSets(ctx context.Context, sets []string) error {
 	return err
 }
 
+const getAllExplicitLinks = `-- name: GetAllExplicitLinks :many
+select leftset, leftkey, rightset, rightkey from ExplicitLink
+`
+
+func (q *Queries) GetAllExplicitLinks(ctx context.Context) ([]ExplicitLink, error) {
+	rows, err := q.db.QueryContext(ctx, getAllExplicitLinks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExplicitLink
+	for rows.Next() {
+		var i ExplicitLink
+		if err := rows.Scan(
+			&i.Leftset,
+			&i.Leftkey,
+			&i.Rightset,
+			&i.Rightkey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getExplicitLinks = `-- name: GetExplicitLinks :many
 select leftset, leftkey, rightset, rightkey from ExplicitLink
 where (leftSet = ?1 and rightSet = ?2)
@@ -0,0 +1,100 @@
+package linker
+
+import "math"
+
+// maximumAssignment solves the assignment problem with the Hungarian
+// algorithm, it returns the column assigned to each row (or -1 if the row
+// was left unassigned because there are more rows than columns) such that
+// the sum of the assigned weights is maximized.
+func maximumAssignment(weights [][]float64) []int {
+	rows := len(weights)
+	if rows == 0 {
+		return nil
+	}
+	cols := len(weights[0])
+
+	// the algorithm below requires rows <= cols
+	transposed := rows > cols
+	n, m := rows, cols
+	if transposed {
+		n, m = cols, rows
+	}
+	// cost is 1-indexed and minimized
+	cost := func(i, j int) float64 {
+		if transposed {
+			return -weights[j-1][i-1]
+		}
+		return -weights[i-1][j-1]
+	}
+
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	// p[j] is the row assigned to column j
+	p := make([]int, m+1)
+	way := make([]int, m+1)
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, m+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		used := make([]bool, m+1)
+
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				cur := cost(i0, j) - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	result := make([]int, rows)
+	for i := range result {
+		result[i] = -1
+	}
+	for j := 1; j <= m; j++ {
+		if p[j] == 0 {
+			continue
+		}
+		if transposed {
+			result[j-1] = p[j] - 1
+		} else {
+			result[p[j]-1] = j - 1
+		}
+	}
+	return result
+}
@@ -1 +1,324 @@
 package linker
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// abbreviations commonly found in course names, these are expanded before
+// names are compared so that "AP Calc BC" and "Advanced Placement Calculus
+// BC" are considered the same.
+var defaultAbbreviations = map[string][]string{
+	"ap":      {"advanced", "placement"},
+	"adv":     {"advanced"},
+	"h":       {"honors"},
+	"hon":     {"honors"},
+	"calc":    {"calculus"},
+	"precalc": {"pre", "calculus"},
+	"trig":    {"trigonometry"},
+	"sci":     {"science"},
+	"prog":    {"programming"},
+	"intro":   {"introduction"},
+	"lang":    {"language"},
+	"lit":     {"literature"},
+	"gov":     {"government"},
+	"govt":    {"government"},
+	"hist":    {"history"},
+	"env":     {"environmental"},
+	"enviro":  {"environmental"},
+	"pe":      {"physical", "education"},
+	"stat":    {"statistics"},
+	"stats":   {"statistics"},
+	"bio":     {"biology"},
+	"chem":    {"chemistry"},
+	"phys":    {"physics"},
+	"econ":    {"economics"},
+	"asl":     {"american", "sign", "language"},
+	"cs":      {"computer", "science"},
+	"jh":      {"junior", "high"},
+	"b":       {"boys"},
+	"g":       {"girls"},
+	"i":       {"1"},
+	"ii":      {"2"},
+	"iii":     {"3"},
+	"iv":      {"4"},
+	"v":       {"5"},
+}
+
+// words that don't help tell names apart
+var stopwords = map[string]struct{}{
+	"and": {},
+	"of":  {},
+	"the": {},
+	"to":  {},
+	"in":  {},
+	"for": {},
+}
+
+// how much token similarity contributes to the similarity of two names,
+// the rest is made up by character trigram similarity
+const tokenWeight = 0.6
+
+type ImplicitLink struct {
+	Left        string
+	Right       string
+	Correlation float64
+}
+
+type LabeledPair struct {
+	Left  string
+	Right string
+}
+
+// Engine scores how similar two keys are, it is trained with the explicit
+// links that have been made between keys.
+type Engine struct {
+	// expansions learned from labeled pairs, these are applied after the
+	// default abbreviations
+	learned map[string][]string
+}
+
+func NewEngine() Engine {
+	return Engine{learned: make(map[string][]string)}
+}
+
+func rawTokens(name string) []string {
+	name = strings.ToLower(name)
+	name = strings.ReplaceAll(name, "&", " and ")
+	fields := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return fields
+}
+
+func expand(tokens []string, expansions map[string][]string) []string {
+	out := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		replacement, ok := expansions[t]
+		if ok {
+			out = append(out, replacement...)
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// the most times learned expansions are applied to a key, an expansion
+// can contain tokens that were themselves learned as abbreviations
+const maxLearnedDepth = 4
+
+func removeStopwords(tokens []string) []string {
+	out := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if _, ok := stopwords[t]; ok {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// Tokens returns the normalized tokens of a key with all abbreviations
+// expanded.
+func (e Engine) Tokens(name string) []string {
+	tokens := expand(rawTokens(name), defaultAbbreviations)
+	for range maxLearnedDepth {
+		expanded := expand(tokens, e.learned)
+		if slices.Equal(expanded, tokens) {
+			break
+		}
+		tokens = expanded
+	}
+	return removeStopwords(tokens)
+}
+
+// unmatched returns the tokens in a that aren't in b, counting duplicates.
+func unmatched(a, b []string) []string {
+	counts := make(map[string]int)
+	for _, t := range b {
+		counts[t]++
+	}
+	var out []string
+	for _, t := range a {
+		if counts[t] > 0 {
+			counts[t]--
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func isNumber(token string) bool {
+	for _, r := range token {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return token != ""
+}
+
+// abbreviates returns true if short is made up of a prefix of each of the
+// long tokens in order, ex. "apush" of "advanced placement us history".
+func abbreviates(short string, long []string) bool {
+	if len(long) == 0 {
+		return short == ""
+	}
+	word := long[0]
+	for n := 1; n <= len(short) && n <= len(word); n++ {
+		if short[n-1] != word[n-1] {
+			return false
+		}
+		if abbreviates(short[n:], long[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// a rewrite that isn't an abbreviation is only learned once this many
+// labeled pairs agree on it, a single pair of unrelated words is more
+// likely to be a difference between two names than a synonym
+const minRuleOccurrences = 2
+
+// rule finds the token that one key of a pair uses in place of one or more
+// tokens of the other key.
+func (e Engine) rule(pair LabeledPair) (short string, long []string, ok bool) {
+	left := e.Tokens(pair.Left)
+	right := e.Tokens(pair.Right)
+	onlyLeft := unmatched(left, right)
+	onlyRight := unmatched(right, left)
+
+	switch {
+	case len(onlyLeft) == 1 && len(onlyRight) == 1:
+		short, long = onlyLeft[0], onlyRight
+		if len(long[0]) < len(short) || (len(long[0]) == len(short) && long[0] < short) {
+			short, long = long[0], onlyLeft
+		}
+	case len(onlyLeft) == 1 && len(onlyRight) > 1:
+		short, long = onlyLeft[0], onlyRight
+	case len(onlyRight) == 1 && len(onlyLeft) > 1:
+		short, long = onlyRight[0], onlyLeft
+	default:
+		return "", nil, false
+	}
+
+	// numbers tell courses apart ("english 10" and "english 11"), they
+	// are never abbreviations of each other
+	if isNumber(short) || slices.ContainsFunc(long, isNumber) {
+		return "", nil, false
+	}
+	return short, long, true
+}
+
+// Learn updates the engine with pairs of keys that are known to refer to
+// the same thing.
+//
+// when the only difference between the two keys of a pair is a single
+// token on one side and one or more tokens on the other, the single token
+// is learned as an abbreviation of the other tokens if it is made up of
+// their prefixes, ex. ("APUSH", "AP US History") teaches the engine that
+// "apush" means "advanced placement us history". other rewrites are only
+// learned when more than one pair agrees on them.
+func (e Engine) Learn(pairs []LabeledPair) {
+	occurrences := make(map[string]int)
+	for _, p := range pairs {
+		short, long, ok := e.rule(p)
+		if !ok {
+			continue
+		}
+		if abbreviates(short, long) {
+			e.learned[short] = long
+			continue
+		}
+
+		key := short + "=" + strings.Join(long, " ")
+		occurrences[key]++
+		if occurrences[key] >= minRuleOccurrences {
+			e.learned[short] = long
+		}
+	}
+}
+
+// dice is the Sørensen–Dice coefficient of two token multisets.
+func dice(a, b []string) float64 {
+	if len(a)+len(b) == 0 {
+		return 0
+	}
+	common := len(a) - len(unmatched(a, b))
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+func trigrams(text string) map[string]struct{} {
+	padded := []rune("  " + text + " ")
+	out := make(map[string]struct{})
+	for i := 0; i+3 <= len(padded); i++ {
+		out[string(padded[i:i+3])] = struct{}{}
+	}
+	return out
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a)+len(b) == 0 {
+		return 0
+	}
+	intersection := 0
+	for k := range a {
+		if _, ok := b[k]; ok {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+// Similarity returns a score from 0-1 of how likely it is that two keys
+// refer to the same thing.
+func (e Engine) Similarity(left, right string) float64 {
+	if left == right {
+		return 1
+	}
+	lt := e.Tokens(left)
+	rt := e.Tokens(right)
+	if len(lt) == 0 || len(rt) == 0 {
+		return 0
+	}
+
+	tokenSim := dice(lt, rt)
+	gramSim := jaccard(
+		trigrams(strings.Join(lt, " ")),
+		trigrams(strings.Join(rt, " ")),
+	)
+	return tokenWeight*tokenSim + (1-tokenWeight)*gramSim
+}
+
+// Match finds the one-to-one links between the left and right keys which
+// maximize the total similarity, links with a similarity below threshold
+// are dropped.
+func (e Engine) Match(leftList, rightList []string, threshold float64) []ImplicitLink {
+	if len(leftList) == 0 || len(rightList) == 0 {
+		return nil
+	}
+
+	similarity := make([][]float64, len(leftList))
+	for i, left := range leftList {
+		similarity[i] = make([]float64, len(rightList))
+		for j, right := range rightList {
+			similarity[i][j] = e.Similarity(left, right)
+		}
+	}
+
+	var result []ImplicitLink
+	for i, j := range maximumAssignment(similarity) {
+		if j < 0 || similarity[i][j] < threshold {
+			continue
+		}
+		result = append(result, ImplicitLink{
+			Left:        leftList[i],
+			Right:       rightList[j],
+			Correlation: similarity[i][j],
+		})
+	}
+	return result
+}
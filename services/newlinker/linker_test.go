@@ -0,0 +1,149 @@
+package linker
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokens(t *testing.T) {
+	engine := NewEngine()
+	require.Equal(
+		t,
+		[]string{"advanced", "placement", "calculus", "bc"},
+		engine.Tokens("AP Calc BC"),
+	)
+	require.Equal(
+		t,
+		[]string{"trigonometry", "pre", "calculus", "bc", "honors"},
+		engine.Tokens("Trig/Pre-Calculus BC (H)"),
+	)
+	require.Equal(
+		t,
+		[]string{"personal", "finance", "stewardship"},
+		engine.Tokens("Personal Finance & Stewardship"),
+	)
+}
+
+func TestSimilarity(t *testing.T) {
+	engine := NewEngine()
+	require.Equal(t, float64(1), engine.Similarity("AP Calc BC", "Advanced Placement Calculus BC"))
+	require.Equal(t, float64(1), engine.Similarity("Algebra II", "Algebra 2"))
+	require.Greater(
+		t,
+		engine.Similarity("AP Calculus BC", "AP Calc BC"),
+		engine.Similarity("AP Calculus BC", "AP Calc AB"),
+	)
+	require.Less(t, engine.Similarity("Chapel", "AP Chemistry"), 0.5)
+	require.Equal(t, float64(0), engine.Similarity("", "Biology"))
+}
+
+func TestLearn(t *testing.T) {
+	engine := NewEngine()
+	before := engine.Similarity("APUSH", "AP US History")
+
+	engine.Learn([]LabeledPair{
+		{Left: "APUSH", Right: "AP US History"},
+		{Left: "Christian Practice & Belief", Right: "Christian Doctrine and Practice"},
+	})
+	require.Equal(t, float64(1), engine.Similarity("APUSH", "AP US History"))
+	require.Greater(t, engine.Similarity("APUSH", "AP US History"), before)
+	// a single pair of unrelated words isn't enough to learn from
+	require.Less(t, engine.Similarity("Belief Studies", "Doctrine Studies"), float64(1))
+
+	engine.Learn([]LabeledPair{
+		{Left: "Christian Practice & Belief", Right: "Christian Doctrine and Practice"},
+		{Left: "Belief & Culture", Right: "Doctrine & Culture"},
+	})
+	require.Equal(t, float64(1), engine.Similarity("Belief Studies", "Doctrine Studies"))
+}
+
+func TestLearnUnrelated(t *testing.T) {
+	engine := NewEngine()
+	before := engine.Similarity("Spanish II", "Spanish 2 Honors")
+
+	engine.Learn([]LabeledPair{
+		{Left: "English 10", Right: "English II"},
+		{Left: "English 10", Right: "English II"},
+		{Left: "Bible Survey", Right: "Old Testament"},
+	})
+
+	// numbers are never rewritten to other numbers
+	require.Equal(t, before, engine.Similarity("Spanish II", "Spanish 2 Honors"))
+	require.Equal(t, float64(1), engine.Similarity("Algebra II", "Algebra 2"))
+	links := engine.Match(
+		[]string{"Spanish II", "Biology"},
+		[]string{"Spanish 2 Honors", "Bible Survey"},
+		0.7,
+	)
+	require.Len(t, links, 1)
+	require.Equal(t, "Spanish II", links[0].Left)
+	require.Equal(t, "Spanish 2 Honors", links[0].Right)
+
+	// learned expansions are applied after the default ones and to each
+	// other
+	engine.Learn([]LabeledPair{
+		{Left: "AP Calc Sem", Right: "APCS"},
+		{Left: "Calc Sem", Right: "Calculus Seminar"},
+	})
+	require.Equal(t, []string{"seminar", "calculus"}, engine.Tokens("Sem Calc"))
+	require.Equal(t, float64(1), engine.Similarity("APCS", "Advanced Placement Calculus Seminar"))
+}
+
+func TestMaximumAssignment(t *testing.T) {
+	// a greedy assignment would take the 0.9 and be left with 0.1
+	weights := [][]float64{
+		{0.9, 0.8},
+		{0.85, 0.1},
+	}
+	require.Equal(t, []int{1, 0}, maximumAssignment(weights))
+
+	// more rows than columns
+	require.Equal(t, []int{-1, 0, -1}, maximumAssignment([][]float64{
+		{0.1},
+		{0.9},
+		{0.5},
+	}))
+	// more columns than rows
+	require.Equal(t, []int{2}, maximumAssignment([][]float64{
+		{0.1, 0.2, 0.3},
+	}))
+	require.Nil(t, maximumAssignment(nil))
+}
+
+func TestMatch(t *testing.T) {
+	engine := NewEngine()
+	links := engine.Match(
+		[]string{
+			"AP Calculus AB",
+			"AP Calculus BC",
+			"Trig/Pre-Calculus BC (H)",
+			"Algebra II (H)",
+			"Yearbook",
+		},
+		[]string{
+			"Advanced Placement Calc BC",
+			"AP Calc AB",
+			"Trigonometry/Precalc BC Honors",
+			"Algebra 2 Honors",
+			"Chapel",
+		},
+		0.7,
+	)
+
+	diff := cmp.Diff(
+		[]ImplicitLink{
+			{Left: "AP Calculus AB", Right: "AP Calc AB"},
+			{Left: "AP Calculus BC", Right: "Advanced Placement Calc BC"},
+			{Left: "Trig/Pre-Calculus BC (H)", Right: "Trigonometry/Precalc BC Honors"},
+			{Left: "Algebra II (H)", Right: "Algebra 2 Honors"},
+		},
+		links,
+		cmpopts.IgnoreFields(ImplicitLink{}, "Correlation"),
+	)
+	if diff != "" {
+		t.Fatal(diff)
+	}
+}
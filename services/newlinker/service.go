@@ -0,0 +1,220 @@
+package linker
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"vcassist-backend/lib/telemetry"
+	linkerv1 "vcassist-backend/proto/vcassist/services/linker/v1"
+	legacy "vcassist-backend/services/linker"
+	"vcassist-backend/services/linker/db"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	_ "modernc.org/sqlite"
+)
+
+var tracer = telemetry.Tracer("vcassist.services.newlinker")
+
+// keys that are not exact matches or explicitly linked are only linked
+// implicitly when they are at least this similar
+const DefaultImplicitThreshold = 0.7
+
+// Service implements the same LinkerService API as services/linker on top
+// of the same database, only Link and SuggestLinks behave differently.
+type Service struct {
+	legacy.Service
+	db                *sql.DB
+	qry               *db.Queries
+	implicitThreshold float64
+}
+
+type ServiceOptions struct {
+	Database *sql.DB
+	// defaults to DefaultImplicitThreshold if 0
+	ImplicitThreshold float64
+}
+
+func NewService(opts ServiceOptions) Service {
+	threshold := opts.ImplicitThreshold
+	if threshold == 0 {
+		threshold = DefaultImplicitThreshold
+	}
+	return Service{
+		Service:           legacy.NewService(opts.Database),
+		db:                opts.Database,
+		qry:               db.New(opts.Database),
+		implicitThreshold: threshold,
+	}
+}
+
+// trainedEngine creates an engine that has learned from every explicit link
+// in the database.
+func (s Service) trainedEngine(ctx context.Context) (Engine, error) {
+	ctx, span := tracer.Start(ctx, "trainedEngine")
+	defer span.End()
+
+	links, err := s.qry.GetAllExplicitLinks(ctx)
+	if err != nil {
+		return Engine{}, err
+	}
+	pairs := make([]LabeledPair, len(links))
+	for i, l := range links {
+		pairs[i] = LabeledPair{Left: l.Leftkey, Right: l.Rightkey}
+	}
+
+	engine := NewEngine()
+	engine.Learn(pairs)
+	return engine, nil
+}
+
+// link resolves the keys of the left set to keys in the right set in order
+// of priority: explicit links, exact matches and then implicit links.
+func (s Service) link(ctx context.Context, left string, leftKeys []string, right string, rightKeys []string, threshold float64) (map[string]string, []ImplicitLink, error) {
+	explicit, err := s.qry.GetExplicitLinks(ctx, db.GetExplicitLinksParams{
+		Leftset:  left,
+		Rightset: right,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leftSet := make(map[string]struct{}, len(leftKeys))
+	for _, k := range leftKeys {
+		leftSet[k] = struct{}{}
+	}
+	rightSet := make(map[string]struct{}, len(rightKeys))
+	for _, k := range rightKeys {
+		rightSet[k] = struct{}{}
+	}
+
+	mapping := make(map[string]string)
+	usedRight := make(map[string]struct{})
+	for _, l := range explicit {
+		if _, ok := leftSet[l.Leftkey]; !ok {
+			continue
+		}
+		mapping[l.Leftkey] = l.Rightkey
+		usedRight[l.Rightkey] = struct{}{}
+	}
+	for _, k := range leftKeys {
+		if _, ok := mapping[k]; ok {
+			continue
+		}
+		if _, ok := rightSet[k]; !ok {
+			continue
+		}
+		if _, ok := usedRight[k]; ok {
+			continue
+		}
+		mapping[k] = k
+		usedRight[k] = struct{}{}
+	}
+
+	var remainingLeft []string
+	for _, k := range leftKeys {
+		if _, ok := mapping[k]; !ok {
+			remainingLeft = append(remainingLeft, k)
+		}
+	}
+	var remainingRight []string
+	for _, k := range rightKeys {
+		if _, ok := usedRight[k]; !ok {
+			remainingRight = append(remainingRight, k)
+		}
+	}
+
+	engine, err := s.trainedEngine(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	implicit := engine.Match(remainingLeft, remainingRight, threshold)
+	for _, l := range implicit {
+		mapping[l.Left] = l.Right
+	}
+
+	return mapping, implicit, nil
+}
+
+func (s Service) Link(ctx context.Context, req *connect.Request[linkerv1.LinkRequest]) (*connect.Response[linkerv1.LinkResponse], error) {
+	// this records the known sets and keys, the mapping it returns only
+	// has exact matches and explicit links so it is discarded
+	_, err := s.Service.Link(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, implicit, err := s.link(
+		ctx,
+		req.Msg.GetSrc().GetName(),
+		req.Msg.GetSrc().GetKeys(),
+		req.Msg.GetDst().GetName(),
+		req.Msg.GetDst().GetKeys(),
+		s.implicitThreshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range implicit {
+		slog.DebugContext(ctx, "implicit link", "left", l.Left, "right", l.Right, "correlation", l.Correlation)
+	}
+
+	return &connect.Response[linkerv1.LinkResponse]{
+		Msg: &linkerv1.LinkResponse{
+			SrcToDst: mapping,
+		},
+	}, nil
+}
+
+func (s Service) SuggestLinks(ctx context.Context, req *connect.Request[linkerv1.SuggestLinksRequest]) (*connect.Response[linkerv1.SuggestLinksResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	left := req.Msg.GetSetLeft()
+	right := req.Msg.GetSetRight()
+
+	leftRows, err := s.qry.GetKnownKeys(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+	rightRows, err := s.qry.GetKnownKeys(ctx, right)
+	if err != nil {
+		return nil, err
+	}
+	leftKeys := make([]string, len(leftRows))
+	for i, r := range leftRows {
+		leftKeys[i] = r.Value
+	}
+	rightKeys := make([]string, len(rightRows))
+	for i, r := range rightRows {
+		rightKeys[i] = r.Value
+	}
+
+	span.AddEvent("left keys", trace.WithAttributes(
+		attribute.StringSlice("keys", leftKeys),
+	))
+	span.AddEvent("right keys", trace.WithAttributes(
+		attribute.StringSlice("keys", rightKeys),
+	))
+
+	_, implicit, err := s.link(ctx, left, leftKeys, right, rightKeys, float64(req.Msg.GetThreshold()))
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := []*linkerv1.LinkSuggestion{}
+	for _, impl := range implicit {
+		suggestions = append(suggestions, &linkerv1.LinkSuggestion{
+			LeftKey:     impl.Left,
+			RightKey:    impl.Right,
+			Correlation: float32(impl.Correlation),
+		})
+	}
+
+	return &connect.Response[linkerv1.SuggestLinksResponse]{
+		Msg: &linkerv1.SuggestLinksResponse{
+			Suggestions: suggestions,
+		},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package linker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"vcassist-backend/lib/telemetry"
+	linkerv1 "vcassist-backend/proto/vcassist/services/linker/v1"
+	"vcassist-backend/services/linker/db"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:newlinker")
+	defer cleanup()
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := NewService(ServiceOptions{Database: sqlite})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, err = service.AddExplicitLink(ctx, &connect.Request[linkerv1.AddExplicitLinkRequest]{
+		Msg: &linkerv1.AddExplicitLinkRequest{
+			Left:  &linkerv1.ExplicitKey{Set: "weights", Key: "Christian Practice & Belief"},
+			Right: &linkerv1.ExplicitKey{Set: "powerschool", Key: "Christian Doctrine and Practice"},
+		},
+	})
+	require.NoError(t, err)
+	// this teaches the engine that "apush" is "ap us history"
+	_, err = service.AddExplicitLink(ctx, &connect.Request[linkerv1.AddExplicitLinkRequest]{
+		Msg: &linkerv1.AddExplicitLinkRequest{
+			Left:  &linkerv1.ExplicitKey{Set: "moodle", Key: "APUSH"},
+			Right: &linkerv1.ExplicitKey{Set: "powerschool", Key: "AP US History"},
+		},
+	})
+	require.NoError(t, err)
+
+	linkRes, err := service.Link(ctx, &connect.Request[linkerv1.LinkRequest]{
+		Msg: &linkerv1.LinkRequest{
+			Src: &linkerv1.Set{
+				Name: "weights",
+				Keys: []string{
+					"Christian Practice & Belief",
+					"Biology",
+					"AP Calculus BC",
+					"APUSH",
+					"Yearbook",
+				},
+			},
+			Dst: &linkerv1.Set{
+				Name: "powerschool",
+				Keys: []string{
+					"Christian Doctrine and Practice",
+					"Biology",
+					"Advanced Placement Calc BC",
+					"AP US History",
+					"Chapel",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	diff := cmp.Diff(
+		map[string]string{
+			"Christian Practice & Belief": "Christian Doctrine and Practice",
+			"Biology":                     "Biology",
+			"AP Calculus BC":              "Advanced Placement Calc BC",
+			"APUSH":                       "AP US History",
+		},
+		linkRes.Msg.GetSrcToDst(),
+	)
+	if diff != "" {
+		t.Fatal(diff)
+	}
+
+	suggestRes, err := service.SuggestLinks(ctx, &connect.Request[linkerv1.SuggestLinksRequest]{
+		Msg: &linkerv1.SuggestLinksRequest{
+			SetLeft:   "weights",
+			SetRight:  "powerschool",
+			Threshold: 0.9,
+		},
+	})
+	require.NoError(t, err)
+
+	diff = cmp.Diff(
+		[]*linkerv1.LinkSuggestion{
+			{LeftKey: "AP Calculus BC", RightKey: "Advanced Placement Calc BC"},
+			{LeftKey: "APUSH", RightKey: "AP US History"},
+		},
+		suggestRes.Msg.GetSuggestions(),
+		cmpopts.IgnoreUnexported(linkerv1.LinkSuggestion{}),
+		cmpopts.IgnoreFields(linkerv1.LinkSuggestion{}, "Correlation"),
+	)
+	if diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,45 @@
+-- name: CreateDevice :execrows
+-- an endpoint registered by another user is left alone, no rows are
+-- affected in that case
+insert into Device(endpoint, email, p256dh, auth, created_at)
+values (?, ?, ?, ?, ?)
+on conflict (endpoint) do update set
+    p256dh = excluded.p256dh,
+    auth = excluded.auth
+where Device.email = excluded.email;
+
+-- name: DeleteDevice :exec
+delete from Device where email = ? and endpoint = ?;
+
+-- name: DeleteDeviceByEndpoint :exec
+delete from Device where endpoint = ?;
+
+-- name: GetDevices :many
+select * from Device where email = ?
+order by created_at asc;
+
+-- name: GetPreferences :one
+select * from Preferences where email = ?;
+
+-- name: SetPreferences :exec
+insert or replace into Preferences(
+    email,
+    push_enabled,
+    email_digest,
+    new_assignments,
+    scores_posted,
+    missing_assignments,
+    late_assignments,
+    grade_drop_threshold
+) values (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateDigestItem :exec
+insert into DigestItem(email, time, title, body) values (?, ?, ?, ?);
+
+-- name: GetDigestItems :many
+select * from DigestItem
+order by email asc, time asc;
+
+-- name: DeleteDigestItems :exec
+delete from DigestItem
+where email = sqlc.arg(email) and id <= sqlc.arg(max_id);
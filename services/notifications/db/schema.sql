@@ -0,0 +1,29 @@
+create table Device (
+    endpoint text not null primary key,
+    email text not null,
+    p256dh text not null,
+    auth text not null,
+    created_at integer not null
+);
+
+create index Device_email on Device(email);
+
+create table Preferences (
+    email text not null primary key,
+    push_enabled boolean not null,
+    email_digest boolean not null,
+    new_assignments boolean not null,
+    scores_posted boolean not null,
+    missing_assignments boolean not null,
+    late_assignments boolean not null,
+    grade_drop_threshold real not null
+);
+
+-- notifications waiting to be sent in the next email digest
+create table DigestItem (
+    id integer primary key autoincrement,
+    email text not null,
+    time integer not null,
+    title text not null,
+    body text not null
+);
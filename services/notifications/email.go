@@ -0,0 +1,63 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"vcassist-backend/lib/mail"
+	"vcassist-backend/lib/timezone"
+
+	"go.opentelemetry.io/otel/codes"
+)
+
+// EmailSender sends all the notifications it is given in a single email,
+// which is how the daily digest is delivered.
+type EmailSender struct {
+	mailer mail.Mailer
+}
+
+func NewEmailSender(mailer mail.Mailer) EmailSender {
+	return EmailSender{mailer: mailer}
+}
+
+func formatDigest(notifications []Notification) string {
+	var b strings.Builder
+	b.WriteString("Here is what changed in your classes today.\n")
+	for _, n := range notifications {
+		b.WriteString("\n")
+		b.WriteString(n.Time.In(timezone.Location).Format("Jan 2 3:04 PM"))
+		b.WriteString(" - ")
+		b.WriteString(n.Title)
+		b.WriteString("\n")
+		b.WriteString(n.Body)
+		b.WriteString("\n")
+	}
+	b.WriteString("\nYou can turn off these emails in the notification settings of VC Assist.")
+	return b.String()
+}
+
+func (s EmailSender) Send(ctx context.Context, recipient Recipient, notifications []Notification) error {
+	ctx, span := tracer.Start(ctx, "EmailSender.Send")
+	defer span.End()
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	subject := "Daily Digest"
+	if len(notifications) == 1 {
+		subject = fmt.Sprintf("Daily Digest: %s", notifications[0].Title)
+	}
+
+	err := s.mailer.Send(ctx, mail.Message{
+		To:      []string{recipient.Email},
+		Subject: subject,
+		Text:    formatDigest(notifications),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to send email")
+		return err
+	}
+	return nil
+}
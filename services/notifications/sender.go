@@ -0,0 +1,88 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"vcassist-backend/services/notifications/db"
+)
+
+type Notification struct {
+	Title string    `json:"title"`
+	Body  string    `json:"body"`
+	Time  time.Time `json:"time"`
+}
+
+type Recipient struct {
+	Email   string
+	Devices []db.Device
+}
+
+// Sender delivers notifications to a user, implementations are free to
+// send each notification individually or all of them at once.
+type Sender interface {
+	Send(ctx context.Context, recipient Recipient, notifications []Notification) error
+}
+
+// ExpiredDevicesError is returned by a Sender when some of the devices of
+// a recipient are no longer valid, the service will remove them.
+type ExpiredDevicesError struct {
+	Endpoints []string
+}
+
+func (e ExpiredDevicesError) Error() string {
+	return fmt.Sprintf("expired devices: %s", strings.Join(e.Endpoints, ", "))
+}
+
+// LogSender appends every notification as a line of json to a file, it is
+// meant for local development and testing.
+type LogSender struct {
+	path string
+	lock *sync.Mutex
+}
+
+func NewLogSender(path string) LogSender {
+	return LogSender{
+		path: path,
+		lock: &sync.Mutex{},
+	}
+}
+
+type logEntry struct {
+	Email        string       `json:"email"`
+	Devices      []string     `json:"devices,omitempty"`
+	Notification Notification `json:"notification"`
+}
+
+func (s LogSender) Send(ctx context.Context, recipient Recipient, notifications []Notification) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	devices := make([]string, len(recipient.Devices))
+	for i, d := range recipient.Devices {
+		devices[i] = d.Endpoint
+	}
+
+	encoder := json.NewEncoder(f)
+	for _, n := range notifications {
+		err = encoder.Encode(logEntry{
+			Email:        recipient.Email,
+			Devices:      devices,
+			Notification: n,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"vcassist-backend/lib/telemetry"
+	"vcassist-backend/lib/timezone"
+	notificationsv1 "vcassist-backend/proto/vcassist/services/notifications/v1"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/notifications/db"
+
+	"connectrpc.com/connect"
+
+	_ "modernc.org/sqlite"
+)
+
+var tracer = telemetry.Tracer("vcassist.services.notifications")
+
+// the preferences of users who have never changed them
+var defaultPreferences = &notificationsv1.Preferences{
+	PushEnabled:        true,
+	EmailDigest:        false,
+	NewAssignments:     true,
+	ScoresPosted:       true,
+	MissingAssignments: true,
+	LateAssignments:    true,
+	GradeDropThreshold: 2,
+}
+
+type Service struct {
+	db             *sql.DB
+	qry            *db.Queries
+	pushSender     Sender
+	digest         Sender
+	vapidPublicKey string
+}
+
+type ServiceOptions struct {
+	Database *sql.DB
+	// sends notifications to the registered devices of a user as they
+	// happen, push notifications are disabled if this is nil
+	Push Sender
+	// sends the notifications of the day in a single message, email
+	// digests are disabled if this is nil
+	Digest Sender
+	// returned by GetPushConfig, this should be the public key of the
+	// WebPushSender if one is used
+	VapidPublicKey string
+}
+
+// NewService creates the notifications service and starts the daemon that
+// sends the daily digests.
+func NewService(ctx context.Context, opts ServiceOptions) Service {
+	if opts.Database == nil {
+		panic("nil database")
+	}
+
+	s := Service{
+		db:             opts.Database,
+		qry:            db.New(opts.Database),
+		pushSender:     opts.Push,
+		digest:         opts.Digest,
+		vapidPublicKey: opts.VapidPublicKey,
+	}
+	if s.digest != nil {
+		go s.sendDigestsDaemon(ctx)
+	}
+	return s
+}
+
+func (s Service) getPreferences(ctx context.Context, email string) (*notificationsv1.Preferences, error) {
+	row, err := s.qry.GetPreferences(ctx, email)
+	if err == sql.ErrNoRows {
+		return defaultPreferences, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &notificationsv1.Preferences{
+		PushEnabled:        row.PushEnabled,
+		EmailDigest:        row.EmailDigest,
+		NewAssignments:     row.NewAssignments,
+		ScoresPosted:       row.ScoresPosted,
+		MissingAssignments: row.MissingAssignments,
+		LateAssignments:    row.LateAssignments,
+		GradeDropThreshold: float32(row.GradeDropThreshold),
+	}, nil
+}
+
+func formatPoints(value *float32) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%g", *value)
+}
+
+// notificationsForChanges turns the changes the user wants to hear about
+// into notifications.
+func notificationsForChanges(prefs *notificationsv1.Preferences, changes []*sisv1.Change) []Notification {
+	var out []Notification
+	for _, c := range changes {
+		n := Notification{Time: time.Unix(c.GetTime(), 0)}
+
+		switch c.GetType() {
+		case sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT:
+			if !prefs.GetNewAssignments() {
+				continue
+			}
+			n.Title = fmt.Sprintf("New assignment in %s", c.GetCourseName())
+			n.Body = c.GetAssignment()
+		case sisv1.ChangeType_CHANGE_SCORE_POSTED:
+			if !prefs.GetScoresPosted() {
+				continue
+			}
+			n.Title = fmt.Sprintf("Score posted in %s", c.GetCourseName())
+			n.Body = fmt.Sprintf("%s: %s points", c.GetAssignment(), formatPoints(c.Current))
+		case sisv1.ChangeType_CHANGE_SCORE_CHANGED:
+			if !prefs.GetScoresPosted() {
+				continue
+			}
+			n.Title = fmt.Sprintf("Score changed in %s", c.GetCourseName())
+			n.Body = fmt.Sprintf(
+				"%s: %s -> %s points",
+				c.GetAssignment(), formatPoints(c.Previous), formatPoints(c.Current),
+			)
+		case sisv1.ChangeType_CHANGE_MARKED_MISSING:
+			if !prefs.GetMissingAssignments() {
+				continue
+			}
+			n.Title = fmt.Sprintf("Missing assignment in %s", c.GetCourseName())
+			n.Body = fmt.Sprintf("%s was marked missing.", c.GetAssignment())
+		case sisv1.ChangeType_CHANGE_MARKED_LATE:
+			if !prefs.GetLateAssignments() {
+				continue
+			}
+			n.Title = fmt.Sprintf("Late assignment in %s", c.GetCourseName())
+			n.Body = fmt.Sprintf("%s was marked late.", c.GetAssignment())
+		case sisv1.ChangeType_CHANGE_OVERALL_GRADE:
+			threshold := prefs.GetGradeDropThreshold()
+			if threshold < 0 || c.Previous == nil || c.Current == nil {
+				continue
+			}
+			if c.GetPrevious()-c.GetCurrent() <= threshold {
+				continue
+			}
+			n.Title = fmt.Sprintf("Grade dropped in %s", c.GetCourseName())
+			n.Body = fmt.Sprintf(
+				"Your grade went from %.2f%% to %.2f%%.",
+				c.GetPrevious(), c.GetCurrent(),
+			)
+		default:
+			continue
+		}
+
+		out = append(out, n)
+	}
+	return out
+}
+
+func (s Service) push(ctx context.Context, email string, notifications []Notification) error {
+	devices, err := s.qry.GetDevices(ctx, email)
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return nil
+	}
+
+	err = s.pushSender.Send(ctx, Recipient{Email: email, Devices: devices}, notifications)
+	var expired ExpiredDevicesError
+	if errors.As(err, &expired) {
+		for _, endpoint := range expired.Endpoints {
+			slog.InfoContext(ctx, "removing expired device", "email", email, "endpoint", endpoint)
+			deleteErr := s.qry.DeleteDeviceByEndpoint(ctx, endpoint)
+			if deleteErr != nil {
+				slog.ErrorContext(ctx, "delete expired device", "endpoint", endpoint, "err", deleteErr)
+			}
+		}
+	}
+	return err
+}
+
+// NotifyChanges notifies a user of the changes detected in their grades
+// according to their preferences.
+func (s Service) NotifyChanges(ctx context.Context, email string, changes []*sisv1.Change) error {
+	ctx, span := tracer.Start(ctx, "NotifyChanges")
+	defer span.End()
+
+	prefs, err := s.getPreferences(ctx, email)
+	if err != nil {
+		return err
+	}
+	notifications := notificationsForChanges(prefs, changes)
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	var errs []error
+	if prefs.GetPushEnabled() && s.pushSender != nil {
+		err = s.push(ctx, email, notifications)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if prefs.GetEmailDigest() && s.digest != nil {
+		for _, n := range notifications {
+			err = s.qry.CreateDigestItem(ctx, db.CreateDigestItemParams{
+				Email: email,
+				Time:  n.Time.Unix(),
+				Title: n.Title,
+				Body:  n.Body,
+			})
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sendDigests sends every user their pending digest items, items are only
+// removed once they have been sent.
+func (s Service) sendDigests(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "sendDigests")
+	defer span.End()
+
+	items, err := s.qry.GetDigestItems(ctx)
+	if err != nil {
+		return err
+	}
+
+	grouped := make(map[string][]db.DigestItem)
+	var emails []string
+	for _, item := range items {
+		if _, ok := grouped[item.Email]; !ok {
+			emails = append(emails, item.Email)
+		}
+		grouped[item.Email] = append(grouped[item.Email], item)
+	}
+
+	for _, email := range emails {
+		var maxId int64
+		notifications := make([]Notification, len(grouped[email]))
+		for i, item := range grouped[email] {
+			notifications[i] = Notification{
+				Title: item.Title,
+				Body:  item.Body,
+				Time:  time.Unix(item.Time, 0),
+			}
+			maxId = max(maxId, item.ID)
+		}
+
+		err = s.digest.Send(ctx, Recipient{Email: email}, notifications)
+		if err != nil {
+			slog.ErrorContext(ctx, "send digest", "email", email, "err", err)
+			continue
+		}
+		err = s.qry.DeleteDigestItems(ctx, db.DeleteDigestItemsParams{
+			Email: email,
+			MaxID: maxId,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "delete sent digest items", "email", email, "err", err)
+		}
+	}
+
+	return nil
+}
+
+func (s Service) sendDigestsDaemon(ctx context.Context) {
+	slog.InfoContext(ctx, "start daemon", "task", "send email digests at 5:00 PM every day")
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if timezone.Now().Hour() != 17 {
+				continue
+			}
+			err := s.sendDigests(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "send digests", "err", err)
+			}
+		}
+	}
+}
+
+func (s Service) RegisterDevice(ctx context.Context, req *connect.Request[notificationsv1.RegisterDeviceRequest]) (*connect.Response[notificationsv1.RegisterDeviceResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	device := req.Msg.GetDevice()
+	if device.GetEndpoint() == "" || device.GetP256Dh() == "" || device.GetAuth() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("endpoint, p256dh and auth must be specified"))
+	}
+
+	err := validateEndpoint(device.GetEndpoint())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rows, err := s.qry.CreateDevice(ctx, db.CreateDeviceParams{
+		Endpoint:  device.GetEndpoint(),
+		Email:     profile.Email,
+		P256dh:    device.GetP256Dh(),
+		Auth:      device.GetAuth(),
+		CreatedAt: timezone.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("endpoint is registered to another user"))
+	}
+
+	return &connect.Response[notificationsv1.RegisterDeviceResponse]{
+		Msg: &notificationsv1.RegisterDeviceResponse{},
+	}, nil
+}
+
+func (s Service) UnregisterDevice(ctx context.Context, req *connect.Request[notificationsv1.UnregisterDeviceRequest]) (*connect.Response[notificationsv1.UnregisterDeviceResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	err := s.qry.DeleteDevice(ctx, db.DeleteDeviceParams{
+		Email:    profile.Email,
+		Endpoint: req.Msg.GetEndpoint(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[notificationsv1.UnregisterDeviceResponse]{
+		Msg: &notificationsv1.UnregisterDeviceResponse{},
+	}, nil
+}
+
+func (s Service) ListDevices(ctx context.Context, req *connect.Request[notificationsv1.ListDevicesRequest]) (*connect.Response[notificationsv1.ListDevicesResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	rows, err := s.qry.GetDevices(ctx, profile.Email)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]*notificationsv1.Device, len(rows))
+	for i, r := range rows {
+		devices[i] = &notificationsv1.Device{
+			Endpoint: r.Endpoint,
+			P256Dh:   r.P256dh,
+			Auth:     r.Auth,
+		}
+	}
+
+	return &connect.Response[notificationsv1.ListDevicesResponse]{
+		Msg: &notificationsv1.ListDevicesResponse{
+			Devices: devices,
+		},
+	}, nil
+}
+
+func (s Service) GetPushConfig(ctx context.Context, req *connect.Request[notificationsv1.GetPushConfigRequest]) (*connect.Response[notificationsv1.GetPushConfigResponse], error) {
+	return &connect.Response[notificationsv1.GetPushConfigResponse]{
+		Msg: &notificationsv1.GetPushConfigResponse{
+			VapidPublicKey: s.vapidPublicKey,
+		},
+	}, nil
+}
+
+func (s Service) GetPreferences(ctx context.Context, req *connect.Request[notificationsv1.GetPreferencesRequest]) (*connect.Response[notificationsv1.GetPreferencesResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	prefs, err := s.getPreferences(ctx, profile.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[notificationsv1.GetPreferencesResponse]{
+		Msg: &notificationsv1.GetPreferencesResponse{
+			Preferences: prefs,
+		},
+	}, nil
+}
+
+func (s Service) SetPreferences(ctx context.Context, req *connect.Request[notificationsv1.SetPreferencesRequest]) (*connect.Response[notificationsv1.SetPreferencesResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	prefs := req.Msg.GetPreferences()
+	if prefs == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("preferences must be specified"))
+	}
+
+	err := s.qry.SetPreferences(ctx, db.SetPreferencesParams{
+		Email:              profile.Email,
+		PushEnabled:        prefs.GetPushEnabled(),
+		EmailDigest:        prefs.GetEmailDigest(),
+		NewAssignments:     prefs.GetNewAssignments(),
+		ScoresPosted:       prefs.GetScoresPosted(),
+		MissingAssignments: prefs.GetMissingAssignments(),
+		LateAssignments:    prefs.GetLateAssignments(),
+		GradeDropThreshold: float64(prefs.GetGradeDropThreshold()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[notificationsv1.SetPreferencesResponse]{
+		Msg: &notificationsv1.SetPreferencesResponse{},
+	}, nil
+}
@@ -0,0 +1,191 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"vcassist-backend/lib/telemetry"
+	notificationsv1 "vcassist-backend/proto/vcassist/services/notifications/v1"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	authdb "vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/notifications/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+type sentBatch struct {
+	recipient     Recipient
+	notifications []Notification
+}
+
+type memorySender struct {
+	sent    *[]sentBatch
+	expired []string
+}
+
+func (s memorySender) Send(ctx context.Context, recipient Recipient, notifications []Notification) error {
+	*s.sent = append(*s.sent, sentBatch{recipient: recipient, notifications: notifications})
+	if len(s.expired) > 0 {
+		return ExpiredDevicesError{Endpoints: s.expired}
+	}
+	return nil
+}
+
+func float(value float32) *float32 {
+	return &value
+}
+
+func TestService(t *testing.T) {
+	cleanup := telemetry.SetupForTesting("test:notifications")
+	defer cleanup()
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pushed []sentBatch
+	var digested []sentBatch
+	service := Service{
+		db:  sqlite,
+		qry: db.New(sqlite),
+		pushSender: memorySender{
+			sent:    &pushed,
+			expired: []string{"https://push.example.com/expired"},
+		},
+		digest: memorySender{sent: &digested},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	const email = "student@example.com"
+	ctx = verifier.ContextWithProfile(ctx, authdb.User{Email: email})
+
+	for _, endpoint := range []string{
+		"https://push.example.com/phone",
+		"https://push.example.com/expired",
+	} {
+		_, err = service.RegisterDevice(ctx, &connect.Request[notificationsv1.RegisterDeviceRequest]{
+			Msg: &notificationsv1.RegisterDeviceRequest{
+				Device: &notificationsv1.Device{Endpoint: endpoint, P256Dh: "key", Auth: "secret"},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// endpoints have to be public push services
+	_, err = service.RegisterDevice(ctx, &connect.Request[notificationsv1.RegisterDeviceRequest]{
+		Msg: &notificationsv1.RegisterDeviceRequest{
+			Device: &notificationsv1.Device{Endpoint: "https://169.254.169.254/latest", P256Dh: "key", Auth: "secret"},
+		},
+	})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// another user can't take over an endpoint that is already registered
+	otherCtx := verifier.ContextWithProfile(ctx, authdb.User{Email: "other@example.com"})
+	_, err = service.RegisterDevice(otherCtx, &connect.Request[notificationsv1.RegisterDeviceRequest]{
+		Msg: &notificationsv1.RegisterDeviceRequest{
+			Device: &notificationsv1.Device{Endpoint: "https://push.example.com/phone", P256Dh: "other", Auth: "other"},
+		},
+	})
+	require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// the owner can still refresh the keys
+	_, err = service.RegisterDevice(ctx, &connect.Request[notificationsv1.RegisterDeviceRequest]{
+		Msg: &notificationsv1.RegisterDeviceRequest{
+			Device: &notificationsv1.Device{Endpoint: "https://push.example.com/phone", P256Dh: "key", Auth: "secret"},
+		},
+	})
+	require.NoError(t, err)
+
+	prefsRes, err := service.GetPreferences(ctx, &connect.Request[notificationsv1.GetPreferencesRequest]{
+		Msg: &notificationsv1.GetPreferencesRequest{},
+	})
+	require.NoError(t, err)
+	require.True(t, prefsRes.Msg.GetPreferences().GetPushEnabled())
+	require.Equal(t, float32(2), prefsRes.Msg.GetPreferences().GetGradeDropThreshold())
+
+	changes := []*sisv1.Change{
+		{
+			Type:       sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT,
+			CourseName: "Biology",
+			Assignment: "Lab Report",
+		},
+		{
+			// a drop of 1.5% is below the default threshold
+			Type:       sisv1.ChangeType_CHANGE_OVERALL_GRADE,
+			CourseName: "Biology",
+			Previous:   float(95),
+			Current:    float(93.5),
+		},
+		{
+			Type:       sisv1.ChangeType_CHANGE_OVERALL_GRADE,
+			CourseName: "Chemistry",
+			Previous:   float(90),
+			Current:    float(85),
+		},
+		{
+			// grade increases are never sent
+			Type:       sisv1.ChangeType_CHANGE_OVERALL_GRADE,
+			CourseName: "Physics",
+			Previous:   float(80),
+			Current:    float(90),
+		},
+	}
+
+	err = service.NotifyChanges(ctx, email, changes)
+	var expired ExpiredDevicesError
+	require.True(t, errors.As(err, &expired))
+
+	require.Len(t, pushed, 1)
+	require.Len(t, pushed[0].recipient.Devices, 2)
+	require.Len(t, pushed[0].notifications, 2)
+	require.Equal(t, "New assignment in Biology", pushed[0].notifications[0].Title)
+	require.Equal(t, "Grade dropped in Chemistry", pushed[0].notifications[1].Title)
+
+	devicesRes, err := service.ListDevices(ctx, &connect.Request[notificationsv1.ListDevicesRequest]{
+		Msg: &notificationsv1.ListDevicesRequest{},
+	})
+	require.NoError(t, err)
+	require.Len(t, devicesRes.Msg.GetDevices(), 1)
+	require.Equal(t, "https://push.example.com/phone", devicesRes.Msg.GetDevices()[0].GetEndpoint())
+
+	_, err = service.SetPreferences(ctx, &connect.Request[notificationsv1.SetPreferencesRequest]{
+		Msg: &notificationsv1.SetPreferencesRequest{
+			Preferences: &notificationsv1.Preferences{
+				PushEnabled:        false,
+				EmailDigest:        true,
+				NewAssignments:     false,
+				GradeDropThreshold: 1,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	pushed = nil
+	err = service.NotifyChanges(ctx, email, changes)
+	require.NoError(t, err)
+	require.Empty(t, pushed)
+
+	err = service.sendDigests(ctx)
+	require.NoError(t, err)
+	require.Len(t, digested, 1)
+	require.Equal(t, email, digested[0].recipient.Email)
+	require.Len(t, digested[0].notifications, 2)
+	require.Equal(t, "Grade dropped in Biology", digested[0].notifications[0].Title)
+	require.Equal(t, "Grade dropped in Chemistry", digested[0].notifications[1].Title)
+
+	// sent items are removed from the digest
+	digested = nil
+	err = service.sendDigests(ctx)
+	require.NoError(t, err)
+	require.Empty(t, digested)
+}
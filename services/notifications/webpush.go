@@ -0,0 +1,356 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"vcassist-backend/services/notifications/db"
+
+	"go.opentelemetry.io/otel/codes"
+)
+
+// the size of the single record that the payload is encrypted into, see
+// RFC 8188 section 2
+const webPushRecordSize = 4096
+
+// how long the push service should hold onto a message for a device that
+// is offline
+const webPushTTL = 24 * time.Hour
+
+// WebPushSender delivers notifications through the Web Push protocol
+// (RFC 8030), with payloads encrypted as described in RFC 8291 and the
+// application server identified with VAPID (RFC 8292).
+type WebPushSender struct {
+	key       *ecdsa.PrivateKey
+	publicKey []byte
+	// a mailto: or https: url the push service can use to contact us
+	subject string
+	client  *http.Client
+}
+
+// errPrivateEndpoint is returned when a push endpoint points somewhere other
+// than the public internet.
+var errPrivateEndpoint = errors.New("push endpoint must be a public address")
+
+func isPublicIp(ip net.IP) bool {
+	return ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast()
+}
+
+// validateEndpoint checks that an endpoint given by a client looks like
+// a push service, since the server will POST to whatever it is given.
+// hostnames can still resolve to private addresses, those are caught
+// when the connection is made (see dialPublic).
+func validateEndpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("parse endpoint: %w", err)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("push endpoint must use https")
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("push endpoint must not contain credentials")
+	}
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "" {
+		return fmt.Errorf("push endpoint must have a host")
+	}
+	if host == "localhost" ||
+		strings.HasSuffix(host, ".localhost") ||
+		strings.HasSuffix(host, ".local") ||
+		strings.HasSuffix(host, ".internal") {
+		return errPrivateEndpoint
+	}
+	ip := net.ParseIP(host)
+	if ip != nil && !isPublicIp(ip) {
+		return errPrivateEndpoint
+	}
+	return nil
+}
+
+// dialPublic refuses connections to addresses that aren't public, after
+// the hostname has been resolved.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIp(ip) {
+		return errPrivateEndpoint
+	}
+	return nil
+}
+
+// GenerateVapidKey creates a new base64url encoded VAPID private key.
+func GenerateVapidKey() (string, error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(key.Bytes()), nil
+}
+
+func decodeBase64Url(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+// NewWebPushSender creates a sender from a base64url encoded VAPID private
+// key (the raw 32 byte P-256 scalar).
+func NewWebPushSender(privateKey, subject string) (WebPushSender, error) {
+	raw, err := decodeBase64Url(privateKey)
+	if err != nil {
+		return WebPushSender{}, fmt.Errorf("decode vapid key: %w", err)
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return WebPushSender{}, fmt.Errorf("parse vapid key: %w", err)
+	}
+
+	// uncompressed point, 0x04 || x || y
+	publicKey := ecdhKey.PublicKey().Bytes()
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(publicKey[1:33]),
+			Y:     new(big.Int).SetBytes(publicKey[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}
+
+	return WebPushSender{
+		key:       key,
+		publicKey: publicKey,
+		subject:   subject,
+		client: &http.Client{
+			Timeout: time.Second * 30,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: (&net.Dialer{
+					Timeout: time.Second * 30,
+					Control: dialPublic,
+				}).DialContext,
+				TLSHandshakeTimeout: time.Second * 10,
+			},
+			// a redirect could point anywhere
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}, nil
+}
+
+// PublicKey returns the base64url encoded VAPID public key, this is the
+// applicationServerKey browsers need to subscribe.
+func (s WebPushSender) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(s.publicKey)
+}
+
+func (s WebPushSender) vapidToken(endpoint string) (string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"typ": "JWT",
+		"alg": "ES256",
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"aud": fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host),
+		"exp": time.Now().Add(time.Hour * 12).Unix(),
+		"sub": s.subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, sig, err := ecdsa.Sign(rand.Reader, s.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	sig.FillBytes(signature[32:])
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func hmacSha256(key []byte, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, p := range parts {
+		mac.Write(p)
+	}
+	return mac.Sum(nil)
+}
+
+// hkdf is HKDF-SHA256 for outputs no longer than a single hash block,
+// which is all web push needs.
+func hkdf(salt, ikm, info []byte, length int) []byte {
+	prk := hmacSha256(salt, ikm)
+	return hmacSha256(prk, info, []byte{1})[:length]
+}
+
+// encryptWebPush encrypts a payload for a subscription with the aes128gcm
+// content coding.
+func encryptWebPush(device db.Device, payload []byte) ([]byte, error) {
+	uaPublicRaw, err := decodeBase64Url(device.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("decode p256dh: %w", err)
+	}
+	authSecret, err := decodeBase64Url(device.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("decode auth: %w", err)
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicRaw)
+	if err != nil {
+		return nil, fmt.Errorf("parse p256dh: %w", err)
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicRaw := asPrivate.PublicKey().Bytes()
+	shared, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublicRaw...)
+	keyInfo = append(keyInfo, asPublicRaw...)
+	ikm := hkdf(authSecret, shared, keyInfo, 32)
+
+	salt := make([]byte, 16)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	cek := hkdf(salt, ikm, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce := hkdf(salt, ikm, []byte("Content-Encoding: nonce\x00"), 12)
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0x02 is the padding delimiter of the last (and only) record
+	plaintext := append(append([]byte{}, payload...), 2)
+	if len(plaintext)+gcm.Overhead() > webPushRecordSize {
+		return nil, fmt.Errorf("payload is too large (%d bytes)", len(payload))
+	}
+
+	header := make([]byte, 0, 16+4+1+len(asPublicRaw))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublicRaw)))
+	header = append(header, asPublicRaw...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// errDeviceExpired is returned by sendToDevice when the push service
+// reports that the subscription no longer exists.
+var errDeviceExpired = errors.New("device expired")
+
+func (s WebPushSender) sendToDevice(ctx context.Context, device db.Device, payload []byte) error {
+	body, err := encryptWebPush(device, payload)
+	if err != nil {
+		return err
+	}
+	token, err := s.vapidToken(device.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", device.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", fmt.Sprint(int64(webPushTTL.Seconds())))
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, s.PublicKey()))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return errDeviceExpired
+	case res.StatusCode >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("push service returned %s: %s", res.Status, string(msg))
+	}
+	return nil
+}
+
+func (s WebPushSender) Send(ctx context.Context, recipient Recipient, notifications []Notification) error {
+	ctx, span := tracer.Start(ctx, "WebPushSender.Send")
+	defer span.End()
+
+	var expired []string
+	var errs []error
+	for _, device := range recipient.Devices {
+		for _, n := range notifications {
+			payload, err := json.Marshal(n)
+			if err != nil {
+				return err
+			}
+			err = s.sendToDevice(ctx, device, payload)
+			if errors.Is(err, errDeviceExpired) {
+				expired = append(expired, device.Endpoint)
+				break
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("send to '%s': %w", device.Endpoint, err))
+			}
+		}
+	}
+	if len(expired) > 0 {
+		errs = append(errs, ExpiredDevicesError{Endpoints: expired})
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to send to some devices")
+	}
+	return err
+}
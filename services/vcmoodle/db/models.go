@@ -15,11 +15,39 @@ type Chapter struct {
 	ID          int64
 	Name        string
 	ContentHtml string
+	ContentHash string
+	DeletedAt   sql.NullInt64
+}
+
+type ContentChange struct {
+	ID              int64
+	Time            int64
+	Type            int64
+	Kind            int64
+	CourseID        int64
+	SectionIdx      int64
+	ResourceIdx     int64
+	ChapterID       int64
+	Name            string
+	Url             string
+	Content         string
+	PreviousContent string
 }
 
 type Course struct {
-	ID   int64
-	Name string
+	ID          int64
+	Name        string
+	ContentHash string
+	DeletedAt   sql.NullInt64
+}
+
+type FileMirror struct {
+	Url         string
+	Hash        string
+	Size        int64
+	ContentType string
+	Filename    string
+	MirroredAt  int64
 }
 
 type Resource struct {
@@ -30,10 +58,45 @@ type Resource struct {
 	Type           int64
 	Url            string
 	DisplayContent string
+	ContentHash    string
+	DeletedAt      sql.NullInt64
+}
+
+type ScrapeRun struct {
+	ID        int64
+	Start     int64
+	Duration  int64
+	Courses   int64
+	Sections  int64
+	Resources int64
+	Chapters  int64
+	Changed   int64
+	Deleted   int64
+	Errors    int64
+	Committed bool
+	Reason    string
+}
+
+type SearchIndex struct {
+	Kind        interface{}
+	CourseID    interface{}
+	SectionIdx  interface{}
+	ResourceIdx interface{}
+	ChapterID   interface{}
+	Url         interface{}
+	Title       string
+	Content     string
 }
 
 type Section struct {
+	CourseID    int64
+	Idx         int64
+	Name        string
+	ContentHash string
+	DeletedAt   sql.NullInt64
+}
+
+type UserCourse struct {
+	Email    string
 	CourseID int64
-	Idx      int64
-	Name     string
 }
@@ -1,62 +1,170 @@
--- name: DeleteAllCourses :exec
-delete from Course;
+-- name: GetCourseHashes :many
+select id, content_hash from Course where deleted_at is null;
 
--- name: DeleteAllSections :exec
-delete from Section;
+-- name: GetSectionHashes :many
+select course_id, idx, content_hash from Section where deleted_at is null;
 
--- name: DeleteAllResources :exec
-delete from Resource;
+-- name: GetResourceHashes :many
+select course_id, section_idx, idx, content_hash from Resource where deleted_at is null;
 
--- name: DeleteAllChapters :exec
-delete from Chapter;
+-- name: GetChapterHashes :many
+select course_id, section_idx, resource_idx, id, content_hash from Chapter where deleted_at is null;
 
 -- name: NoteCourse :exec
-insert into Course(id, name) values (?, ?)
+insert into Course(id, name, content_hash) values (?, ?, ?)
 on conflict (id) do update
-    set name = excluded.name;
+    set name = excluded.name,
+        content_hash = excluded.content_hash,
+        deleted_at = null;
 
 -- name: NoteSection :exec
-insert into Section(course_id, idx, name) values (?, ?, ?)
+insert into Section(course_id, idx, name, content_hash) values (?, ?, ?, ?)
 on conflict (course_id, idx) do update
-    set name = excluded.name;
+    set name = excluded.name,
+        content_hash = excluded.content_hash,
+        deleted_at = null;
 
 -- name: NoteResource :exec
-insert into Resource(course_id, section_idx, idx, id, type, url, display_content) values (?, ?, ?, ?, ?, ?, ?)
+insert into Resource(course_id, section_idx, idx, id, type, url, display_content, content_hash) values (?, ?, ?, ?, ?, ?, ?, ?)
 on conflict (course_id, section_idx, idx) do update
-    set type = excluded.type,
+    set id = excluded.id,
+        type = excluded.type,
         url = excluded.url,
-        display_content = excluded.display_content;
+        display_content = excluded.display_content,
+        content_hash = excluded.content_hash,
+        deleted_at = null;
 
 -- name: NoteChapter :exec
-insert into Chapter(course_id, section_idx, resource_idx, id, name, content_html) values (?, ?, ?, ?, ?, ?)
+insert into Chapter(course_id, section_idx, resource_idx, id, name, content_html, content_hash) values (?, ?, ?, ?, ?, ?, ?)
 on conflict (id) do update
     set course_id = excluded.course_id,
         section_idx = excluded.section_idx,
         resource_idx = excluded.resource_idx,
         name = excluded.name,
-        content_html = excluded.content_html;
+        content_html = excluded.content_html,
+        content_hash = excluded.content_hash,
+        deleted_at = null;
+
+-- name: SoftDeleteCourse :exec
+update Course set deleted_at = ? where id = ?;
+
+-- name: SoftDeleteSection :exec
+update Section set deleted_at = ? where course_id = ? and idx = ?;
+
+-- name: SoftDeleteResource :exec
+update Resource set deleted_at = ? where course_id = ? and section_idx = ? and idx = ?;
+
+-- name: SoftDeleteChapter :exec
+update Chapter set deleted_at = ? where id = ?;
+
+-- name: NoteSearchEntry :exec
+insert into SearchIndex(kind, course_id, section_idx, resource_idx, chapter_id, url, title, content) values (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteSearchEntry :exec
+delete from SearchIndex where
+    kind = ? and
+    course_id = ? and
+    section_idx = ? and
+    resource_idx = ? and
+    chapter_id = ?;
+
+-- name: GetSection :one
+select * from Section where course_id = ? and idx = ?;
+
+-- name: GetResource :one
+select * from Resource where course_id = ? and section_idx = ? and idx = ?;
+
+-- name: GetChapter :one
+select * from Chapter where id = ?;
+
+-- name: CreateContentChange :exec
+insert into ContentChange(time, type, kind, course_id, section_idx, resource_idx, chapter_id, name, url, content, previous_content)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteContentChangesBefore :exec
+delete from ContentChange where time < ?;
+
+-- name: CreateScrapeRun :exec
+insert into ScrapeRun(start, duration, courses, sections, resources, chapters, changed, deleted, errors, committed, reason)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetLastCommittedScrapeRun :one
+select * from ScrapeRun where committed order by id desc limit 1;
+
+-- name: NoteFileMirror :exec
+insert into FileMirror(url, hash, size, content_type, filename, mirrored_at) values (?, ?, ?, ?, ?, ?)
+on conflict (url) do update
+    set hash = excluded.hash,
+        size = excluded.size,
+        content_type = excluded.content_type,
+        filename = excluded.filename,
+        mirrored_at = excluded.mirrored_at;
+
+-- name: GetFileMirror :one
+select * from FileMirror where url = ?;
+
+-- name: GetFileMirrorHashes :many
+select distinct hash from FileMirror;
 
 -- name: GetCourses :many
-select * from Course where id in (sqlc.slice(ids));
+select * from Course where id in (sqlc.slice(ids)) and deleted_at is null;
 
 -- name: GetCourseSections :many
-select * from Section where course_id = ?;
+select * from Section where course_id = ? and deleted_at is null;
 
 -- name: GetSectionResources :many
-select * from Resource where course_id = ? and section_idx = ?;
+select * from Resource where course_id = ? and section_idx = ? and deleted_at is null;
 
 -- name: GetResourceChapters :many
 select * from Chapter where
     course_id = ? and
     section_idx = ? and
-    resource_idx = ?;
+    resource_idx = ? and
+    deleted_at is null;
 
 -- name: GetChapterContent :one
-select content_html from Chapter where id = ?;
+select content_html from Chapter where id = ? and deleted_at is null;
 
 -- name: GetFileResource :one
-select url from Resource where id = ? and type = 1;
+select url from Resource where id = ? and type = 1 and deleted_at is null;
+
+-- name: GetFileCourseIds :many
+select distinct course_id from Resource where url = ? and type = 1 and deleted_at is null;
 
 -- name: GetAllCourses :many
-select * from Course;
+select * from Course where deleted_at is null;
+
+-- name: SearchContent :many
+-- title matches are weighted more heavily than content matches, lower
+-- ranks are better matches. matches in the snippet are surrounded with
+-- STX and ETX so the snippet can be escaped before they become <mark>
+-- tags.
+select
+    cast(kind as integer) as kind,
+    cast(course_id as integer) as course_id,
+    cast(section_idx as integer) as section_idx,
+    cast(resource_idx as integer) as resource_idx,
+    cast(chapter_id as integer) as chapter_id,
+    cast(url as text) as url,
+    cast(title as text) as title,
+    cast(snippet(SearchIndex, 7, char(2), char(3), '...', 16) as text) as snippet,
+    cast(bm25(SearchIndex, 0, 0, 0, 0, 0, 0, 10, 1) as real) as rank
+from SearchIndex
+where SearchIndex match ? and course_id in (sqlc.slice(course_ids))
+order by rank
+limit ?;
+
+-- name: GetContentChanges :many
+select * from ContentChange
+where time > ? and course_id in (sqlc.slice(course_ids))
+order by time, id;
+
+-- name: GetUserCourseIds :many
+select course_id from UserCourse where email = ?;
+
+-- name: DeleteUserCourses :exec
+delete from UserCourse where email = ?;
 
+-- name: NoteUserCourse :exec
+insert into UserCourse(email, course_id) values (?, ?)
+on conflict do nothing;
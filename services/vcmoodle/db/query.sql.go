@@ -11,44 +11,126 @@ import (
 	"strings"
 )
 
-const deleteAllChapters = `-- name: DeleteAllChapters :exec
-delete from Chapter
+const createContentChange = `-- name: CreateContentChange :exec
+insert into ContentChange(time, type, kind, course_id, section_idx, resource_idx, chapter_id, name, url, content, previous_content)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) DeleteAllChapters(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, deleteAllChapters)
+type CreateContentChangeParams struct {
+	Time            int64
+	Type            int64
+	Kind            int64
+	CourseID        int64
+	SectionIdx      int64
+	ResourceIdx     int64
+	ChapterID       int64
+	Name            string
+	Url             string
+	Content         string
+	PreviousContent string
+}
+
+func (q *Queries) CreateContentChange(ctx context.Context, arg CreateContentChangeParams) error {
+	_, err := q.db.ExecContext(ctx, createContentChange,
+		arg.Time,
+		arg.Type,
+		arg.Kind,
+		arg.CourseID,
+		arg.SectionIdx,
+		arg.ResourceIdx,
+		arg.ChapterID,
+		arg.Name,
+		arg.Url,
+		arg.Content,
+		arg.PreviousContent,
+	)
+	return err
+}
+
+const createScrapeRun = `-- name: CreateScrapeRun :exec
+insert into ScrapeRun(start, duration, courses, sections, resources, chapters, changed, deleted, errors, committed, reason)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateScrapeRunParams struct {
+	Start     int64
+	Duration  int64
+	Courses   int64
+	Sections  int64
+	Resources int64
+	Chapters  int64
+	Changed   int64
+	Deleted   int64
+	Errors    int64
+	Committed bool
+	Reason    string
+}
+
+func (q *Queries) CreateScrapeRun(ctx context.Context, arg CreateScrapeRunParams) error {
+	_, err := q.db.ExecContext(ctx, createScrapeRun,
+		arg.Start,
+		arg.Duration,
+		arg.Courses,
+		arg.Sections,
+		arg.Resources,
+		arg.Chapters,
+		arg.Changed,
+		arg.Deleted,
+		arg.Errors,
+		arg.Committed,
+		arg.Reason,
+	)
 	return err
 }
 
-const deleteAllCourses = `-- name: DeleteAllCourses :exec
-delete from Course
+const deleteContentChangesBefore = `-- name: DeleteContentChangesBefore :exec
+delete from ContentChange where time < ?
 `
 
-func (q *Queries) DeleteAllCourses(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, deleteAllCourses)
+func (q *Queries) DeleteContentChangesBefore(ctx context.Context, time int64) error {
+	_, err := q.db.ExecContext(ctx, deleteContentChangesBefore, time)
 	return err
 }
 
-const deleteAllResources = `-- name: DeleteAllResources :exec
-delete from Resource
+const deleteSearchEntry = `-- name: DeleteSearchEntry :exec
+delete from SearchIndex where
+    kind = ? and
+    course_id = ? and
+    section_idx = ? and
+    resource_idx = ? and
+    chapter_id = ?
 `
 
-func (q *Queries) DeleteAllResources(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, deleteAllResources)
+type DeleteSearchEntryParams struct {
+	Kind        interface{}
+	CourseID    interface{}
+	SectionIdx  interface{}
+	ResourceIdx interface{}
+	ChapterID   interface{}
+}
+
+func (q *Queries) DeleteSearchEntry(ctx context.Context, arg DeleteSearchEntryParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSearchEntry,
+		arg.Kind,
+		arg.CourseID,
+		arg.SectionIdx,
+		arg.ResourceIdx,
+		arg.ChapterID,
+	)
 	return err
 }
 
-const deleteAllSections = `-- name: DeleteAllSections :exec
-delete from Section
+const deleteUserCourses = `-- name: DeleteUserCourses :exec
+delete from UserCourse where email = ?
 `
 
-func (q *Queries) DeleteAllSections(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, deleteAllSections)
+func (q *Queries) DeleteUserCourses(ctx context.Context, email string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserCourses, email)
 	return err
 }
 
 const getAllCourses = `-- name: GetAllCourses :many
-select id, name from Course
+select id, name, content_hash, deleted_at from Course where deleted_at is null
 `
 
 func (q *Queries) GetAllCourses(ctx context.Context) ([]Course, error) {
@@ -60,7 +142,12 @@ func (q *Queries) GetAllCourses(ctx context.Context) ([]Course, error) {
 	var items []Course
 	for rows.Next() {
 		var i Course
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ContentHash,
+			&i.DeletedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -74,8 +161,28 @@ func (q *Queries) GetAllCourses(ctx context.Context) ([]Course, error) {
 	return items, nil
 }
 
+const getChapter = `-- name: GetChapter :one
+select course_id, section_idx, resource_idx, id, name, content_html, content_hash, deleted_at from Chapter where id = ?
+`
+
+func (q *Queries) GetChapter(ctx context.Context, id int64) (Chapter, error) {
+	row := q.db.QueryRowContext(ctx, getChapter, id)
+	var i Chapter
+	err := row.Scan(
+		&i.CourseID,
+		&i.SectionIdx,
+		&i.ResourceIdx,
+		&i.ID,
+		&i.Name,
+		&i.ContentHtml,
+		&i.ContentHash,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const getChapterContent = `-- name: GetChapterContent :one
-select content_html from Chapter where id = ?
+select content_html from Chapter where id = ? and deleted_at is null
 `
 
 func (q *Queries) GetChapterContent(ctx context.Context, id int64) (string, error) {
@@ -85,8 +192,139 @@ func (q *Queries) GetChapterContent(ctx context.Context, id int64) (string, erro
 	return content_html, err
 }
 
+const getChapterHashes = `-- name: GetChapterHashes :many
+select course_id, section_idx, resource_idx, id, content_hash from Chapter where deleted_at is null
+`
+
+type GetChapterHashesRow struct {
+	CourseID    int64
+	SectionIdx  int64
+	ResourceIdx int64
+	ID          int64
+	ContentHash string
+}
+
+func (q *Queries) GetChapterHashes(ctx context.Context) ([]GetChapterHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getChapterHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChapterHashesRow
+	for rows.Next() {
+		var i GetChapterHashesRow
+		if err := rows.Scan(
+			&i.CourseID,
+			&i.SectionIdx,
+			&i.ResourceIdx,
+			&i.ID,
+			&i.ContentHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContentChanges = `-- name: GetContentChanges :many
+select id, time, type, kind, course_id, section_idx, resource_idx, chapter_id, name, url, content, previous_content from ContentChange
+where time > ? and course_id in (/*SLICE:course_ids*/?)
+order by time, id
+`
+
+type GetContentChangesParams struct {
+	Time      int64
+	CourseIds []int64
+}
+
+func (q *Queries) GetContentChanges(ctx context.Context, arg GetContentChangesParams) ([]ContentChange, error) {
+	query := getContentChanges
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Time)
+	if len(arg.CourseIds) > 0 {
+		for _, v := range arg.CourseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:course_ids*/?", strings.Repeat(",?", len(arg.CourseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:course_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContentChange
+	for rows.Next() {
+		var i ContentChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.Time,
+			&i.Type,
+			&i.Kind,
+			&i.CourseID,
+			&i.SectionIdx,
+			&i.ResourceIdx,
+			&i.ChapterID,
+			&i.Name,
+			&i.Url,
+			&i.Content,
+			&i.PreviousContent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCourseHashes = `-- name: GetCourseHashes :many
+select id, content_hash from Course where deleted_at is null
+`
+
+type GetCourseHashesRow struct {
+	ID          int64
+	ContentHash string
+}
+
+func (q *Queries) GetCourseHashes(ctx context.Context) ([]GetCourseHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCourseHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCourseHashesRow
+	for rows.Next() {
+		var i GetCourseHashesRow
+		if err := rows.Scan(&i.ID, &i.ContentHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCourseSections = `-- name: GetCourseSections :many
-select course_id, idx, name from Section where course_id = ?
+select course_id, idx, name, content_hash, deleted_at from Section where course_id = ? and deleted_at is null
 `
 
 func (q *Queries) GetCourseSections(ctx context.Context, courseID int64) ([]Section, error) {
@@ -98,7 +336,13 @@ func (q *Queries) GetCourseSections(ctx context.Context, courseID int64) ([]Sect
 	var items []Section
 	for rows.Next() {
 		var i Section
-		if err := rows.Scan(&i.CourseID, &i.Idx, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.CourseID,
+			&i.Idx,
+			&i.Name,
+			&i.ContentHash,
+			&i.DeletedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -113,7 +357,7 @@ func (q *Queries) GetCourseSections(ctx context.Context, courseID int64) ([]Sect
 }
 
 const getCourses = `-- name: GetCourses :many
-select id, name from Course where id in (/*SLICE:ids*/?)
+select id, name, content_hash, deleted_at from Course where id in (/*SLICE:ids*/?) and deleted_at is null
 `
 
 func (q *Queries) GetCourses(ctx context.Context, ids []int64) ([]Course, error) {
@@ -135,7 +379,12 @@ func (q *Queries) GetCourses(ctx context.Context, ids []int64) ([]Course, error)
 	var items []Course
 	for rows.Next() {
 		var i Course
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ContentHash,
+			&i.DeletedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -149,8 +398,80 @@ func (q *Queries) GetCourses(ctx context.Context, ids []int64) ([]Course, error)
 	return items, nil
 }
 
+const getFileCourseIds = `-- name: GetFileCourseIds :many
+select distinct course_id from Resource where url = ? and type = 1 and deleted_at is null
+`
+
+func (q *Queries) GetFileCourseIds(ctx context.Context, url string) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getFileCourseIds, url)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var course_id int64
+		if err := rows.Scan(&course_id); err != nil {
+			return nil, err
+		}
+		items = append(items, course_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFileMirror = `-- name: GetFileMirror :one
+select url, hash, size, content_type, filename, mirrored_at from FileMirror where url = ?
+`
+
+func (q *Queries) GetFileMirror(ctx context.Context, url string) (FileMirror, error) {
+	row := q.db.QueryRowContext(ctx, getFileMirror, url)
+	var i FileMirror
+	err := row.Scan(
+		&i.Url,
+		&i.Hash,
+		&i.Size,
+		&i.ContentType,
+		&i.Filename,
+		&i.MirroredAt,
+	)
+	return i, err
+}
+
+const getFileMirrorHashes = `-- name: GetFileMirrorHashes :many
+select distinct hash from FileMirror
+`
+
+func (q *Queries) GetFileMirrorHashes(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getFileMirrorHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		items = append(items, hash)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFileResource = `-- name: GetFileResource :one
-select url from Resource where id = ? and type = 1
+select url from Resource where id = ? and type = 1 and deleted_at is null
 `
 
 func (q *Queries) GetFileResource(ctx context.Context, id sql.NullInt64) (string, error) {
@@ -160,11 +481,63 @@ func (q *Queries) GetFileResource(ctx context.Context, id sql.NullInt64) (string
 	return url, err
 }
 
+const getLastCommittedScrapeRun = `-- name: GetLastCommittedScrapeRun :one
+select id, start, duration, courses, sections, resources, chapters, changed, deleted, errors, committed, reason from ScrapeRun where committed order by id desc limit 1
+`
+
+func (q *Queries) GetLastCommittedScrapeRun(ctx context.Context) (ScrapeRun, error) {
+	row := q.db.QueryRowContext(ctx, getLastCommittedScrapeRun)
+	var i ScrapeRun
+	err := row.Scan(
+		&i.ID,
+		&i.Start,
+		&i.Duration,
+		&i.Courses,
+		&i.Sections,
+		&i.Resources,
+		&i.Chapters,
+		&i.Changed,
+		&i.Deleted,
+		&i.Errors,
+		&i.Committed,
+		&i.Reason,
+	)
+	return i, err
+}
+
+const getResource = `-- name: GetResource :one
+select course_id, section_idx, idx, id, type, url, display_content, content_hash, deleted_at from Resource where course_id = ? and section_idx = ? and idx = ?
+`
+
+type GetResourceParams struct {
+	CourseID   int64
+	SectionIdx int64
+	Idx        int64
+}
+
+func (q *Queries) GetResource(ctx context.Context, arg GetResourceParams) (Resource, error) {
+	row := q.db.QueryRowContext(ctx, getResource, arg.CourseID, arg.SectionIdx, arg.Idx)
+	var i Resource
+	err := row.Scan(
+		&i.CourseID,
+		&i.SectionIdx,
+		&i.Idx,
+		&i.ID,
+		&i.Type,
+		&i.Url,
+		&i.DisplayContent,
+		&i.ContentHash,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const getResourceChapters = `-- name: GetResourceChapters :many
-select course_id, section_idx, resource_idx, id, name, content_html from Chapter where
+select course_id, section_idx, resource_idx, id, name, content_html, content_hash, deleted_at from Chapter where
     course_id = ? and
     section_idx = ? and
-    resource_idx = ?
+    resource_idx = ? and
+    deleted_at is null
 `
 
 type GetResourceChaptersParams struct {
@@ -189,6 +562,8 @@ func (q *Queries) GetResourceChapters(ctx context.Context, arg GetResourceChapte
 			&i.ID,
 			&i.Name,
 			&i.ContentHtml,
+			&i.ContentHash,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -203,8 +578,102 @@ func (q *Queries) GetResourceChapters(ctx context.Context, arg GetResourceChapte
 	return items, nil
 }
 
+const getResourceHashes = `-- name: GetResourceHashes :many
+select course_id, section_idx, idx, content_hash from Resource where deleted_at is null
+`
+
+type GetResourceHashesRow struct {
+	CourseID    int64
+	SectionIdx  int64
+	Idx         int64
+	ContentHash string
+}
+
+func (q *Queries) GetResourceHashes(ctx context.Context) ([]GetResourceHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getResourceHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetResourceHashesRow
+	for rows.Next() {
+		var i GetResourceHashesRow
+		if err := rows.Scan(
+			&i.CourseID,
+			&i.SectionIdx,
+			&i.Idx,
+			&i.ContentHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSection = `-- name: GetSection :one
+select course_id, idx, name, content_hash, deleted_at from Section where course_id = ? and idx = ?
+`
+
+type GetSectionParams struct {
+	CourseID int64
+	Idx      int64
+}
+
+func (q *Queries) GetSection(ctx context.Context, arg GetSectionParams) (Section, error) {
+	row := q.db.QueryRowContext(ctx, getSection, arg.CourseID, arg.Idx)
+	var i Section
+	err := row.Scan(
+		&i.CourseID,
+		&i.Idx,
+		&i.Name,
+		&i.ContentHash,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getSectionHashes = `-- name: GetSectionHashes :many
+select course_id, idx, content_hash from Section where deleted_at is null
+`
+
+type GetSectionHashesRow struct {
+	CourseID    int64
+	Idx         int64
+	ContentHash string
+}
+
+func (q *Queries) GetSectionHashes(ctx context.Context) ([]GetSectionHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSectionHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSectionHashesRow
+	for rows.Next() {
+		var i GetSectionHashesRow
+		if err := rows.Scan(&i.CourseID, &i.Idx, &i.ContentHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSectionResources = `-- name: GetSectionResources :many
-select course_id, section_idx, idx, id, type, url, display_content from Resource where course_id = ? and section_idx = ?
+select course_id, section_idx, idx, id, type, url, display_content, content_hash, deleted_at from Resource where course_id = ? and section_idx = ? and deleted_at is null
 `
 
 type GetSectionResourcesParams struct {
@@ -229,6 +698,8 @@ func (q *Queries) GetSectionResources(ctx context.Context, arg GetSectionResourc
 			&i.Type,
 			&i.Url,
 			&i.DisplayContent,
+			&i.ContentHash,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -243,14 +714,43 @@ func (q *Queries) GetSectionResources(ctx context.Context, arg GetSectionResourc
 	return items, nil
 }
 
+const getUserCourseIds = `-- name: GetUserCourseIds :many
+select course_id from UserCourse where email = ?
+`
+
+func (q *Queries) GetUserCourseIds(ctx context.Context, email string) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getUserCourseIds, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var course_id int64
+		if err := rows.Scan(&course_id); err != nil {
+			return nil, err
+		}
+		items = append(items, course_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const noteChapter = `-- name: NoteChapter :exec
-insert into Chapter(course_id, section_idx, resource_idx, id, name, content_html) values (?, ?, ?, ?, ?, ?)
+insert into Chapter(course_id, section_idx, resource_idx, id, name, content_html, content_hash) values (?, ?, ?, ?, ?, ?, ?)
 on conflict (id) do update
     set course_id = excluded.course_id,
         section_idx = excluded.section_idx,
         resource_idx = excluded.resource_idx,
         name = excluded.name,
-        content_html = excluded.content_html
+        content_html = excluded.content_html,
+        content_hash = excluded.content_hash,
+        deleted_at = null
 `
 
 type NoteChapterParams struct {
@@ -260,6 +760,7 @@ type NoteChapterParams struct {
 	ID          int64
 	Name        string
 	ContentHtml string
+	ContentHash string
 }
 
 func (q *Queries) NoteChapter(ctx context.Context, arg NoteChapterParams) error {
@@ -270,32 +771,70 @@ func (q *Queries) NoteChapter(ctx context.Context, arg NoteChapterParams) error
 		arg.ID,
 		arg.Name,
 		arg.ContentHtml,
+		arg.ContentHash,
 	)
 	return err
 }
 
 const noteCourse = `-- name: NoteCourse :exec
-insert into Course(id, name) values (?, ?)
+insert into Course(id, name, content_hash) values (?, ?, ?)
 on conflict (id) do update
-    set name = excluded.name
+    set name = excluded.name,
+        content_hash = excluded.content_hash,
+        deleted_at = null
 `
 
 type NoteCourseParams struct {
-	ID   int64
-	Name string
+	ID          int64
+	Name        string
+	ContentHash string
 }
 
 func (q *Queries) NoteCourse(ctx context.Context, arg NoteCourseParams) error {
-	_, err := q.db.ExecContext(ctx, noteCourse, arg.ID, arg.Name)
+	_, err := q.db.ExecContext(ctx, noteCourse, arg.ID, arg.Name, arg.ContentHash)
+	return err
+}
+
+const noteFileMirror = `-- name: NoteFileMirror :exec
+insert into FileMirror(url, hash, size, content_type, filename, mirrored_at) values (?, ?, ?, ?, ?, ?)
+on conflict (url) do update
+    set hash = excluded.hash,
+        size = excluded.size,
+        content_type = excluded.content_type,
+        filename = excluded.filename,
+        mirrored_at = excluded.mirrored_at
+`
+
+type NoteFileMirrorParams struct {
+	Url         string
+	Hash        string
+	Size        int64
+	ContentType string
+	Filename    string
+	MirroredAt  int64
+}
+
+func (q *Queries) NoteFileMirror(ctx context.Context, arg NoteFileMirrorParams) error {
+	_, err := q.db.ExecContext(ctx, noteFileMirror,
+		arg.Url,
+		arg.Hash,
+		arg.Size,
+		arg.ContentType,
+		arg.Filename,
+		arg.MirroredAt,
+	)
 	return err
 }
 
 const noteResource = `-- name: NoteResource :exec
-insert into Resource(course_id, section_idx, idx, id, type, url, display_content) values (?, ?, ?, ?, ?, ?, ?)
+insert into Resource(course_id, section_idx, idx, id, type, url, display_content, content_hash) values (?, ?, ?, ?, ?, ?, ?, ?)
 on conflict (course_id, section_idx, idx) do update
-    set type = excluded.type,
+    set id = excluded.id,
+        type = excluded.type,
         url = excluded.url,
-        display_content = excluded.display_content
+        display_content = excluded.display_content,
+        content_hash = excluded.content_hash,
+        deleted_at = null
 `
 
 type NoteResourceParams struct {
@@ -306,6 +845,7 @@ type NoteResourceParams struct {
 	Type           int64
 	Url            string
 	DisplayContent string
+	ContentHash    string
 }
 
 func (q *Queries) NoteResource(ctx context.Context, arg NoteResourceParams) error {
@@ -317,23 +857,222 @@ func (q *Queries) NoteResource(ctx context.Context, arg NoteResourceParams) erro
 		arg.Type,
 		arg.Url,
 		arg.DisplayContent,
+		arg.ContentHash,
+	)
+	return err
+}
+
+const noteSearchEntry = `-- name: NoteSearchEntry :exec
+insert into SearchIndex(kind, course_id, section_idx, resource_idx, chapter_id, url, title, content) values (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type NoteSearchEntryParams struct {
+	Kind        interface{}
+	CourseID    interface{}
+	SectionIdx  interface{}
+	ResourceIdx interface{}
+	ChapterID   interface{}
+	Url         interface{}
+	Title       string
+	Content     string
+}
+
+func (q *Queries) NoteSearchEntry(ctx context.Context, arg NoteSearchEntryParams) error {
+	_, err := q.db.ExecContext(ctx, noteSearchEntry,
+		arg.Kind,
+		arg.CourseID,
+		arg.SectionIdx,
+		arg.ResourceIdx,
+		arg.ChapterID,
+		arg.Url,
+		arg.Title,
+		arg.Content,
 	)
 	return err
 }
 
 const noteSection = `-- name: NoteSection :exec
-insert into Section(course_id, idx, name) values (?, ?, ?)
+insert into Section(course_id, idx, name, content_hash) values (?, ?, ?, ?)
 on conflict (course_id, idx) do update
-    set name = excluded.name
+    set name = excluded.name,
+        content_hash = excluded.content_hash,
+        deleted_at = null
 `
 
 type NoteSectionParams struct {
-	CourseID int64
-	Idx      int64
-	Name     string
+	CourseID    int64
+	Idx         int64
+	Name        string
+	ContentHash string
 }
 
 func (q *Queries) NoteSection(ctx context.Context, arg NoteSectionParams) error {
-	_, err := q.db.ExecContext(ctx, noteSection, arg.CourseID, arg.Idx, arg.Name)
+	_, err := q.db.ExecContext(ctx, noteSection,
+		arg.CourseID,
+		arg.Idx,
+		arg.Name,
+		arg.ContentHash,
+	)
+	return err
+}
+
+const noteUserCourse = `-- name: NoteUserCourse :exec
+insert into UserCourse(email, course_id) values (?, ?)
+on conflict do nothing
+`
+
+type NoteUserCourseParams struct {
+	Email    string
+	CourseID int64
+}
+
+func (q *Queries) NoteUserCourse(ctx context.Context, arg NoteUserCourseParams) error {
+	_, err := q.db.ExecContext(ctx, noteUserCourse, arg.Email, arg.CourseID)
+	return err
+}
+
+const searchContent = `-- name: SearchContent :many
+select
+    cast(kind as integer) as kind,
+    cast(course_id as integer) as course_id,
+    cast(section_idx as integer) as section_idx,
+    cast(resource_idx as integer) as resource_idx,
+    cast(chapter_id as integer) as chapter_id,
+    cast(url as text) as url,
+    cast(title as text) as title,
+    cast(snippet(SearchIndex, 7, char(2), char(3), '...', 16) as text) as snippet,
+    cast(bm25(SearchIndex, 0, 0, 0, 0, 0, 0, 10, 1) as real) as rank
+from SearchIndex
+where SearchIndex match ? and course_id in (/*SLICE:course_ids*/?)
+order by rank
+limit ?
+`
+
+type SearchContentParams struct {
+	Query     string
+	CourseIds []int64
+	Limit     int64
+}
+
+type SearchContentRow struct {
+	Kind        int64
+	CourseID    int64
+	SectionIdx  int64
+	ResourceIdx int64
+	ChapterID   int64
+	Url         string
+	Title       string
+	Snippet     string
+	Rank        float64
+}
+
+// title matches are weighted more heavily than content matches, lower
+// ranks are better matches
+func (q *Queries) SearchContent(ctx context.Context, arg SearchContentParams) ([]SearchContentRow, error) {
+	query := searchContent
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	if len(arg.CourseIds) > 0 {
+		for _, v := range arg.CourseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:course_ids*/?", strings.Repeat(",?", len(arg.CourseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:course_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchContentRow
+	for rows.Next() {
+		var i SearchContentRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.CourseID,
+			&i.SectionIdx,
+			&i.ResourceIdx,
+			&i.ChapterID,
+			&i.Url,
+			&i.Title,
+			&i.Snippet,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteChapter = `-- name: SoftDeleteChapter :exec
+update Chapter set deleted_at = ? where id = ?
+`
+
+type SoftDeleteChapterParams struct {
+	DeletedAt sql.NullInt64
+	ID        int64
+}
+
+func (q *Queries) SoftDeleteChapter(ctx context.Context, arg SoftDeleteChapterParams) error {
+	_, err := q.db.ExecContext(ctx, softDeleteChapter, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const softDeleteCourse = `-- name: SoftDeleteCourse :exec
+update Course set deleted_at = ? where id = ?
+`
+
+type SoftDeleteCourseParams struct {
+	DeletedAt sql.NullInt64
+	ID        int64
+}
+
+func (q *Queries) SoftDeleteCourse(ctx context.Context, arg SoftDeleteCourseParams) error {
+	_, err := q.db.ExecContext(ctx, softDeleteCourse, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const softDeleteResource = `-- name: SoftDeleteResource :exec
+update Resource set deleted_at = ? where course_id = ? and section_idx = ? and idx = ?
+`
+
+type SoftDeleteResourceParams struct {
+	DeletedAt  sql.NullInt64
+	CourseID   int64
+	SectionIdx int64
+	Idx        int64
+}
+
+func (q *Queries) SoftDeleteResource(ctx context.Context, arg SoftDeleteResourceParams) error {
+	_, err := q.db.ExecContext(ctx, softDeleteResource,
+		arg.DeletedAt,
+		arg.CourseID,
+		arg.SectionIdx,
+		arg.Idx,
+	)
+	return err
+}
+
+const softDeleteSection = `-- name: SoftDeleteSection :exec
+update Section set deleted_at = ? where course_id = ? and idx = ?
+`
+
+type SoftDeleteSectionParams struct {
+	DeletedAt sql.NullInt64
+	CourseID  int64
+	Idx       int64
+}
+
+func (q *Queries) SoftDeleteSection(ctx context.Context, arg SoftDeleteSectionParams) error {
+	_, err := q.db.ExecContext(ctx, softDeleteSection, arg.DeletedAt, arg.CourseID, arg.Idx)
 	return err
 }
@@ -5,6 +5,13 @@ import _ "embed"
 //go:embed schema.sql
 var Schema string
 
+//go:embed search.sql
+var SearchSchema string
+
+// SearchTables matches the table in SearchSchema and its fts5 shadow
+// tables, migrations of Schema must exclude them
+const SearchTables = "SearchIndex*"
+
 type ResourceType int64
 
 const (
@@ -13,3 +20,19 @@ const (
 	RESOURCE_BOOK
 	RESOURCE_HTML_AREA
 )
+
+type SearchKind int64
+
+const (
+	SEARCH_SECTION SearchKind = iota
+	SEARCH_RESOURCE
+	SEARCH_CHAPTER
+)
+
+type ContentChangeType int64
+
+const (
+	CONTENT_ADDED ContentChangeType = iota
+	CONTENT_CHANGED
+	CONTENT_REMOVED
+)
@@ -1,6 +1,14 @@
+-- Course, Section, Resource and Chapter have the following columns:
+-- - content_hash: a hash of the scraped content, rows are only rewritten
+--   when this changes
+-- - deleted_at: the time the row was no longer found in a scrape, this is
+--   null if the row still exists
+
 create table Course (
     id integer not null primary key,
-    name text not null
+    name text not null,
+    content_hash text not null default '',
+    deleted_at integer
 );
 
 create table Section (
@@ -8,6 +16,9 @@ create table Section (
     idx integer not null,
     name text not null,
 
+    content_hash text not null default '',
+    deleted_at integer,
+
     primary key (course_id, idx),
     foreign key (course_id) references Course(id)
 );
@@ -34,6 +45,9 @@ create table Resource (
     -- this will be the html for html areas
     display_content text not null,
 
+    content_hash text not null default '',
+    deleted_at integer,
+
     primary key (course_id, section_idx, idx),
     foreign key (course_id, section_idx) references Section(course_id, idx),
     foreign key (course_id) references Course(id)
@@ -48,6 +62,76 @@ create table Chapter (
     name text not null,
     content_html text not null,
 
+    content_hash text not null default '',
+    deleted_at integer,
+
     foreign key (course_id, section_idx, resource_idx) references Resource(course_id, section_idx, idx)
 );
 
+-- the history of sections, resources and chapters across scrapes, a row
+-- is added every time one is added, changed or removed
+create table ContentChange (
+    id integer not null primary key autoincrement,
+    time integer not null,
+    -- 0: added
+    -- 1: changed
+    -- 2: removed
+    type integer not null,
+    -- this is the same as SearchIndex.kind
+    kind integer not null,
+    course_id integer not null,
+    section_idx integer not null,
+    resource_idx integer not null,
+    chapter_id integer not null,
+    name text not null,
+    -- this will be empty for removed content
+    url text not null,
+    -- the html of chapters and html areas after and before the change
+    content text not null,
+    previous_content text not null
+);
+
+create index ContentChange_course_time on ContentChange(course_id, time);
+
+-- a record of every scrape, a scrape is only committed if it passes a
+-- sanity check against the last committed scrape
+create table ScrapeRun (
+    id integer not null primary key autoincrement,
+    start integer not null,
+    -- in milliseconds
+    duration integer not null,
+    -- the number of rows found in the scrape
+    courses integer not null,
+    sections integer not null,
+    resources integer not null,
+    chapters integer not null,
+    -- the number of rows that were new or had different content
+    changed integer not null,
+    -- the number of rows that were soft deleted
+    deleted integer not null,
+    errors integer not null,
+    committed boolean not null,
+    -- the reason the scrape was not committed
+    reason text not null
+);
+
+-- files that have been mirrored into the blob store, keyed by the url of
+-- the file resource
+create table FileMirror (
+    url text not null primary key,
+    -- the sha256 hash of the file, this is also the key of the file in
+    -- the blob store
+    hash text not null,
+    size integer not null,
+    content_type text not null,
+    filename text not null,
+    mirrored_at integer not null
+);
+
+-- the last known list of courses a user is enrolled in, this is used
+-- as a fallback when logging into moodle on behalf of the user fails
+create table UserCourse (
+    email text not null,
+    course_id integer not null,
+    primary key (email, course_id)
+);
@@ -0,0 +1,23 @@
+-- a full-text index over the names and text content of the scraped
+-- sections, resources and chapters, entries are replaced whenever their
+-- row changes
+--
+-- this is kept out of schema.sql because atlas can't migrate virtual
+-- tables or the shadow tables sqlite creates for them, it is created
+-- after migrations instead and migrations are told to leave it alone
+create virtual table if not exists SearchIndex using fts5(
+    -- 0: section
+    -- 1: resource
+    -- 2: chapter
+    kind unindexed,
+    course_id unindexed,
+    section_idx unindexed,
+    -- this will be 0 for sections
+    resource_idx unindexed,
+    -- this will be 0 for sections and resources
+    chapter_id unindexed,
+    url unindexed,
+    title,
+    content,
+    tokenize = 'porter unicode61'
+);
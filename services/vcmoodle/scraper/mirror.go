@@ -0,0 +1,160 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"time"
+	"vcassist-backend/lib/blobstore"
+	"vcassist-backend/lib/scrapers/moodle/core"
+	"vcassist-backend/services/vcmoodle/db"
+)
+
+// the maximum number of files that are downloaded at once while mirroring
+const maxConcurrentDownloads = 4
+
+// unreferenced blobs younger than this are kept when pruning the mirror,
+// they may have been written by a scrape that hasn't committed yet
+const mirrorPruneGracePeriod = 6 * time.Hour
+
+// FileInfo returns the filename and content type of a downloaded file,
+// sniffed is the content type detected from the contents of the file and
+// is only used when the response and the filename don't say what it is.
+func FileInfo(res *http.Response, sniffed string) (filename, contentType string) {
+	_, params, err := mime.ParseMediaType(res.Header.Get("content-disposition"))
+	if err == nil {
+		filename = params["filename"]
+	}
+	if filename == "" && res.Request != nil {
+		filename = path.Base(res.Request.URL.Path)
+	}
+	if filename == "/" || filename == "." {
+		filename = ""
+	}
+
+	contentType = res.Header.Get("content-type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "" || mediaType == "application/octet-stream" {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	if contentType == "" {
+		contentType = sniffed
+	}
+
+	return filename, contentType
+}
+
+// mirrorFile downloads the file at url into the blob store if it hasn't
+// been mirrored already or if its resource has changed since it was.
+func (s scraper) mirrorFile(ctx context.Context, url string, changed bool) {
+	if s.mirror == nil || url == "" {
+		return
+	}
+
+	if !changed {
+		existing, err := s.qry.GetFileMirror(ctx, url)
+		if err == nil && s.mirror.Has(existing.Hash) {
+			return
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			s.run.noteError()
+			slog.WarnContext(ctx, "failed to get file mirror", "url", url, "err", err)
+			return
+		}
+	}
+
+	select {
+	case s.downloads <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-s.downloads }()
+
+	err := s.downloadFile(ctx, url)
+	if errors.Is(err, blobstore.ErrTooLarge) {
+		slog.DebugContext(ctx, "file is too large to mirror", "url", url)
+		return
+	}
+	if errors.Is(err, core.ResourceUnavailable) {
+		slog.DebugContext(ctx, "file is not accessible to the scraper", "url", url)
+		return
+	}
+	if err != nil {
+		s.run.noteError()
+		slog.WarnContext(ctx, "failed to mirror file", "url", url, "err", err)
+	}
+}
+
+func (s scraper) downloadFile(ctx context.Context, url string) error {
+	res, err := s.client.Core.Http.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(url)
+	if err != nil {
+		return err
+	}
+	body := res.RawBody()
+	defer body.Close()
+
+	err = s.client.Core.CheckLoginRedirect(res)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode())
+	}
+
+	blob, err := s.mirror.Put(body)
+	if err != nil {
+		return err
+	}
+	filename, contentType := FileInfo(res.RawResponse, blob.ContentType)
+
+	return s.qry.NoteFileMirror(ctx, db.NoteFileMirrorParams{
+		Url:         url,
+		Hash:        blob.Hash,
+		Size:        blob.Size,
+		ContentType: contentType,
+		Filename:    filename,
+		MirroredAt:  s.run.now,
+	})
+}
+
+// pruneMirror deletes the blobs in the mirror that no FileMirror row
+// references, these are left behind by scrapes that were rolled back and
+// by files that were replaced with new contents.
+func pruneMirror(ctx context.Context, qry *db.Queries, mirror *blobstore.Store, now time.Time) (int, error) {
+	hashes, err := qry.GetFileMirrorHashes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	referenced := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		referenced[hash] = struct{}{}
+	}
+
+	pruned := 0
+	err = mirror.Walk(func(hash string, modTime time.Time) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, ok := referenced[hash]; ok {
+			return nil
+		}
+		if now.Sub(modTime) < mirrorPruneGracePeriod {
+			return nil
+		}
+		err := mirror.Delete(hash)
+		if err != nil {
+			return fmt.Errorf("delete blob %s: %w", hash, err)
+		}
+		pruned++
+		return nil
+	})
+	return pruned, err
+}
@@ -0,0 +1,426 @@
+package scraper
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"vcassist-backend/services/vcmoodle/db"
+)
+
+type sectionKey struct {
+	courseId int64
+	idx      int64
+}
+
+type resourceKey struct {
+	courseId   int64
+	sectionIdx int64
+	idx        int64
+}
+
+type chapterRow struct {
+	resource resourceKey
+	hash     string
+}
+
+// run keeps track of the rows that existed before a scrape and the rows
+// that were found during it so that only changed rows are rewritten and
+// rows that no longer exist can be soft deleted.
+type run struct {
+	lock sync.Mutex
+
+	courses   map[int64]string
+	sections  map[sectionKey]string
+	resources map[resourceKey]string
+	chapters  map[int64]chapterRow
+
+	seenCourses   map[int64]bool
+	seenSections  map[sectionKey]bool
+	seenResources map[resourceKey]bool
+	seenChapters  map[int64]bool
+
+	// the children of these rows could not be fetched, so they are
+	// left as they are instead of being soft deleted
+	keepCourses   map[int64]bool
+	keepSections  map[sectionKey]bool
+	keepResources map[resourceKey]bool
+	keepChapters  map[int64]bool
+
+	// changes are not recorded on the first scrape since everything
+	// would be recorded as added
+	recordChanges bool
+	now           int64
+
+	changed int64
+	errors  int64
+}
+
+func loadRun(ctx context.Context, qry *db.Queries) (*run, error) {
+	r := &run{
+		courses:       make(map[int64]string),
+		sections:      make(map[sectionKey]string),
+		resources:     make(map[resourceKey]string),
+		chapters:      make(map[int64]chapterRow),
+		seenCourses:   make(map[int64]bool),
+		seenSections:  make(map[sectionKey]bool),
+		seenResources: make(map[resourceKey]bool),
+		seenChapters:  make(map[int64]bool),
+		keepCourses:   make(map[int64]bool),
+		keepSections:  make(map[sectionKey]bool),
+		keepResources: make(map[resourceKey]bool),
+		keepChapters:  make(map[int64]bool),
+	}
+
+	courses, err := qry.GetCourseHashes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get course hashes: %w", err)
+	}
+	for _, c := range courses {
+		r.courses[c.ID] = c.ContentHash
+	}
+	sections, err := qry.GetSectionHashes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get section hashes: %w", err)
+	}
+	for _, s := range sections {
+		r.sections[sectionKey{courseId: s.CourseID, idx: s.Idx}] = s.ContentHash
+	}
+	resources, err := qry.GetResourceHashes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get resource hashes: %w", err)
+	}
+	for _, res := range resources {
+		key := resourceKey{courseId: res.CourseID, sectionIdx: res.SectionIdx, idx: res.Idx}
+		r.resources[key] = res.ContentHash
+	}
+	chapters, err := qry.GetChapterHashes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chapter hashes: %w", err)
+	}
+	for _, c := range chapters {
+		r.chapters[c.ID] = chapterRow{
+			resource: resourceKey{courseId: c.CourseID, sectionIdx: c.SectionIdx, idx: c.ResourceIdx},
+			hash:     c.ContentHash,
+		}
+	}
+
+	return r, nil
+}
+
+func contentHash(fields ...string) string {
+	hash := sha256.New()
+	for _, f := range fields {
+		hash.Write([]byte(f))
+		// separate fields so that ("ab", "c") and ("a", "bc") don't collide
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+// the note* methods mark a row as seen and return true if it is new or
+// its content has changed since the last scrape
+
+func (r *run) noteCourse(id int64, hash string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.seenCourses[id] = true
+	return r.noteChange(r.courses[id] != hash)
+}
+
+func (r *run) noteSection(key sectionKey, hash string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.seenSections[key] = true
+	return r.noteChange(r.sections[key] != hash)
+}
+
+func (r *run) noteResource(key resourceKey, hash string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.seenResources[key] = true
+	return r.noteChange(r.resources[key] != hash)
+}
+
+func (r *run) noteChapter(id int64, hash string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.seenChapters[id] = true
+	return r.noteChange(r.chapters[id].hash != hash)
+}
+
+func (r *run) hasSection(key sectionKey) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, ok := r.sections[key]
+	return ok
+}
+
+func (r *run) hasResource(key resourceKey) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, ok := r.resources[key]
+	return ok
+}
+
+// chapterResource returns the resource a chapter belonged to before the
+// scrape, chapters are keyed by id so they may move between resources
+func (r *run) chapterResource(id int64) (resourceKey, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	row, ok := r.chapters[id]
+	return row.resource, ok
+}
+
+func (r *run) noteChange(changed bool) bool {
+	if changed {
+		r.changed++
+	}
+	return changed
+}
+
+func (r *run) keepCourse(id int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keepCourses[id] = true
+}
+
+func (r *run) keepSection(key sectionKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keepSections[key] = true
+}
+
+func (r *run) keepResource(key resourceKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keepResources[key] = true
+}
+
+func (r *run) keepChapter(id int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keepChapters[id] = true
+}
+
+func (r *run) noteError() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.errors++
+}
+
+func (r *run) keptResource(key resourceKey) bool {
+	return r.keepCourses[key.courseId] ||
+		r.keepSections[sectionKey{courseId: key.courseId, idx: key.sectionIdx}] ||
+		r.keepResources[key]
+}
+
+// deleteUnseen soft deletes all the rows that existed before the scrape
+// but were not found during it, it returns the number of deleted rows.
+func (r *run) deleteUnseen(ctx context.Context, qry *db.Queries, now int64) (int64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	deletedAt := sql.NullInt64{Int64: now, Valid: true}
+	var deleted int64
+
+	for id := range r.chapters {
+		row := r.chapters[id]
+		if r.seenChapters[id] || r.keepChapters[id] || r.keptResource(row.resource) {
+			continue
+		}
+		if r.recordChanges {
+			chapter, err := qry.GetChapter(ctx, id)
+			if err != nil {
+				return deleted, err
+			}
+			err = r.recordRemoval(ctx, qry, db.CreateContentChangeParams{
+				Kind:            int64(db.SEARCH_CHAPTER),
+				CourseID:        chapter.CourseID,
+				SectionIdx:      chapter.SectionIdx,
+				ResourceIdx:     chapter.ResourceIdx,
+				ChapterID:       chapter.ID,
+				Name:            chapter.Name,
+				PreviousContent: chapter.ContentHtml,
+			})
+			if err != nil {
+				return deleted, err
+			}
+		}
+		err := qry.SoftDeleteChapter(ctx, db.SoftDeleteChapterParams{
+			DeletedAt: deletedAt,
+			ID:        id,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		err = qry.DeleteSearchEntry(ctx, db.DeleteSearchEntryParams{
+			Kind:        int64(db.SEARCH_CHAPTER),
+			CourseID:    row.resource.courseId,
+			SectionIdx:  row.resource.sectionIdx,
+			ResourceIdx: row.resource.idx,
+			ChapterID:   id,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	for key := range r.resources {
+		if r.seenResources[key] || r.keptResource(key) {
+			continue
+		}
+		if r.recordChanges {
+			resource, err := qry.GetResource(ctx, db.GetResourceParams{
+				CourseID:   key.courseId,
+				SectionIdx: key.sectionIdx,
+				Idx:        key.idx,
+			})
+			if err != nil {
+				return deleted, err
+			}
+			name, content := resourceContent(db.ResourceType(resource.Type), resource.DisplayContent)
+			err = r.recordRemoval(ctx, qry, db.CreateContentChangeParams{
+				Kind:            int64(db.SEARCH_RESOURCE),
+				CourseID:        key.courseId,
+				SectionIdx:      key.sectionIdx,
+				ResourceIdx:     key.idx,
+				Name:            name,
+				PreviousContent: content,
+			})
+			if err != nil {
+				return deleted, err
+			}
+		}
+		err := qry.SoftDeleteResource(ctx, db.SoftDeleteResourceParams{
+			DeletedAt:  deletedAt,
+			CourseID:   key.courseId,
+			SectionIdx: key.sectionIdx,
+			Idx:        key.idx,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		err = qry.DeleteSearchEntry(ctx, db.DeleteSearchEntryParams{
+			Kind:        int64(db.SEARCH_RESOURCE),
+			CourseID:    key.courseId,
+			SectionIdx:  key.sectionIdx,
+			ResourceIdx: key.idx,
+			ChapterID:   0,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	for key := range r.sections {
+		if r.seenSections[key] || r.keepCourses[key.courseId] || r.keepSections[key] {
+			continue
+		}
+		if r.recordChanges {
+			section, err := qry.GetSection(ctx, db.GetSectionParams{
+				CourseID: key.courseId,
+				Idx:      key.idx,
+			})
+			if err != nil {
+				return deleted, err
+			}
+			err = r.recordRemoval(ctx, qry, db.CreateContentChangeParams{
+				Kind:       int64(db.SEARCH_SECTION),
+				CourseID:   key.courseId,
+				SectionIdx: key.idx,
+				Name:       section.Name,
+			})
+			if err != nil {
+				return deleted, err
+			}
+		}
+		err := qry.SoftDeleteSection(ctx, db.SoftDeleteSectionParams{
+			DeletedAt: deletedAt,
+			CourseID:  key.courseId,
+			Idx:       key.idx,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		err = qry.DeleteSearchEntry(ctx, db.DeleteSearchEntryParams{
+			Kind:        int64(db.SEARCH_SECTION),
+			CourseID:    key.courseId,
+			SectionIdx:  key.idx,
+			ResourceIdx: 0,
+			ChapterID:   0,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	for id := range r.courses {
+		if r.seenCourses[id] || r.keepCourses[id] {
+			continue
+		}
+		err := qry.SoftDeleteCourse(ctx, db.SoftDeleteCourseParams{
+			DeletedAt: deletedAt,
+			ID:        id,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (r *run) recordRemoval(ctx context.Context, qry *db.Queries, change db.CreateContentChangeParams) error {
+	change.Time = r.now
+	change.Type = int64(db.CONTENT_REMOVED)
+	return qry.CreateContentChange(ctx, change)
+}
+
+// resourceContent returns the name and the html content of a resource
+// for the content history, html areas don't have a name and other
+// resources don't have any html content
+func resourceContent(resourceType db.ResourceType, displayContent string) (string, string) {
+	if resourceType == db.RESOURCE_HTML_AREA {
+		return "", displayContent
+	}
+	return displayContent, ""
+}
+
+// minRetained is the fraction of the courses and chapters from the last
+// committed scrape that a scrape must find to be committed, this prevents
+// a partially failed scrape from soft deleting most of the content
+const minRetained = 0.5
+
+func sanityCheck(prev *db.ScrapeRun, current db.CreateScrapeRunParams) error {
+	if current.Courses == 0 {
+		return fmt.Errorf("no courses were found")
+	}
+	if prev == nil {
+		return nil
+	}
+	if float64(current.Courses) < float64(prev.Courses)*minRetained {
+		return fmt.Errorf(
+			"only %d courses were found, the last scrape found %d",
+			current.Courses, prev.Courses,
+		)
+	}
+	if float64(current.Chapters) < float64(prev.Chapters)*minRetained {
+		return fmt.Errorf(
+			"only %d chapters were found, the last scrape found %d",
+			current.Chapters, prev.Chapters,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/blobstore"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+func seedCourse(t testing.TB, ctx context.Context, qry *db.Queries, id, chapterId int64) {
+	err := qry.NoteCourse(ctx, db.NoteCourseParams{
+		ID:          id,
+		Name:        "course",
+		ContentHash: "course",
+	})
+	require.NoError(t, err)
+	err = qry.NoteSection(ctx, db.NoteSectionParams{
+		CourseID:    id,
+		Idx:         0,
+		Name:        "section",
+		ContentHash: "section",
+	})
+	require.NoError(t, err)
+	err = qry.NoteResource(ctx, db.NoteResourceParams{
+		CourseID:       id,
+		SectionIdx:     0,
+		Idx:            0,
+		Type:           int64(db.RESOURCE_BOOK),
+		DisplayContent: "book",
+		ContentHash:    "resource",
+	})
+	require.NoError(t, err)
+	err = qry.NoteChapter(ctx, db.NoteChapterParams{
+		CourseID:    id,
+		SectionIdx:  0,
+		ResourceIdx: 0,
+		ID:          chapterId,
+		Name:        "chapter",
+		ContentHtml: "<p>homework</p>",
+		ContentHash: "chapter",
+	})
+	require.NoError(t, err)
+	err = qry.NoteSearchEntry(ctx, db.NoteSearchEntryParams{
+		Kind:        int64(db.SEARCH_CHAPTER),
+		CourseID:    id,
+		SectionIdx:  0,
+		ResourceIdx: 0,
+		ChapterID:   chapterId,
+		Url:         "",
+		Title:       "chapter",
+		Content:     "homework",
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteUnseen(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	qry := db.New(sqlite)
+	seedCourse(t, ctx, qry, 1, 10)
+	seedCourse(t, ctx, qry, 2, 20)
+
+	r, err := loadRun(ctx, qry)
+	require.NoError(t, err)
+
+	// course 1 is still there but its book could not be fetched, course 2
+	// is gone entirely
+	require.False(t, r.noteCourse(1, "course"))
+	require.False(t, r.noteSection(sectionKey{courseId: 1, idx: 0}, "section"))
+	require.True(t, r.noteResource(resourceKey{courseId: 1, sectionIdx: 0, idx: 0}, "changed"))
+	r.keepResource(resourceKey{courseId: 1, sectionIdx: 0, idx: 0})
+	require.Equal(t, int64(1), r.changed)
+
+	r.recordChanges = true
+	r.now = 100
+	deleted, err := r.deleteUnseen(ctx, qry, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), deleted)
+
+	changes, err := qry.GetContentChanges(ctx, db.GetContentChangesParams{
+		Time:      0,
+		CourseIds: []int64{1, 2},
+	})
+	require.NoError(t, err)
+	// courses are not part of the content history
+	require.Len(t, changes, 3)
+	for _, c := range changes {
+		require.Equal(t, int64(db.CONTENT_REMOVED), c.Type)
+		require.Equal(t, int64(2), c.CourseID)
+		if c.Kind == int64(db.SEARCH_CHAPTER) {
+			require.Equal(t, "<p>homework</p>", c.PreviousContent)
+		}
+	}
+
+	courses, err := qry.GetAllCourses(ctx)
+	require.NoError(t, err)
+	require.Len(t, courses, 1)
+	require.Equal(t, int64(1), courses[0].ID)
+
+	chapters, err := qry.GetResourceChapters(ctx, db.GetResourceChaptersParams{
+		CourseID: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, chapters, 1)
+	_, err = qry.GetChapterContent(ctx, 20)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	results, err := qry.SearchContent(ctx, db.SearchContentParams{
+		Query:     "homework",
+		CourseIds: []int64{1, 2},
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, int64(10), results[0].ChapterID)
+
+	// soft deleted rows are treated as new if they show up again
+	r, err = loadRun(ctx, qry)
+	require.NoError(t, err)
+	require.True(t, r.noteCourse(2, "course"))
+}
+
+func TestSanityCheck(t *testing.T) {
+	prev := &db.ScrapeRun{Courses: 10, Chapters: 1000}
+
+	require.Error(t, sanityCheck(nil, db.CreateScrapeRunParams{}))
+	require.NoError(t, sanityCheck(nil, db.CreateScrapeRunParams{Courses: 1}))
+	require.NoError(t, sanityCheck(prev, db.CreateScrapeRunParams{Courses: 9, Chapters: 900}))
+	require.Error(t, sanityCheck(prev, db.CreateScrapeRunParams{Courses: 4, Chapters: 1000}))
+	require.Error(t, sanityCheck(prev, db.CreateScrapeRunParams{Courses: 10, Chapters: 10}))
+}
+
+func TestPruneMirror(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	qry := db.New(sqlite)
+	mirror, err := blobstore.Open(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	referenced, err := mirror.Put(strings.NewReader("referenced"))
+	require.NoError(t, err)
+	orphaned, err := mirror.Put(strings.NewReader("orphaned"))
+	require.NoError(t, err)
+	err = qry.NoteFileMirror(ctx, db.NoteFileMirrorParams{
+		Url:         "https://example.com/file.pdf",
+		Hash:        referenced.Hash,
+		Size:        referenced.Size,
+		ContentType: referenced.ContentType,
+		Filename:    "file.pdf",
+		MirroredAt:  1,
+	})
+	require.NoError(t, err)
+
+	// the orphan may still belong to a scrape that hasn't committed
+	pruned, err := pruneMirror(ctx, qry, &mirror, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 0, pruned)
+	require.True(t, mirror.Has(orphaned.Hash))
+
+	pruned, err = pruneMirror(ctx, qry, &mirror, time.Now().Add(mirrorPruneGracePeriod+time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+	require.True(t, mirror.Has(referenced.Hash))
+	require.False(t, mirror.Has(orphaned.Hash))
+}
@@ -6,6 +6,8 @@ import (
 	"log/slog"
 	"strconv"
 	"sync"
+	"time"
+	"vcassist-backend/lib/blobstore"
 	"vcassist-backend/lib/scrapers/moodle/view"
 	"vcassist-backend/services/vcmoodle/db"
 )
@@ -14,23 +16,71 @@ type scraper struct {
 	client view.Client
 	qry    *db.Queries
 	wg     *sync.WaitGroup
+	run    *run
+	// this is nil if files are not being mirrored
+	mirror    *blobstore.Store
+	downloads chan struct{}
+}
+
+// index replaces the search index entry of a row that has changed
+func (s scraper) index(ctx context.Context, previous db.DeleteSearchEntryParams, entry db.NoteSearchEntryParams) {
+	err := s.qry.DeleteSearchEntry(ctx, previous)
+	if err == nil {
+		err = s.qry.NoteSearchEntry(ctx, entry)
+	}
+	if err != nil {
+		s.run.noteError()
+		slog.WarnContext(ctx, "failed to index search entry", "title", entry.Title, "err", err)
+	}
+}
+
+// recordChange adds a change to the content history
+func (s scraper) recordChange(ctx context.Context, change db.CreateContentChangeParams) {
+	if !s.run.recordChanges {
+		return
+	}
+	change.Time = s.run.now
+	err := s.qry.CreateContentChange(ctx, change)
+	if err != nil {
+		s.run.noteError()
+		slog.WarnContext(ctx, "failed to record content change", "name", change.Name, "err", err)
+	}
 }
 
 func (s scraper) scrapeChapter(ctx context.Context, chapter view.Chapter, courseId, sectionIdx, resourceIdx int64) {
 	slog.DebugContext(ctx, "scraping chapter", "name", chapter.Name, "url", chapter.Url)
 
+	id, err := chapter.Id()
+	if err != nil {
+		s.run.noteError()
+		slog.WarnContext(ctx, "failed to parse chapter id", "id", id, "name", chapter.Name)
+		return
+	}
+
 	content, err := s.client.ChapterContent(ctx, chapter)
 	if err != nil || content == "" {
+		s.run.noteError()
+		s.run.keepChapter(id)
 		slog.WarnContext(ctx, "failed to get chapter content", "url", chapter.Url, "err", err)
 		return
 	}
 
-	id, err := chapter.Id()
-	if err != nil {
-		slog.WarnContext(ctx, "failed to parse chapter id", "id", id, "name", chapter.Name)
+	hash := contentHash(itoa(courseId), itoa(sectionIdx), itoa(resourceIdx), chapter.Name, content)
+	if !s.run.noteChapter(id, hash) {
 		return
 	}
 
+	previous, existed := s.run.chapterResource(id)
+	var previousRow db.Chapter
+	if existed && s.run.recordChanges {
+		previousRow, err = s.qry.GetChapter(ctx, id)
+		if err != nil {
+			s.run.noteError()
+			slog.WarnContext(ctx, "failed to get previous chapter", "id", id, "err", err)
+			return
+		}
+	}
+
 	err = s.qry.NoteChapter(ctx, db.NoteChapterParams{
 		CourseID:    courseId,
 		SectionIdx:  sectionIdx,
@@ -38,10 +88,53 @@ func (s scraper) scrapeChapter(ctx context.Context, chapter view.Chapter, course
 		ID:          id,
 		Name:        chapter.Name,
 		ContentHtml: content,
+		ContentHash: hash,
 	})
 	if err != nil {
+		s.run.noteError()
 		slog.WarnContext(ctx, "failed to note chapter", "err", err)
+		return
+	}
+
+	change := db.CreateContentChangeParams{
+		Type:            int64(db.CONTENT_ADDED),
+		Kind:            int64(db.SEARCH_CHAPTER),
+		CourseID:        courseId,
+		SectionIdx:      sectionIdx,
+		ResourceIdx:     resourceIdx,
+		ChapterID:       id,
+		Name:            chapter.Name,
+		Url:             chapter.Url.String(),
+		Content:         content,
+		PreviousContent: previousRow.ContentHtml,
+	}
+	if existed {
+		change.Type = int64(db.CONTENT_CHANGED)
 	}
+	// chapters that were only moved don't need to be recorded
+	if !existed || previousRow.Name != chapter.Name || previousRow.ContentHtml != content {
+		s.recordChange(ctx, change)
+	}
+
+	if !existed {
+		previous = resourceKey{courseId: courseId, sectionIdx: sectionIdx, idx: resourceIdx}
+	}
+	s.index(ctx, db.DeleteSearchEntryParams{
+		Kind:        int64(db.SEARCH_CHAPTER),
+		CourseID:    previous.courseId,
+		SectionIdx:  previous.sectionIdx,
+		ResourceIdx: previous.idx,
+		ChapterID:   id,
+	}, db.NoteSearchEntryParams{
+		Kind:        int64(db.SEARCH_CHAPTER),
+		CourseID:    courseId,
+		SectionIdx:  sectionIdx,
+		ResourceIdx: resourceIdx,
+		ChapterID:   id,
+		Url:         chapter.Url.String(),
+		Title:       chapter.Name,
+		Content:     htmlText(content),
+	})
 }
 
 func (s scraper) scrapeBook(ctx context.Context, resource view.Resource, courseId, sectionIdx, resourceIdx int64) {
@@ -49,6 +142,8 @@ func (s scraper) scrapeBook(ctx context.Context, resource view.Resource, courseI
 
 	chapterList, err := s.client.Chapters(ctx, resource)
 	if err != nil {
+		s.run.noteError()
+		s.run.keepResource(resourceKey{courseId: courseId, sectionIdx: sectionIdx, idx: resourceIdx})
 		slog.WarnContext(ctx, "failed to get chapters", "err", err)
 		return
 	}
@@ -124,26 +219,157 @@ func (s scraper) handleResource(ctx context.Context, resource view.Resource, res
 		return
 	}
 
+	params.ContentHash = contentHash(
+		itoa(params.ID.Int64),
+		itoa(params.Type),
+		params.Url,
+		params.DisplayContent,
+	)
+	key := resourceKey{courseId: courseId, sectionIdx: sectionIdx, idx: resourceIdx}
+	changed := s.run.noteResource(key, params.ContentHash)
+	if params.Type == int64(db.RESOURCE_FILE) {
+		s.mirrorFile(ctx, params.Url, changed)
+	}
+	if !changed {
+		return
+	}
+
+	existed := s.run.hasResource(key)
+	var previousRow db.Resource
+	if existed && s.run.recordChanges {
+		previousRow, err = s.qry.GetResource(ctx, db.GetResourceParams{
+			CourseID:   courseId,
+			SectionIdx: sectionIdx,
+			Idx:        resourceIdx,
+		})
+		if err != nil {
+			s.run.noteError()
+			slog.WarnContext(ctx, "failed to get previous resource", "idx", resourceIdx, "err", err)
+			return
+		}
+	}
+
 	err = s.qry.NoteResource(ctx, params)
 	if err != nil {
+		s.run.noteError()
 		slog.WarnContext(ctx, "failed to note resource", "err", err)
+		return
+	}
+
+	// resources are recorded only when what the user sees changes, not
+	// when ex. a workaround link fails to resolve
+	if !existed || previousRow.DisplayContent != params.DisplayContent {
+		name, content := resourceContent(db.ResourceType(params.Type), params.DisplayContent)
+		_, previousContent := resourceContent(db.ResourceType(previousRow.Type), previousRow.DisplayContent)
+		change := db.CreateContentChangeParams{
+			Type:            int64(db.CONTENT_ADDED),
+			Kind:            int64(db.SEARCH_RESOURCE),
+			CourseID:        courseId,
+			SectionIdx:      sectionIdx,
+			ResourceIdx:     resourceIdx,
+			Name:            name,
+			Url:             params.Url,
+			Content:         content,
+			PreviousContent: previousContent,
+		}
+		if existed {
+			change.Type = int64(db.CONTENT_CHANGED)
+		}
+		s.recordChange(ctx, change)
+	}
+
+	previous := db.DeleteSearchEntryParams{
+		Kind:        int64(db.SEARCH_RESOURCE),
+		CourseID:    courseId,
+		SectionIdx:  sectionIdx,
+		ResourceIdx: resourceIdx,
+		ChapterID:   0,
 	}
+	entry := db.NoteSearchEntryParams{
+		Kind:        int64(db.SEARCH_RESOURCE),
+		CourseID:    courseId,
+		SectionIdx:  sectionIdx,
+		ResourceIdx: resourceIdx,
+		ChapterID:   0,
+		Url:         params.Url,
+		Title:       resource.Name,
+	}
+	// html areas don't have a name, their display content is the html itself
+	if resource.Type == view.RESOURCE_HTML_AREA {
+		entry.Title = ""
+		entry.Content = htmlText(resource.Name)
+	}
+	s.index(ctx, previous, entry)
 }
 
 func (s scraper) scrapeSection(ctx context.Context, section view.Section, sectionIdx, courseId int64) error {
 	slog.DebugContext(ctx, "scraping section", "idx", sectionIdx, "course_id", courseId)
 
-	err := s.qry.NoteSection(ctx, db.NoteSectionParams{
-		CourseID: courseId,
-		Idx:      sectionIdx,
-		Name:     section.Name,
-	})
-	if err != nil {
-		return err
+	sectionUrl := ""
+	if section.Url != nil {
+		sectionUrl = section.Url.String()
+	}
+
+	key := sectionKey{courseId: courseId, idx: sectionIdx}
+	hash := contentHash(section.Name, sectionUrl)
+	if s.run.noteSection(key, hash) {
+		existed := s.run.hasSection(key)
+		var previousRow db.Section
+		if existed && s.run.recordChanges {
+			var err error
+			previousRow, err = s.qry.GetSection(ctx, db.GetSectionParams{
+				CourseID: courseId,
+				Idx:      sectionIdx,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := s.qry.NoteSection(ctx, db.NoteSectionParams{
+			CourseID:    courseId,
+			Idx:         sectionIdx,
+			Name:        section.Name,
+			ContentHash: hash,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !existed || previousRow.Name != section.Name {
+			change := db.CreateContentChangeParams{
+				Type:       int64(db.CONTENT_ADDED),
+				Kind:       int64(db.SEARCH_SECTION),
+				CourseID:   courseId,
+				SectionIdx: sectionIdx,
+				Name:       section.Name,
+				Url:        sectionUrl,
+			}
+			if existed {
+				change.Type = int64(db.CONTENT_CHANGED)
+			}
+			s.recordChange(ctx, change)
+		}
+		s.index(ctx, db.DeleteSearchEntryParams{
+			Kind:        int64(db.SEARCH_SECTION),
+			CourseID:    courseId,
+			SectionIdx:  sectionIdx,
+			ResourceIdx: 0,
+			ChapterID:   0,
+		}, db.NoteSearchEntryParams{
+			Kind:        int64(db.SEARCH_SECTION),
+			CourseID:    courseId,
+			SectionIdx:  sectionIdx,
+			ResourceIdx: 0,
+			ChapterID:   0,
+			Url:         sectionUrl,
+			Title:       section.Name,
+		})
 	}
 
 	resourceList, err := s.client.Resources(ctx, section)
 	if err != nil {
+		s.run.keepSection(key)
 		return err
 	}
 	for i, resource := range resourceList {
@@ -160,22 +386,30 @@ func (s scraper) scrapeSection(ctx context.Context, section view.Section, sectio
 func (s scraper) scrapeCourse(ctx context.Context, course view.Course) {
 	id, err := course.Id()
 	if err != nil {
+		s.run.noteError()
 		slog.WarnContext(ctx, "failed to parse course id", "id", id, "name", course.Name)
 		return
 	}
 	slog.DebugContext(ctx, "scraping course", "id", id, "name", course.Name)
 
-	err = s.qry.NoteCourse(ctx, db.NoteCourseParams{
-		ID:   id,
-		Name: course.Name,
-	})
-	if err != nil {
-		slog.WarnContext(ctx, "failed to note course", "err", err)
-		return
+	hash := contentHash(course.Name)
+	if s.run.noteCourse(id, hash) {
+		err = s.qry.NoteCourse(ctx, db.NoteCourseParams{
+			ID:          id,
+			Name:        course.Name,
+			ContentHash: hash,
+		})
+		if err != nil {
+			s.run.noteError()
+			slog.WarnContext(ctx, "failed to note course", "err", err)
+			return
+		}
 	}
 
 	sectionList, err := s.client.Sections(ctx, course)
 	if err != nil {
+		s.run.noteError()
+		s.run.keepCourse(id)
 		slog.WarnContext(ctx, "failed to get course sections", "err", err)
 		return
 	}
@@ -183,7 +417,11 @@ func (s scraper) scrapeCourse(ctx context.Context, course view.Course) {
 		s.wg.Add(1)
 		go func() {
 			defer s.wg.Done()
-			s.scrapeSection(ctx, section, int64(i), id)
+			err := s.scrapeSection(ctx, section, int64(i), id)
+			if err != nil {
+				s.run.noteError()
+				slog.WarnContext(ctx, "failed to scrape section", "idx", i, "course_id", id, "err", err)
+			}
 		}()
 	}
 }
@@ -193,6 +431,7 @@ func (s scraper) scrapeDashboard(ctx context.Context) {
 
 	courseList, err := s.client.Courses(ctx)
 	if err != nil {
+		s.run.noteError()
 		slog.WarnContext(ctx, "failed to get courses", "err", err)
 		return
 	}
@@ -205,43 +444,121 @@ func (s scraper) scrapeDashboard(ctx context.Context) {
 	}
 }
 
-func Scrape(ctx context.Context, out *sql.DB, client view.Client) {
+// how long the history of content changes is kept for
+const contentHistoryRetention = time.Hour * 24 * 365
+
+// Scrape scrapes all the courses visible to the client, only rows whose
+// content has changed are rewritten and rows that are no longer found are
+// soft deleted. the changes are only committed if the scrape passes a
+// sanity check against the last committed scrape, every scrape is
+// recorded as a ScrapeRun regardless. if mirror is not nil, file resources
+// are also downloaded into it.
+func Scrape(ctx context.Context, out *sql.DB, client view.Client, mirror *blobstore.Store) {
+	start := time.Now()
+
 	qry := db.New(out)
 	tx, err := out.BeginTx(ctx, nil)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create transaction", "err", err)
 		return
 	}
-	defer tx.Commit()
+	defer tx.Rollback()
 
 	txqry := qry.WithTx(tx)
 
-	err = txqry.DeleteAllChapters(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete all chapters", "err", err)
-		return
-	}
-	err = txqry.DeleteAllResources(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete all resources", "err", err)
-		return
-	}
-	err = txqry.DeleteAllSections(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete all sections", "err", err)
+	var prev *db.ScrapeRun
+	prevRun, err := txqry.GetLastCommittedScrapeRun(ctx)
+	if err == nil {
+		prev = &prevRun
+	} else if err != sql.ErrNoRows {
+		slog.ErrorContext(ctx, "failed to get last scrape run", "err", err)
 		return
 	}
-	err = txqry.DeleteAllCourses(ctx)
+
+	r, err := loadRun(ctx, txqry)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to delete all courses", "err", err)
+		slog.ErrorContext(ctx, "failed to load previous scrape", "err", err)
 		return
 	}
 
+	r.recordChanges = prev != nil
+	r.now = start.Unix()
+
 	s := scraper{
 		client: client,
 		qry:    txqry,
 		wg:     &sync.WaitGroup{},
+		run:    r,
+
+		mirror:    mirror,
+		downloads: make(chan struct{}, maxConcurrentDownloads),
 	}
 	s.scrapeDashboard(ctx)
 	s.wg.Wait()
+
+	record := db.CreateScrapeRunParams{
+		Start:     start.Unix(),
+		Courses:   int64(len(r.seenCourses)),
+		Sections:  int64(len(r.seenSections)),
+		Resources: int64(len(r.seenResources)),
+		Chapters:  int64(len(r.seenChapters)),
+		Changed:   r.changed,
+		Errors:    r.errors,
+	}
+
+	err = ctx.Err()
+	if err == nil {
+		err = sanityCheck(prev, record)
+	}
+	if err == nil {
+		record.Deleted, err = r.deleteUnseen(ctx, txqry, start.Unix())
+	}
+	if err == nil {
+		err = txqry.DeleteContentChangesBefore(ctx, start.Add(-contentHistoryRetention).Unix())
+	}
+	if err == nil {
+		record.Committed = true
+		record.Duration = time.Since(start).Milliseconds()
+		err = txqry.CreateScrapeRun(ctx, record)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		tx.Rollback()
+
+		record.Committed = false
+		record.Deleted = 0
+		record.Reason = err.Error()
+		record.Duration = time.Since(start).Milliseconds()
+		slog.ErrorContext(ctx, "moodle scrape was not committed", "reason", record.Reason)
+
+		// the scrape context may be what caused the failure
+		err = qry.CreateScrapeRun(context.WithoutCancel(ctx), record)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to record scrape run", "err", err)
+		}
+		return
+	}
+
+	slog.InfoContext(
+		ctx, "moodle scrape committed",
+		"courses", record.Courses,
+		"chapters", record.Chapters,
+		"changed", record.Changed,
+		"deleted", record.Deleted,
+		"errors", record.Errors,
+		"duration", record.Duration,
+	)
+
+	// blobs are written before the transaction commits, so the ones it
+	// didn't end up referencing are only cleaned up once it has
+	if mirror != nil {
+		pruned, err := pruneMirror(ctx, qry, mirror, time.Now())
+		if err != nil {
+			slog.WarnContext(ctx, "failed to prune file mirror", "err", err)
+		} else if pruned > 0 {
+			slog.InfoContext(ctx, "pruned file mirror", "blobs", pruned)
+		}
+	}
 }
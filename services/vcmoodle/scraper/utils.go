@@ -8,8 +8,36 @@ import (
 	"vcassist-backend/lib/scrapers/moodle/view"
 
 	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
 )
 
+// htmlText returns the text content of an html fragment with block
+// boundaries separated by whitespace, this is what gets indexed for search
+func htmlText(fragment string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), nil)
+	if err != nil {
+		return fragment
+	}
+	var words []string
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode && (node.Data == "script" || node.Data == "style") {
+			return
+		}
+		if node.Type == html.TextNode {
+			words = append(words, strings.Fields(node.Data)...)
+			return
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	for _, node := range nodes {
+		walk(node)
+	}
+	return strings.Join(words, " ")
+}
+
 func ScrapeThroughWorkaroundLink(ctx context.Context, client view.Client, link string) (string, error) {
 	if !strings.Contains(link, client.Core.Http.BaseURL) ||
 		!(strings.Contains(link, "/mod/url") || strings.Contains(link, "/mod/resource")) {
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"vcassist-backend/lib/timezone"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// SISData is the part of the SIS service used to add course meetings to
+// the agenda
+type SISData interface {
+	GetData(ctx context.Context, req *connect.Request[sisv1.GetDataRequest]) (*connect.Response[sisv1.GetDataResponse], error)
+}
+
+// the maximum number of days that can be requested at once, this only
+// limits lesson plans since meetings are only known for the current week
+const maxAgendaDays = 62
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(timezone.Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, timezone.Location)
+}
+
+// agendaDays creates an empty day for every day that overlaps [start, end)
+func agendaDays(start, end time.Time) []*vcmoodlev1.AgendaDay {
+	var days []*vcmoodlev1.AgendaDay
+	for day := startOfDay(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, &vcmoodlev1.AgendaDay{
+			Date:        day.Unix(),
+			LessonPlans: []*vcmoodlev1.AgendaLessonPlan{},
+			Meetings:    []*vcmoodlev1.AgendaMeeting{},
+		})
+	}
+	return days
+}
+
+// findAgendaDay returns the day that contains the given time or nil if it
+// is out of range
+func findAgendaDay(days []*vcmoodlev1.AgendaDay, t time.Time) *vcmoodlev1.AgendaDay {
+	date := startOfDay(t).Unix()
+	for _, day := range days {
+		if day.GetDate() == date {
+			return day
+		}
+	}
+	return nil
+}
+
+// meetingsWeek returns the range of days course meetings are known for,
+// the SIS service only scrapes the meetings of the current week.
+func meetingsWeek(now time.Time) (start, end time.Time) {
+	start, stop := timezone.GetCurrentWeek(now)
+	return startOfDay(start), startOfDay(stop).AddDate(0, 0, 1)
+}
+
+func addLessonPlans(days []*vcmoodlev1.AgendaDay, courses []*vcmoodlev1.Course) {
+	for _, course := range courses {
+		for _, section := range course.GetSections() {
+			for _, resource := range section.GetResources() {
+				for _, chapter := range resource.GetChapters() {
+					for _, date := range chapter.GetDates() {
+						day := findAgendaDay(days, time.Unix(date, 0))
+						if day == nil {
+							continue
+						}
+						day.LessonPlans = append(day.LessonPlans, &vcmoodlev1.AgendaLessonPlan{
+							CourseId:   course.GetId(),
+							CourseName: course.GetName(),
+							Chapter:    chapter,
+						})
+					}
+				}
+			}
+		}
+	}
+	for _, day := range days {
+		slices.SortStableFunc(day.LessonPlans, func(a, b *vcmoodlev1.AgendaLessonPlan) int {
+			return strings.Compare(a.GetCourseName(), b.GetCourseName())
+		})
+	}
+}
+
+// addMeetings adds the meetings of courses that start in [start, end) to
+// the days they are on.
+func addMeetings(days []*vcmoodlev1.AgendaDay, courses []*sisv1.CourseData, start, end time.Time) {
+	for _, course := range courses {
+		for _, meeting := range course.GetMeetings() {
+			meetingStart := time.Unix(meeting.GetStart(), 0)
+			if meetingStart.Before(start) || !meetingStart.Before(end) {
+				continue
+			}
+			day := findAgendaDay(days, meetingStart)
+			if day == nil {
+				continue
+			}
+			day.Meetings = append(day.Meetings, &vcmoodlev1.AgendaMeeting{
+				CourseGuid: course.GetGuid(),
+				CourseName: course.GetName(),
+				Period:     course.GetPeriod(),
+				Room:       course.GetRoom(),
+				Meeting:    meeting,
+			})
+		}
+	}
+	for _, day := range days {
+		slices.SortStableFunc(day.Meetings, func(a, b *vcmoodlev1.AgendaMeeting) int {
+			return int(a.GetMeeting().GetStart() - b.GetMeeting().GetStart())
+		})
+	}
+}
+
+func (s Service) GetAgenda(ctx context.Context, req *connect.Request[vcmoodlev1.GetAgendaRequest]) (*connect.Response[vcmoodlev1.GetAgendaResponse], error) {
+	start := time.Unix(req.Msg.GetStart(), 0)
+	end := time.Unix(req.Msg.GetEnd(), 0)
+	if !end.After(start) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("end must be after start"))
+	}
+	if end.Sub(start) > time.Hour*24*maxAgendaDays {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("at most %d days can be requested at once", maxAgendaDays),
+		)
+	}
+	days := agendaDays(start, end)
+
+	profile := verifier.ProfileFromContext(ctx)
+
+	if s.enableCourses {
+		courses, err := s.getUserCourseData(ctx, profile.Email)
+		if err != nil {
+			return nil, err
+		}
+		addLessonPlans(days, courses)
+	}
+
+	out := &vcmoodlev1.GetAgendaResponse{
+		Days: days,
+	}
+
+	// meetings are nice to have, the agenda is still useful without them.
+	// the SIS data only has the meetings of the current week, so it isn't
+	// fetched for ranges that are entirely outside of it and meetings
+	// outside of it (from data cached the week before) are left out.
+	meetingsStart, meetingsEnd := meetingsWeek(timezone.Now())
+	if s.sis != nil && start.Before(meetingsEnd) && end.After(meetingsStart) {
+		res, err := s.sis.GetData(ctx, &connect.Request[sisv1.GetDataRequest]{
+			Msg: &sisv1.GetDataRequest{},
+		})
+		if err == nil {
+			addMeetings(days, res.Msg.GetData().GetCourses(), meetingsStart, meetingsEnd)
+			out.MeetingsStart = proto.Int64(meetingsStart.Unix())
+			out.MeetingsEnd = proto.Int64(meetingsEnd.Unix())
+		} else {
+			slog.WarnContext(ctx, "get sis data for agenda", "email", profile.Email, "err", err)
+		}
+	}
+
+	return &connect.Response[vcmoodlev1.GetAgendaResponse]{
+		Msg: out,
+	}, nil
+}
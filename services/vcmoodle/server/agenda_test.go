@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"vcassist-backend/lib/timezone"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	authdb "vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+type staticSIS struct {
+	data *sisv1.Data
+}
+
+func (s staticSIS) GetData(context.Context, *connect.Request[sisv1.GetDataRequest]) (*connect.Response[sisv1.GetDataResponse], error) {
+	return &connect.Response[sisv1.GetDataResponse]{
+		Msg: &sisv1.GetDataResponse{Data: s.data},
+	}, nil
+}
+
+func TestGetAgenda(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := verifier.ContextWithProfile(context.Background(), authdb.User{Email: "student@example.com"})
+	qry := db.New(sqlite)
+
+	// meetings are only known for the current week, so the agenda is
+	// requested around the wednesday of it
+	weekStart, weekEnd := meetingsWeek(timezone.Now())
+	date := weekStart.AddDate(0, 0, 3)
+
+	err = qry.NoteCourse(ctx, db.NoteCourseParams{ID: 1, Name: "Biology - Teacher"})
+	require.NoError(t, err)
+	err = qry.NoteSection(ctx, db.NoteSectionParams{CourseID: 1, Idx: 0, Name: "Lesson Plans"})
+	require.NoError(t, err)
+	err = qry.NoteResource(ctx, db.NoteResourceParams{
+		CourseID:       1,
+		SectionIdx:     0,
+		Idx:            0,
+		ID:             sql.NullInt64{Int64: 5, Valid: true},
+		Type:           int64(db.RESOURCE_BOOK),
+		DisplayContent: "Quarter 1",
+	})
+	require.NoError(t, err)
+	err = qry.NoteChapter(ctx, db.NoteChapterParams{
+		CourseID:    1,
+		SectionIdx:  0,
+		ResourceIdx: 0,
+		ID:          30,
+		Name:        date.Format("Monday, January 2"),
+		ContentHtml: "<p>cells</p>",
+	})
+	require.NoError(t, err)
+
+	meeting := &sisv1.Meeting{
+		Start: date.Add(time.Hour * 10).Unix(),
+		Stop:  date.Add(time.Hour * 11).Unix(),
+	}
+	// left over from data that was scraped the week before
+	lastWeek := &sisv1.Meeting{
+		Start: date.AddDate(0, 0, -7).Add(time.Hour * 10).Unix(),
+		Stop:  date.AddDate(0, 0, -7).Add(time.Hour * 11).Unix(),
+	}
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+		SIS: staticSIS{data: &sisv1.Data{
+			Courses: []*sisv1.CourseData{
+				{Guid: "bio", Name: "Biology", Period: "3", Meetings: []*sisv1.Meeting{meeting, lastWeek}},
+			},
+		}},
+	})
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1})
+	require.NoError(t, err)
+
+	res, err := service.GetAgenda(ctx, &connect.Request[vcmoodlev1.GetAgendaRequest]{
+		Msg: &vcmoodlev1.GetAgendaRequest{
+			Start: date.AddDate(0, 0, -1).Unix(),
+			End:   date.AddDate(0, 0, 2).Unix(),
+		},
+	})
+	require.NoError(t, err)
+
+	days := res.Msg.GetDays()
+	require.Len(t, days, 3)
+	require.Empty(t, days[0].GetLessonPlans())
+	require.Empty(t, days[0].GetMeetings())
+
+	require.Equal(t, date.Unix(), days[1].GetDate())
+	require.Len(t, days[1].GetLessonPlans(), 1)
+	require.Equal(t, "Biology", days[1].GetLessonPlans()[0].GetCourseName())
+	require.Equal(t, int64(30), days[1].GetLessonPlans()[0].GetChapter().GetId())
+	require.Len(t, days[1].GetMeetings(), 1)
+	require.Equal(t, "3", days[1].GetMeetings()[0].GetPeriod())
+	require.Equal(t, weekStart.Unix(), res.Msg.GetMeetingsStart())
+	require.Equal(t, weekEnd.Unix(), res.Msg.GetMeetingsEnd())
+
+	// meetings outside of the current week aren't included
+	res, err = service.GetAgenda(ctx, &connect.Request[vcmoodlev1.GetAgendaRequest]{
+		Msg: &vcmoodlev1.GetAgendaRequest{
+			Start: date.AddDate(0, 0, -8).Unix(),
+			End:   date.AddDate(0, 0, 1).Unix(),
+		},
+	})
+	require.NoError(t, err)
+	meetings := 0
+	for _, day := range res.Msg.GetDays() {
+		meetings += len(day.GetMeetings())
+	}
+	require.Equal(t, 1, meetings)
+
+	// the range doesn't include the current week at all
+	res, err = service.GetAgenda(ctx, &connect.Request[vcmoodlev1.GetAgendaRequest]{
+		Msg: &vcmoodlev1.GetAgendaRequest{
+			Start: date.AddDate(0, 0, -10).Unix(),
+			End:   date.AddDate(0, 0, -5).Unix(),
+		},
+	})
+	require.NoError(t, err)
+	for _, day := range res.Msg.GetDays() {
+		require.Empty(t, day.GetMeetings())
+	}
+	require.Nil(t, res.Msg.MeetingsStart)
+
+	_, err = service.GetAgenda(ctx, &connect.Request[vcmoodlev1.GetAgendaRequest]{
+		Msg: &vcmoodlev1.GetAgendaRequest{
+			Start: date.Unix(),
+			End:   date.AddDate(1, 0, 0).Unix(),
+		},
+	})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
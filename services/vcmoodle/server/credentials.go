@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"vcassist-backend/lib/scrapers/moodle/core"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// the reasons attached to credential errors, see credentialError
+const (
+	reasonMissingCredentials = "MISSING_CREDENTIALS"
+	reasonInvalidCredentials = "INVALID_CREDENTIALS"
+)
+
+// the reason stored in the keychain when moodle rejects a login
+const rejectedLoginReason = "moodle rejected the username or password"
+
+// credentialError creates an error with a google.rpc.ErrorInfo detail so
+// clients can tell why their credentials can't be used without parsing the
+// message.
+func credentialError(code connect.Code, reason string, err error) error {
+	connectErr := connect.NewError(code, err)
+	detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: keychainNamespace,
+	})
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+func (s Service) GetAuthStatus(ctx context.Context, req *connect.Request[vcmoodlev1.GetAuthStatusRequest]) (*connect.Response[vcmoodlev1.GetAuthStatusResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	existing, err := s.keychain.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+		Msg: &keychainv1.GetUsernamePasswordRequest{
+			Namespace: keychainNamespace,
+			Id:        profile.Email,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &vcmoodlev1.GetAuthStatusResponse{
+		Provided: existing.Msg.GetKey() != nil,
+	}
+	check := existing.Msg.GetCheck()
+	switch check.GetValidity() {
+	case keychainv1.KeyValidity_KEY_VALID:
+		out.Validity = vcmoodlev1.CredentialValidity_CREDENTIAL_VALID
+	case keychainv1.KeyValidity_KEY_INVALID:
+		out.Validity = vcmoodlev1.CredentialValidity_CREDENTIAL_INVALID
+	default:
+		out.Validity = vcmoodlev1.CredentialValidity_CREDENTIAL_UNKNOWN
+	}
+	out.LastChecked = check.GetCheckedAt()
+	out.Reason = check.GetReason()
+
+	return &connect.Response[vcmoodlev1.GetAuthStatusResponse]{Msg: out}, nil
+}
+
+func (s Service) ProvideUsernamePassword(ctx context.Context, req *connect.Request[vcmoodlev1.ProvideUsernamePasswordRequest]) (*connect.Response[vcmoodlev1.ProvideUsernamePasswordResponse], error) {
+	if req.Msg.GetUsername() == "" || req.Msg.GetPassword() == "" {
+		return nil, credentialError(
+			connect.CodeInvalidArgument,
+			reasonMissingCredentials,
+			fmt.Errorf("username and password must not be empty"),
+		)
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+
+	check := &keychainv1.KeyCheck{}
+	client, err := s.sessionCache.loginWith(ctx, req.Msg.GetUsername(), req.Msg.GetPassword())
+	switch {
+	case errors.Is(err, core.LoginFailed):
+		return nil, credentialError(
+			connect.CodeInvalidArgument,
+			reasonInvalidCredentials,
+			fmt.Errorf("%s", rejectedLoginReason),
+		)
+	case err != nil:
+		// moodle being unreachable says nothing about the credentials, so
+		// they are stored anyways and checked the next time they're used
+		slog.WarnContext(ctx, "could not check moodle credentials", "email", profile.Email, "err", err)
+	default:
+		check.Validity = keychainv1.KeyValidity_KEY_VALID
+	}
+
+	_, err = s.keychain.SetUsernamePassword(ctx, &connect.Request[keychainv1.SetUsernamePasswordRequest]{
+		Msg: &keychainv1.SetUsernamePasswordRequest{
+			Namespace: keychainNamespace,
+			Id:        profile.Email,
+			Key: &keychainv1.UsernamePasswordKey{
+				Username: req.Msg.GetUsername(),
+				Password: req.Msg.GetPassword(),
+			},
+			Check: check,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the session made to check the credentials replaces any session made
+	// with the previous ones
+	if check.GetValidity() == keychainv1.KeyValidity_KEY_VALID {
+		s.sessionCache.cache.Add(profile.Email, client)
+	} else {
+		s.sessionCache.cache.Remove(profile.Email)
+	}
+	// the cached courses and deadlines may be from a different moodle
+	// account
+	s.userCourseCache.Remove(profile.Email)
+	s.userDataCache.Remove(profile.Email)
+	s.deadlineCache.Remove(profile.Email)
+
+	return &connect.Response[vcmoodlev1.ProvideUsernamePasswordResponse]{Msg: &vcmoodlev1.ProvideUsernamePasswordResponse{}}, nil
+}
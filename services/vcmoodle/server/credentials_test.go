@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	authdb "vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/keychain"
+	keychaindb "vcassist-backend/services/keychain/db"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func errorReason(t testing.TB, err error) string {
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		info, ok := value.(*errdetails.ErrorInfo)
+		if ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestProvideUsernamePassword(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	ctx = verifier.ContextWithProfile(ctx, authdb.User{Email: "student@example.com"})
+
+	keychainDb, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = keychainDb.Exec(keychaindb.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := keychain.NewService(ctx, keychainDb, keychain.Keyring{})
+
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moodle, sessions := newFakeMoodle(t, keys)
+	service := NewService(ServiceOptions{
+		Keychain: keys,
+		Database: sqlite,
+	})
+	service.sessionCache = sessions
+
+	getStatus := func() *vcmoodlev1.GetAuthStatusResponse {
+		res, err := service.GetAuthStatus(ctx, &connect.Request[vcmoodlev1.GetAuthStatusRequest]{
+			Msg: &vcmoodlev1.GetAuthStatusRequest{},
+		})
+		require.NoError(t, err)
+		return res.Msg
+	}
+
+	_, err = service.ProvideUsernamePassword(ctx, &connect.Request[vcmoodlev1.ProvideUsernamePasswordRequest]{
+		Msg: &vcmoodlev1.ProvideUsernamePasswordRequest{
+			Username: "student",
+		},
+	})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, reasonMissingCredentials, errorReason(t, err))
+
+	// rejected credentials are not stored
+	_, err = service.ProvideUsernamePassword(ctx, &connect.Request[vcmoodlev1.ProvideUsernamePasswordRequest]{
+		Msg: &vcmoodlev1.ProvideUsernamePasswordRequest{
+			Username: "student",
+			Password: "wrong",
+		},
+	})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, reasonInvalidCredentials, errorReason(t, err))
+	require.False(t, getStatus().GetProvided())
+
+	// data cached for a previous moodle account isn't served for the new
+	// one
+	service.userCourseCache.Add("student@example.com", []db.Course{{ID: 1}})
+	service.userDataCache.Add("student@example.com", []*vcmoodlev1.Course{{Id: 1}})
+	service.deadlineCache.Add("student@example.com", []*vcmoodlev1.Deadline{{}})
+
+	_, err = service.ProvideUsernamePassword(ctx, &connect.Request[vcmoodlev1.ProvideUsernamePasswordRequest]{
+		Msg: &vcmoodlev1.ProvideUsernamePasswordRequest{
+			Username: "student",
+			Password: "password",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, service.userCourseCache.Contains("student@example.com"))
+	require.False(t, service.userDataCache.Contains("student@example.com"))
+	require.False(t, service.deadlineCache.Contains("student@example.com"))
+	status := getStatus()
+	require.True(t, status.GetProvided())
+	require.Equal(t, vcmoodlev1.CredentialValidity_CREDENTIAL_VALID, status.GetValidity())
+	require.NotZero(t, status.GetLastChecked())
+
+	// the session used to check the credentials is reused
+	_, err = sessions.Get(ctx, "student@example.com")
+	require.NoError(t, err)
+	require.Equal(t, 1, moodle.loginCount())
+
+	// the password is changed on moodle after it was provided
+	_, err = keys.SetUsernamePassword(ctx, &connect.Request[keychainv1.SetUsernamePasswordRequest]{
+		Msg: &keychainv1.SetUsernamePasswordRequest{
+			Namespace: keychainNamespace,
+			Id:        "student@example.com",
+			Key: &keychainv1.UsernamePasswordKey{
+				Username: "student",
+				Password: "outdated",
+			},
+		},
+	})
+	require.NoError(t, err)
+	sessions.cache.Remove("student@example.com")
+
+	_, err = sessions.Get(ctx, "student@example.com")
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.Equal(t, reasonInvalidCredentials, errorReason(t, err))
+	status = getStatus()
+	require.Equal(t, vcmoodlev1.CredentialValidity_CREDENTIAL_INVALID, status.GetValidity())
+	require.Equal(t, rejectedLoginReason, status.GetReason())
+
+	// credentials that are known to be invalid aren't tried again
+	_, err = sessions.Get(ctx, "student@example.com")
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.Equal(t, 1, moodle.loginCount())
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+	"vcassist-backend/lib/scrapers/moodle/view"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+)
+
+// the activities scraped from a single course
+type courseActivities struct {
+	courseId    int64
+	assignments []view.Assignment
+	quizzes     []view.Quiz
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func urlString(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+func setPoints(deadline *vcmoodlev1.Deadline, grade string) {
+	earned, possible, ok := view.ParseGrade(grade)
+	if !ok {
+		return
+	}
+	earned32 := float32(earned)
+	possible32 := float32(possible)
+	deadline.PointsEarned = &earned32
+	deadline.PointsPossible = &possible32
+}
+
+// buildDeadlines turns scraped activities into deadlines, events that
+// belong to an assignment or quiz that was already scraped are skipped.
+func buildDeadlines(names map[int64]string, activities []courseActivities, events []view.Event) []*vcmoodlev1.Deadline {
+	var deadlines []*vcmoodlev1.Deadline
+	seen := make(map[string]bool)
+
+	for _, course := range activities {
+		for _, a := range course.assignments {
+			deadline := &vcmoodlev1.Deadline{
+				Type:             vcmoodlev1.DeadlineType_DEADLINE_ASSIGNMENT,
+				CourseId:         course.courseId,
+				CourseName:       names[course.courseId],
+				Title:            a.Name,
+				Section:          a.Section,
+				DueDate:          unixOrZero(a.Due),
+				Url:              urlString(a.Url),
+				SubmissionStatus: a.Submission,
+				IsSubmitted:      a.Submitted(),
+			}
+			setPoints(deadline, a.Grade)
+			seen[deadline.Url] = true
+			deadlines = append(deadlines, deadline)
+		}
+		for _, q := range course.quizzes {
+			deadline := &vcmoodlev1.Deadline{
+				Type:       vcmoodlev1.DeadlineType_DEADLINE_QUIZ,
+				CourseId:   course.courseId,
+				CourseName: names[course.courseId],
+				Title:      q.Name,
+				Section:    q.Section,
+				DueDate:    unixOrZero(q.Closes),
+				Url:        urlString(q.Url),
+				// a quiz only has a grade once it has been attempted
+				IsSubmitted: q.Grade != "",
+			}
+			setPoints(deadline, q.Grade)
+			seen[deadline.Url] = true
+			deadlines = append(deadlines, deadline)
+		}
+	}
+
+	for _, e := range events {
+		link := urlString(e.Url)
+		if link != "" && seen[link] {
+			continue
+		}
+		deadlines = append(deadlines, &vcmoodlev1.Deadline{
+			Type:       vcmoodlev1.DeadlineType_DEADLINE_EVENT,
+			CourseId:   e.CourseId,
+			CourseName: names[e.CourseId],
+			Title:      e.Name,
+			DueDate:    unixOrZero(e.Time),
+			Url:        link,
+		})
+	}
+
+	slices.SortStableFunc(deadlines, func(a, b *vcmoodlev1.Deadline) int {
+		switch {
+		case a.GetDueDate() == b.GetDueDate():
+			return 0
+		case a.GetDueDate() == 0:
+			return 1
+		case b.GetDueDate() == 0:
+			return -1
+		case a.GetDueDate() < b.GetDueDate():
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return deadlines
+}
+
+func (s Service) fetchDeadlines(ctx context.Context, email string) ([]*vcmoodlev1.Deadline, error) {
+	// the session is checked before the courses are fetched in parallel
+	// so an expired session is only logged into again once
+	var events []view.Event
+	err := s.sessionCache.Do(ctx, email, func(client view.Client) error {
+		var err error
+		events, err = client.UpcomingEvents(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dbCourses, err := s.getUserCourses(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("getUserCourses: %w", err)
+	}
+	_, names := courseNames(dbCourses)
+
+	base, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]courseActivities, len(dbCourses))
+	wg := sync.WaitGroup{}
+	for i, c := range dbCourses {
+		course := view.Course{
+			Name: c.Name,
+			Url: base.ResolveReference(&url.URL{
+				Path:     "/course/view.php",
+				RawQuery: fmt.Sprintf("id=%d", c.ID),
+			}),
+		}
+		activities[i].courseId = c.ID
+
+		// a course that fails to load just won't have its deadlines
+		// shown, the rest are still useful
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			var assignments []view.Assignment
+			err := s.sessionCache.Do(ctx, email, func(client view.Client) error {
+				var err error
+				assignments, err = client.Assignments(ctx, course)
+				return err
+			})
+			if err != nil {
+				slog.WarnContext(ctx, "get assignments", "course_id", c.ID, "err", err)
+				return
+			}
+			activities[i].assignments = assignments
+		}()
+		go func() {
+			defer wg.Done()
+			var quizzes []view.Quiz
+			err := s.sessionCache.Do(ctx, email, func(client view.Client) error {
+				var err error
+				quizzes, err = client.Quizzes(ctx, course)
+				return err
+			})
+			if err != nil {
+				slog.WarnContext(ctx, "get quizzes", "course_id", c.ID, "err", err)
+				return
+			}
+			activities[i].quizzes = quizzes
+		}()
+	}
+	wg.Wait()
+
+	return buildDeadlines(names, activities, events), nil
+}
+
+func (s Service) GetDeadlines(ctx context.Context, req *connect.Request[vcmoodlev1.GetDeadlinesRequest]) (*connect.Response[vcmoodlev1.GetDeadlinesResponse], error) {
+	if !s.enableCourses {
+		return &connect.Response[vcmoodlev1.GetDeadlinesResponse]{
+			Msg: &vcmoodlev1.GetDeadlinesResponse{
+				Deadlines: []*vcmoodlev1.Deadline{},
+			},
+		}, nil
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+
+	deadlines, hit := s.deadlineCache.Get(profile.Email)
+	if !hit {
+		var err error
+		deadlines, err = s.fetchDeadlines(ctx, profile.Email)
+		if err != nil {
+			return nil, err
+		}
+		evicted := s.deadlineCache.Add(profile.Email, deadlines)
+		if evicted {
+			slog.WarnContext(ctx, "deadline cache could not be added: evicted", "email", profile.Email)
+		}
+	}
+
+	return &connect.Response[vcmoodlev1.GetDeadlinesResponse]{
+		Msg: &vcmoodlev1.GetDeadlinesResponse{
+			Deadlines: deadlines,
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"net/url"
+	"testing"
+	"time"
+	"vcassist-backend/lib/scrapers/moodle/view"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDeadlines(t *testing.T) {
+	link := func(raw string) *url.URL {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		return u
+	}
+
+	activities := []courseActivities{
+		{
+			courseId: 1,
+			assignments: []view.Assignment{
+				{
+					Name:       "Essay",
+					Url:        link("https://learn.vcs.net/mod/assign/view.php?id=101"),
+					Section:    "Unit 1",
+					Due:        time.Unix(2000, 0),
+					Submission: "Submitted for grading",
+					Grade:      "85.00 / 100.00",
+				},
+				{
+					Name:       "Reflection",
+					Url:        link("https://learn.vcs.net/mod/assign/view.php?id=102"),
+					Submission: "No submission",
+				},
+			},
+			quizzes: []view.Quiz{
+				{
+					Name:   "Vocab quiz",
+					Url:    link("https://learn.vcs.net/mod/quiz/view.php?id=201"),
+					Closes: time.Unix(1000, 0),
+				},
+			},
+		},
+	}
+	events := []view.Event{
+		// the same as the essay assignment
+		{
+			Id:       501,
+			Name:     "Essay is due",
+			CourseId: 1,
+			Time:     time.Unix(2000, 0),
+			Url:      link("https://learn.vcs.net/mod/assign/view.php?id=101"),
+		},
+		{
+			Id:   502,
+			Name: "Study group",
+			Time: time.Unix(1500, 0),
+		},
+	}
+
+	deadlines := buildDeadlines(map[int64]string{1: "English"}, activities, events)
+	var titles []string
+	for _, d := range deadlines {
+		titles = append(titles, d.GetTitle())
+	}
+	require.Equal(t, []string{"Vocab quiz", "Study group", "Essay", "Reflection"}, titles)
+
+	quiz := deadlines[0]
+	require.Equal(t, vcmoodlev1.DeadlineType_DEADLINE_QUIZ, quiz.GetType())
+	require.False(t, quiz.GetIsSubmitted())
+	require.Nil(t, quiz.PointsEarned)
+
+	event := deadlines[1]
+	require.Equal(t, vcmoodlev1.DeadlineType_DEADLINE_EVENT, event.GetType())
+	require.Equal(t, int64(0), event.GetCourseId())
+
+	essay := deadlines[2]
+	require.Equal(t, "English", essay.GetCourseName())
+	require.Equal(t, "Unit 1", essay.GetSection())
+	require.Equal(t, int64(2000), essay.GetDueDate())
+	require.True(t, essay.GetIsSubmitted())
+	require.Equal(t, float32(85), essay.GetPointsEarned())
+	require.Equal(t, float32(100), essay.GetPointsPossible())
+
+	require.Equal(t, int64(0), deadlines[3].GetDueDate())
+	require.False(t, deadlines[3].GetIsSubmitted())
+}
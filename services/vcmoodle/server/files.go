@@ -0,0 +1,359 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"vcassist-backend/lib/scrapers/moodle/view"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+	"vcassist-backend/services/vcmoodle/scraper"
+
+	"connectrpc.com/connect"
+	"github.com/go-resty/resty/v2"
+)
+
+// the size of the chunks DownloadFile sends files in
+const downloadChunkSize = 64 * 1024
+
+// openMirroredFile opens the mirrored copy of the file at url, it returns
+// false if there is no mirrored copy or if the user is not enrolled in a
+// course that contains the file, in which case the file should be fetched
+// from moodle on behalf of the user instead.
+func (s Service) openMirroredFile(ctx context.Context, email, url string) (db.FileMirror, *os.File, bool) {
+	// the course list of the user is needed to check if they can access
+	// the file
+	if s.mirror == nil || !s.enableCourses {
+		return db.FileMirror{}, nil, false
+	}
+
+	row, err := s.qry.GetFileMirror(ctx, url)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.FileMirror{}, nil, false
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "get file mirror", "url", url, "err", err)
+		return db.FileMirror{}, nil, false
+	}
+
+	fileCourseIds, err := s.qry.GetFileCourseIds(ctx, url)
+	if err != nil {
+		slog.WarnContext(ctx, "get file course ids", "url", url, "err", err)
+		return db.FileMirror{}, nil, false
+	}
+	userCourses, err := s.getUserCourses(ctx, email)
+	if err != nil {
+		slog.WarnContext(ctx, "get user courses for file", "email", email, "err", err)
+		return db.FileMirror{}, nil, false
+	}
+	enrolled := slices.ContainsFunc(userCourses, func(c db.Course) bool {
+		return slices.Contains(fileCourseIds, c.ID)
+	})
+	if !enrolled {
+		return db.FileMirror{}, nil, false
+	}
+
+	f, err := s.mirror.Open(row.Hash)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			slog.WarnContext(ctx, "open mirrored file", "url", url, "hash", row.Hash, "err", err)
+		}
+		return db.FileMirror{}, nil, false
+	}
+	return row, f, true
+}
+
+// fetchFile requests the file at url from moodle using the session of the
+// user, byteRange is the value of the Range header and is not sent if it
+// is empty. the caller must close the body of the response.
+func (s Service) fetchFile(ctx context.Context, email, url, byteRange string) (*resty.Response, error) {
+	var res *resty.Response
+	err := s.sessionCache.Do(ctx, email, func(client view.Client) error {
+		fileUrl, err := scraper.ScrapeThroughWorkaroundLink(ctx, client, url)
+		if err != nil {
+			return err
+		}
+
+		req := client.Core.Http.R().
+			SetContext(ctx).
+			SetDoNotParseResponse(true)
+		if byteRange != "" {
+			req.SetHeader("Range", byteRange)
+		}
+		res, err = req.Get(fileUrl)
+		if err != nil {
+			return err
+		}
+		err = client.Core.CheckLoginRedirect(res)
+		if err != nil {
+			res.RawBody().Close()
+			return err
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (s Service) GetFileContent(ctx context.Context, req *connect.Request[vcmoodlev1.GetFileContentRequest]) (*connect.Response[vcmoodlev1.GetFileContentResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	current := req.Msg.GetEtag()
+	if current == "" {
+		current = strings.Trim(req.Header().Get("If-None-Match"), `"`)
+	}
+
+	out := &vcmoodlev1.GetFileContentResponse{}
+
+	row, f, mirrored := s.openMirroredFile(ctx, profile.Email, req.Msg.GetUrl())
+	if mirrored {
+		defer f.Close()
+
+		out.Etag = row.Hash
+		out.ContentType = row.ContentType
+		if current != row.Hash {
+			content, err := io.ReadAll(f)
+			if err != nil {
+				return nil, err
+			}
+			out.File = content
+		}
+	} else {
+		res, err := s.fetchFile(ctx, profile.Email, req.Msg.GetUrl(), "")
+		if err != nil {
+			return nil, err
+		}
+		defer res.RawBody().Close()
+		if res.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("fetch file: unexpected status code %d", res.StatusCode())
+		}
+
+		content, err := io.ReadAll(res.RawBody())
+		if err != nil {
+			return nil, err
+		}
+		// this is the same as the hash the file would be mirrored
+		// under so etags don't change once a file is mirrored
+		sum := sha256.Sum256(content)
+		_, contentType := scraper.FileInfo(res.RawResponse, http.DetectContentType(content))
+
+		out.File = content
+		out.Etag = hex.EncodeToString(sum[:])
+		out.ContentType = contentType
+	}
+
+	if current == out.Etag {
+		out.File = nil
+		out.NotModified = true
+	}
+
+	res := connect.NewResponse(out)
+	res.Header().Set("ETag", fmt.Sprintf(`"%s"`, out.Etag))
+	return res, nil
+}
+
+// fileStream is an open file positioned at the start of the requested
+// range along with the metadata that is sent before it
+type fileStream struct {
+	metadata *vcmoodlev1.FileMetadata
+	body     io.ReadCloser
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func limitBody(body io.ReadCloser, length int64) io.ReadCloser {
+	if length <= 0 {
+		return body
+	}
+	return readCloser{Reader: io.LimitReader(body, length), Closer: body}
+}
+
+func errOffsetOutOfRange(offset int64) error {
+	return connect.NewError(connect.CodeOutOfRange, fmt.Errorf("offset %d is past the end of the file", offset))
+}
+
+func mirroredFileStream(row db.FileMirror, f *os.File, offset, length int64) (fileStream, error) {
+	if offset > row.Size {
+		f.Close()
+		return fileStream{}, errOffsetOutOfRange(offset)
+	}
+	_, err := f.Seek(offset, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return fileStream{}, err
+	}
+	return fileStream{
+		metadata: &vcmoodlev1.FileMetadata{
+			ContentType: row.ContentType,
+			Filename:    row.Filename,
+			Length:      row.Size,
+			Offset:      offset,
+			Etag:        row.Hash,
+		},
+		body: limitBody(f, length),
+	}, nil
+}
+
+// parseContentRange parses the start and total size out of a Content-Range
+// header like "bytes 100-199/1000", total is -1 if it is "*"
+func parseContentRange(header string) (start, total int64, ok bool) {
+	spec, found := strings.CutPrefix(header, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	byteRange, size, found := strings.Cut(spec, "/")
+	if !found {
+		return 0, 0, false
+	}
+	first, _, found := strings.Cut(byteRange, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if size == "*" {
+		return start, -1, true
+	}
+	total, err = strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
+
+// liveFileStream wraps a response from moodle to a request for the range
+// starting at offset, it takes care of closing the body of the response.
+func liveFileStream(res *http.Response, offset, length int64) (fileStream, error) {
+	metadata := &vcmoodlev1.FileMetadata{
+		Offset: offset,
+		Etag:   strings.Trim(res.Header.Get("ETag"), `"`),
+	}
+
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+		start, total, ok := parseContentRange(res.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			res.Body.Close()
+			return fileStream{}, fmt.Errorf("fetch file: unexpected content range %q", res.Header.Get("Content-Range"))
+		}
+		metadata.Length = total
+	case http.StatusOK:
+		metadata.Length = res.ContentLength
+		// the range was ignored, so the start of the file has to be
+		// skipped here instead
+		if offset > 0 {
+			_, err := io.CopyN(io.Discard, res.Body, offset)
+			if err == io.EOF {
+				res.Body.Close()
+				return fileStream{}, errOffsetOutOfRange(offset)
+			}
+			if err != nil {
+				res.Body.Close()
+				return fileStream{}, err
+			}
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		res.Body.Close()
+		return fileStream{}, errOffsetOutOfRange(offset)
+	default:
+		res.Body.Close()
+		return fileStream{}, fmt.Errorf("fetch file: unexpected status code %d", res.StatusCode)
+	}
+
+	var body io.ReadCloser = res.Body
+	sniffed := ""
+	// the content type can only be sniffed from the start of the file
+	if offset == 0 {
+		buffered := bufio.NewReader(res.Body)
+		start, _ := buffered.Peek(512)
+		sniffed = http.DetectContentType(start)
+		body = readCloser{Reader: buffered, Closer: res.Body}
+	}
+	metadata.Filename, metadata.ContentType = scraper.FileInfo(res, sniffed)
+
+	return fileStream{
+		metadata: metadata,
+		body:     limitBody(body, length),
+	}, nil
+}
+
+func (s Service) openFileStream(ctx context.Context, email, url string, offset, length int64) (fileStream, error) {
+	row, f, mirrored := s.openMirroredFile(ctx, email, url)
+	if mirrored {
+		return mirroredFileStream(row, f, offset, length)
+	}
+
+	byteRange := ""
+	if length > 0 {
+		byteRange = fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	} else if offset > 0 {
+		byteRange = fmt.Sprintf("bytes=%d-", offset)
+	}
+	res, err := s.fetchFile(ctx, email, url, byteRange)
+	if err != nil {
+		return fileStream{}, err
+	}
+	return liveFileStream(res.RawResponse, offset, length)
+}
+
+func (s Service) DownloadFile(ctx context.Context, req *connect.Request[vcmoodlev1.DownloadFileRequest], stream *connect.ServerStream[vcmoodlev1.DownloadFileResponse]) error {
+	if req.Msg.GetOffset() < 0 || req.Msg.GetLength() < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("offset and length cannot be negative"))
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+
+	file, err := s.openFileStream(ctx, profile.Email, req.Msg.GetUrl(), req.Msg.GetOffset(), req.Msg.GetLength())
+	if err != nil {
+		return err
+	}
+	defer file.body.Close()
+
+	err = stream.Send(&vcmoodlev1.DownloadFileResponse{
+		Content: &vcmoodlev1.DownloadFileResponse_Metadata{
+			Metadata: file.metadata,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	buff := make([]byte, downloadChunkSize)
+	for {
+		n, err := io.ReadFull(file.body, buff)
+		if n > 0 {
+			// the chunk is serialized by Send so the buffer can be reused
+			sendErr := stream.Send(&vcmoodlev1.DownloadFileResponse{
+				Content: &vcmoodlev1.DownloadFileResponse_Chunk{
+					Chunk: buff[:n],
+				},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"vcassist-backend/lib/blobstore"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/proto/vcassist/services/vcmoodle/v1/vcmoodlev1connect"
+	authdb "vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMirroredFile(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := blobstore.Open(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	ctx = verifier.ContextWithProfile(ctx, authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+		Mirror:        &store,
+	})
+
+	content := []byte("%PDF-1.4 syllabus")
+	blob, err := store.Put(bytes.NewReader(content))
+	require.NoError(t, err)
+
+	qry := db.New(sqlite)
+	for _, id := range []int64{1, 2} {
+		err = qry.NoteCourse(ctx, db.NoteCourseParams{ID: id, Name: "course"})
+		require.NoError(t, err)
+	}
+	err = qry.NoteResource(ctx, db.NoteResourceParams{
+		CourseID:       2,
+		Type:           int64(db.RESOURCE_FILE),
+		Url:            "https://learn.vcs.net/syllabus.pdf",
+		DisplayContent: "Syllabus",
+	})
+	require.NoError(t, err)
+	err = qry.NoteFileMirror(ctx, db.NoteFileMirrorParams{
+		Url:         "https://learn.vcs.net/syllabus.pdf",
+		Hash:        blob.Hash,
+		Size:        blob.Size,
+		ContentType: "application/pdf",
+		Filename:    "syllabus.pdf",
+	})
+	require.NoError(t, err)
+
+	// the user is not enrolled in the course with the file so it has to
+	// be fetched from moodle, which is unavailable
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1})
+	require.NoError(t, err)
+	_, err = service.GetFileContent(ctx, &connect.Request[vcmoodlev1.GetFileContentRequest]{
+		Msg: &vcmoodlev1.GetFileContentRequest{Url: "https://learn.vcs.net/syllabus.pdf"},
+	})
+	require.Error(t, err)
+
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1, 2})
+	require.NoError(t, err)
+
+	res, err := service.GetFileContent(ctx, &connect.Request[vcmoodlev1.GetFileContentRequest]{
+		Msg: &vcmoodlev1.GetFileContentRequest{Url: "https://learn.vcs.net/syllabus.pdf"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, content, res.Msg.GetFile())
+	require.Equal(t, blob.Hash, res.Msg.GetEtag())
+	require.Equal(t, "application/pdf", res.Msg.GetContentType())
+	require.False(t, res.Msg.GetNotModified())
+	require.Equal(t, `"`+blob.Hash+`"`, res.Header().Get("ETag"))
+
+	res, err = service.GetFileContent(ctx, &connect.Request[vcmoodlev1.GetFileContentRequest]{
+		Msg: &vcmoodlev1.GetFileContentRequest{
+			Url:  "https://learn.vcs.net/syllabus.pdf",
+			Etag: blob.Hash,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, res.Msg.GetNotModified())
+	require.Empty(t, res.Msg.GetFile())
+}
+
+func TestParseContentRange(t *testing.T) {
+	start, total, ok := parseContentRange("bytes 100-199/1000")
+	require.True(t, ok)
+	require.Equal(t, int64(100), start)
+	require.Equal(t, int64(1000), total)
+
+	start, total, ok = parseContentRange("bytes 5-9/*")
+	require.True(t, ok)
+	require.Equal(t, int64(5), start)
+	require.Equal(t, int64(-1), total)
+
+	_, _, ok = parseContentRange("bytes */1000")
+	require.False(t, ok)
+	_, _, ok = parseContentRange("")
+	require.False(t, ok)
+}
+
+func TestLiveFileStream(t *testing.T) {
+	response := func(status int, header http.Header, body string) *http.Response {
+		return &http.Response{
+			StatusCode:    status,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request: &http.Request{
+				URL: &url.URL{Path: "/pluginfile.php/1/slides.pdf"},
+			},
+		}
+	}
+
+	file, err := liveFileStream(response(http.StatusOK, http.Header{}, "%PDF-1.4 slides"), 0, 0)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file.body)
+	require.NoError(t, err)
+	require.Equal(t, "%PDF-1.4 slides", string(content))
+	require.Equal(t, "slides.pdf", file.metadata.GetFilename())
+	require.Equal(t, "application/pdf", file.metadata.GetContentType())
+	require.Equal(t, int64(15), file.metadata.GetLength())
+
+	// moodle honored the range
+	file, err = liveFileStream(response(http.StatusPartialContent, http.Header{
+		"Content-Range": {"bytes 9-11/15"},
+		"Content-Type":  {"application/pdf"},
+	}, "sli"), 9, 3)
+	require.NoError(t, err)
+	content, err = io.ReadAll(file.body)
+	require.NoError(t, err)
+	require.Equal(t, "sli", string(content))
+	require.Equal(t, int64(15), file.metadata.GetLength())
+	require.Equal(t, int64(9), file.metadata.GetOffset())
+
+	// moodle ignored the range
+	file, err = liveFileStream(response(http.StatusOK, http.Header{}, "%PDF-1.4 slides"), 9, 3)
+	require.NoError(t, err)
+	content, err = io.ReadAll(file.body)
+	require.NoError(t, err)
+	require.Equal(t, "sli", string(content))
+
+	_, err = liveFileStream(response(http.StatusOK, http.Header{}, "%PDF-1.4 slides"), 100, 0)
+	require.Equal(t, connect.CodeOutOfRange, connect.CodeOf(err))
+	_, err = liveFileStream(response(http.StatusRequestedRangeNotSatisfiable, http.Header{}, ""), 100, 0)
+	require.Equal(t, connect.CodeOutOfRange, connect.CodeOf(err))
+}
+
+func TestDownloadMirroredFile(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := blobstore.Open(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	ctx = verifier.ContextWithProfile(ctx, authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+		Mirror:        &store,
+	})
+
+	// large enough to be sent in multiple chunks
+	content := bytes.Repeat([]byte("0123456789"), downloadChunkSize/5)
+	blob, err := store.Put(bytes.NewReader(content))
+	require.NoError(t, err)
+
+	qry := db.New(sqlite)
+	err = qry.NoteCourse(ctx, db.NoteCourseParams{ID: 1, Name: "course"})
+	require.NoError(t, err)
+	err = qry.NoteResource(ctx, db.NoteResourceParams{
+		CourseID: 1,
+		Type:     int64(db.RESOURCE_FILE),
+		Url:      "https://learn.vcs.net/slides.pdf",
+	})
+	require.NoError(t, err)
+	err = qry.NoteFileMirror(ctx, db.NoteFileMirrorParams{
+		Url:         "https://learn.vcs.net/slides.pdf",
+		Hash:        blob.Hash,
+		Size:        blob.Size,
+		ContentType: "application/pdf",
+		Filename:    "slides.pdf",
+	})
+	require.NoError(t, err)
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1})
+	require.NoError(t, err)
+
+	_, handler := vcmoodlev1connect.NewMoodleServiceHandler(service)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(verifier.ContextWithProfile(r.Context(), authdb.User{Email: "student@example.com"})))
+	}))
+	defer server.Close()
+	client := vcmoodlev1connect.NewMoodleServiceClient(server.Client(), server.URL)
+
+	download := func(offset, length int64) (*vcmoodlev1.FileMetadata, []byte) {
+		stream, err := client.DownloadFile(ctx, connect.NewRequest(&vcmoodlev1.DownloadFileRequest{
+			Url:    "https://learn.vcs.net/slides.pdf",
+			Offset: offset,
+			Length: length,
+		}))
+		require.NoError(t, err)
+		defer stream.Close()
+
+		require.True(t, stream.Receive(), stream.Err())
+		metadata := stream.Msg().GetMetadata()
+		require.NotNil(t, metadata)
+
+		var out []byte
+		for stream.Receive() {
+			out = append(out, stream.Msg().GetChunk()...)
+		}
+		require.NoError(t, stream.Err())
+		return metadata, out
+	}
+
+	metadata, out := download(0, 0)
+	require.Equal(t, content, out)
+	require.Equal(t, "slides.pdf", metadata.GetFilename())
+	require.Equal(t, "application/pdf", metadata.GetContentType())
+	require.Equal(t, int64(len(content)), metadata.GetLength())
+	require.Equal(t, blob.Hash, metadata.GetEtag())
+
+	metadata, out = download(int64(len(content))-15, 10)
+	require.Equal(t, content[len(content)-15:len(content)-5], out)
+	require.Equal(t, int64(len(content))-15, metadata.GetOffset())
+
+	stream, err := client.DownloadFile(ctx, connect.NewRequest(&vcmoodlev1.DownloadFileRequest{
+		Url:    "https://learn.vcs.net/slides.pdf",
+		Offset: int64(len(content)) + 1,
+	}))
+	require.NoError(t, err)
+	require.False(t, stream.Receive())
+	require.Equal(t, connect.CodeOutOfRange, connect.CodeOf(stream.Err()))
+	stream.Close()
+}
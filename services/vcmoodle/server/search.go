@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+)
+
+const defaultSearchLimit = 25
+const maxSearchLimit = 100
+
+// ftsQuery turns arbitrary user input into an fts5 query that matches
+// documents containing all of the terms, the last term is matched as a
+// prefix so results show up while the user is still typing
+func ftsQuery(input string) string {
+	terms := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(terms) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		// terms only contain letters and numbers so they never need
+		// to be escaped
+		quoted[i] = fmt.Sprintf(`"%s"`, term)
+	}
+	quoted[len(quoted)-1] += "*"
+	return strings.Join(quoted, " ")
+}
+
+// the markers SearchContent puts around matches in snippets
+const (
+	snippetMatchStart = "\x02"
+	snippetMatchEnd   = "\x03"
+)
+
+// highlightSnippet turns a snippet from SearchContent into html, the
+// indexed content is plain text which may contain anything a teacher
+// wrote, so it is escaped before the matches are wrapped in <mark> tags.
+// an empty string is returned if none of the terms matched the content.
+func highlightSnippet(snippet string) string {
+	if !strings.Contains(snippet, snippetMatchStart) {
+		return ""
+	}
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, snippetMatchStart, "<mark>")
+	escaped = strings.ReplaceAll(escaped, snippetMatchEnd, "</mark>")
+	return escaped
+}
+
+func pbSearchResultKind(kind db.SearchKind) vcmoodlev1.SearchResultKind {
+	switch kind {
+	case db.SEARCH_SECTION:
+		return vcmoodlev1.SearchResultKind_SEARCH_RESULT_SECTION
+	case db.SEARCH_RESOURCE:
+		return vcmoodlev1.SearchResultKind_SEARCH_RESULT_RESOURCE
+	case db.SEARCH_CHAPTER:
+		return vcmoodlev1.SearchResultKind_SEARCH_RESULT_CHAPTER
+	default:
+		return -1
+	}
+}
+
+func (s Service) SearchMoodle(ctx context.Context, req *connect.Request[vcmoodlev1.SearchMoodleRequest]) (*connect.Response[vcmoodlev1.SearchMoodleResponse], error) {
+	query := ftsQuery(req.Msg.GetQuery())
+	if !s.enableCourses || query == "" {
+		return &connect.Response[vcmoodlev1.SearchMoodleResponse]{
+			Msg: &vcmoodlev1.SearchMoodleResponse{
+				Results: []*vcmoodlev1.SearchResult{},
+			},
+		}, nil
+	}
+
+	limit := int64(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+	dbCourses, err := s.getUserCourses(ctx, profile.Email)
+	if err != nil {
+		return nil, fmt.Errorf("getUserCourses: %w", err)
+	}
+	courseIds, names := courseNames(dbCourses)
+
+	rows, err := s.qry.SearchContent(ctx, db.SearchContentParams{
+		Query:     query,
+		CourseIds: courseIds,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*vcmoodlev1.SearchResult, 0, len(rows))
+	for _, r := range rows {
+		kind := pbSearchResultKind(db.SearchKind(r.Kind))
+		if kind < 0 {
+			continue
+		}
+		results = append(results, &vcmoodlev1.SearchResult{
+			Kind:        kind,
+			CourseId:    r.CourseID,
+			CourseName:  names[r.CourseID],
+			SectionIdx:  r.SectionIdx,
+			ResourceIdx: r.ResourceIdx,
+			ChapterId:   r.ChapterID,
+			Title:       r.Title,
+			Snippet:     highlightSnippet(r.Snippet),
+			Url:         r.Url,
+		})
+	}
+
+	return &connect.Response[vcmoodlev1.SearchMoodleResponse]{
+		Msg: &vcmoodlev1.SearchMoodleResponse{
+			Results: results,
+		},
+	}, nil
+}
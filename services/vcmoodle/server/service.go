@@ -7,12 +7,12 @@ import (
 	"log/slog"
 	"strings"
 	"time"
-	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	"vcassist-backend/lib/blobstore"
+	"vcassist-backend/lib/scrapers/moodle/view"
 	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
 	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
 	"vcassist-backend/services/auth/verifier"
 	"vcassist-backend/services/vcmoodle/db"
-	"vcassist-backend/services/vcmoodle/scraper"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/golang-lru/v2/expirable"
@@ -25,72 +25,112 @@ const keychainNamespace = "vcmoodle"
 
 type Service struct {
 	keychain        keychainv1connect.KeychainServiceClient
+	sis             SISData
+	mirror          *blobstore.Store
+	db              *sql.DB
 	qry             *db.Queries
+	enableCourses   bool
 	userCourseCache *expirable.LRU[string, []db.Course]
 	userDataCache   *expirable.LRU[string, []*vcmoodlev1.Course]
+	deadlineCache   *expirable.LRU[string, []*vcmoodlev1.Deadline]
 	sessionCache    sessionCache
 }
 
-func NewService(keychain keychainv1connect.KeychainServiceClient, data *sql.DB) Service {
+type ServiceOptions struct {
+	Keychain keychainv1connect.KeychainServiceClient
+	Database *sql.DB
+	// when this is false GetCourses, RefreshCourses, GetSession and
+	// GetDeadlines will return empty responses without ever logging into
+	// moodle
+	EnableCourses bool
+	// this is optional, if it is specified GetAgenda will also include
+	// course meetings
+	SIS SISData
+	// this is optional, if it is specified files that have been mirrored
+	// by the scraper are served from it instead of from moodle
+	Mirror *blobstore.Store
+}
+
+func NewService(opts ServiceOptions) Service {
 	return Service{
-		keychain: keychain,
-		qry:      db.New(data),
+		keychain:      opts.Keychain,
+		sis:           opts.SIS,
+		mirror:        opts.Mirror,
+		db:            opts.Database,
+		qry:           db.New(opts.Database),
+		enableCourses: opts.EnableCourses,
 		// reevaluate course list every day
-		userCourseCache: expirable.NewLRU[string, []db.Course](2048, nil, time.Hour*24*30),
+		userCourseCache: expirable.NewLRU[string, []db.Course](2048, nil, time.Hour*24),
 		userDataCache:   expirable.NewLRU[string, []*vcmoodlev1.Course](2048, nil, time.Hour*12),
-		sessionCache:    newSessionCache(keychain),
+		// deadlines are scraped on behalf of the user so they are only
+		// cached for a short while
+		deadlineCache: expirable.NewLRU[string, []*vcmoodlev1.Deadline](2048, nil, time.Minute*15),
+		sessionCache:  newSessionCache(baseUrl, opts.Keychain),
 	}
 }
 
-func (s Service) GetAuthStatus(ctx context.Context, req *connect.Request[vcmoodlev1.GetAuthStatusRequest]) (*connect.Response[vcmoodlev1.GetAuthStatusResponse], error) {
-	profile := verifier.ProfileFromContext(ctx)
+// KeepSessionsAlive stops moodle from expiring the sessions of users that
+// are still cached by touching them every interval until ctx is canceled.
+func (s Service) KeepSessionsAlive(ctx context.Context, interval time.Duration) {
+	s.sessionCache.Keepalive(ctx, interval)
+}
 
-	existing, err := s.keychain.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
-		Msg: &keychainv1.GetUsernamePasswordRequest{
-			Namespace: keychainNamespace,
-			Id:        profile.Email,
-		},
+func (s Service) fetchUserCourseIds(ctx context.Context, email string) ([]int64, error) {
+	var courses []view.Course
+	err := s.sessionCache.Do(ctx, email, func(client view.Client) error {
+		var err error
+		courses, err = client.Courses(ctx)
+		return err
 	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get courses: %w", err)
 	}
 
-	return &connect.Response[vcmoodlev1.GetAuthStatusResponse]{
-		Msg: &vcmoodlev1.GetAuthStatusResponse{
-			Provided: existing.Msg.GetKey() != nil,
-		},
-	}, nil
+	seen := make(map[int64]bool)
+	var courseIds []int64
+	for _, c := range courses {
+		// the course list may contain empty entries for anchors that
+		// could not be parsed
+		if c.Url == nil {
+			continue
+		}
+		id, err := c.Id()
+		if err != nil {
+			slog.WarnContext(ctx, "get courses: get course id", "url", c.Url.String(), "err", err)
+			continue
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		courseIds = append(courseIds, id)
+	}
+	return courseIds, nil
 }
 
-func (s Service) ProvideUsernamePassword(ctx context.Context, req *connect.Request[vcmoodlev1.ProvideUsernamePasswordRequest]) (*connect.Response[vcmoodlev1.ProvideUsernamePasswordResponse], error) {
-	profile := verifier.ProfileFromContext(ctx)
+func (s Service) noteUserCourses(ctx context.Context, email string, courseIds []int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
 
-	_, err := s.keychain.SetUsernamePassword(ctx, &connect.Request[keychainv1.SetUsernamePasswordRequest]{
-		Msg: &keychainv1.SetUsernamePasswordRequest{
-			Namespace: keychainNamespace,
-			Id:        profile.Email,
-			Key: &keychainv1.UsernamePasswordKey{
-				Username: req.Msg.GetUsername(),
-				Password: req.Msg.GetPassword(),
-			},
-		},
-	})
+	err = txqry.DeleteUserCourses(ctx, email)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	for _, id := range courseIds {
+		err = txqry.NoteUserCourse(ctx, db.NoteUserCourseParams{
+			Email:    email,
+			CourseID: id,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// client, err := core.NewClient(ctx, core.ClientOptions{
-	// 	BaseUrl: baseUrl,
-	// })
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// err = client.LoginUsernamePassword(ctx, req.Msg.GetUsername(), req.Msg.GetPassword())
-	// if err != nil {
-	// 	return nil, err
-	// }
-
-	return &connect.Response[vcmoodlev1.ProvideUsernamePasswordResponse]{Msg: &vcmoodlev1.ProvideUsernamePasswordResponse{}}, nil
+	return tx.Commit()
 }
 
 func (s Service) getUserCourses(ctx context.Context, email string) ([]db.Course, error) {
@@ -99,24 +139,23 @@ func (s Service) getUserCourses(ctx context.Context, email string) ([]db.Course,
 		return cached, nil
 	}
 
-	client, err := s.sessionCache.Get(ctx, email)
+	courseIds, err := s.fetchUserCourseIds(ctx, email)
 	if err != nil {
-		return nil, fmt.Errorf("create session: %w", err)
+		lastKnown, lastKnownErr := s.qry.GetUserCourseIds(ctx, email)
+		if lastKnownErr != nil || len(lastKnown) == 0 {
+			return nil, err
+		}
+		slog.WarnContext(ctx, "failed to fetch user courses, using last known courses", "email", email, "err", err)
+		// this is intentionally not cached so that the next
+		// request will attempt to fetch the course list again
+		return s.qry.GetCourses(ctx, lastKnown)
 	}
-	courses, err := client.Courses(ctx)
+
+	err = s.noteUserCourses(ctx, email, courseIds)
 	if err != nil {
-		return nil, err
+		slog.WarnContext(ctx, "failed to note user courses", "email", email, "err", err)
 	}
 
-	var courseIds []int64
-	for _, c := range courses {
-		id, err := c.Id()
-		if err != nil {
-			slog.WarnContext(ctx, "get courses: get course id", "url", c.Url.String(), "err", err)
-			continue
-		}
-		courseIds = append(courseIds, int64(id))
-	}
 	dbCourses, err := s.qry.GetCourses(ctx, courseIds)
 	if err != nil {
 		return nil, err
@@ -130,25 +169,13 @@ func (s Service) getUserCourses(ctx context.Context, email string) ([]db.Course,
 	return dbCourses, nil
 }
 
-func (s Service) GetCourses(ctx context.Context, req *connect.Request[vcmoodlev1.GetCoursesRequest]) (*connect.Response[vcmoodlev1.GetCoursesResponse], error) {
-	return &connect.Response[vcmoodlev1.GetCoursesResponse]{
-		Msg: &vcmoodlev1.GetCoursesResponse{
-			Courses: []*vcmoodlev1.Course{},
-		},
-	}, nil
-
-	profile := verifier.ProfileFromContext(ctx)
-
-	cached, hit := s.userDataCache.Get(profile.Email)
+func (s Service) getUserCourseData(ctx context.Context, email string) ([]*vcmoodlev1.Course, error) {
+	cached, hit := s.userDataCache.Get(email)
 	if hit {
-		return &connect.Response[vcmoodlev1.GetCoursesResponse]{
-			Msg: &vcmoodlev1.GetCoursesResponse{
-				Courses: cached,
-			},
-		}, nil
+		return cached, nil
 	}
 
-	dbCourses, err := s.getUserCourses(ctx, profile.Email)
+	dbCourses, err := s.getUserCourses(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("getUserCourses: %w", err)
 	}
@@ -157,9 +184,28 @@ func (s Service) GetCourses(ctx context.Context, req *connect.Request[vcmoodlev1
 		return nil, err
 	}
 
-	evicted := s.userDataCache.Add(profile.Email, outCourses)
+	evicted := s.userDataCache.Add(email, outCourses)
 	if evicted {
-		slog.WarnContext(ctx, "courses cache could not be added: evicted", "email", profile.Email)
+		slog.WarnContext(ctx, "courses cache could not be added: evicted", "email", email)
+	}
+
+	return outCourses, nil
+}
+
+func (s Service) GetCourses(ctx context.Context, req *connect.Request[vcmoodlev1.GetCoursesRequest]) (*connect.Response[vcmoodlev1.GetCoursesResponse], error) {
+	if !s.enableCourses {
+		return &connect.Response[vcmoodlev1.GetCoursesResponse]{
+			Msg: &vcmoodlev1.GetCoursesResponse{
+				Courses: []*vcmoodlev1.Course{},
+			},
+		}, nil
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+
+	outCourses, err := s.getUserCourseData(ctx, profile.Email)
+	if err != nil {
+		return nil, err
 	}
 
 	return &connect.Response[vcmoodlev1.GetCoursesResponse]{
@@ -170,14 +216,19 @@ func (s Service) GetCourses(ctx context.Context, req *connect.Request[vcmoodlev1
 }
 
 func (s Service) RefreshCourses(ctx context.Context, req *connect.Request[vcmoodlev1.RefreshCoursesRequest]) (*connect.Response[vcmoodlev1.RefreshCoursesResponse], error) {
-	return &connect.Response[vcmoodlev1.RefreshCoursesResponse]{
-		Msg: &vcmoodlev1.RefreshCoursesResponse{
-			Courses: []*vcmoodlev1.Course{},
-		},
-	}, nil
+	if !s.enableCourses {
+		return &connect.Response[vcmoodlev1.RefreshCoursesResponse]{
+			Msg: &vcmoodlev1.RefreshCoursesResponse{
+				Courses: []*vcmoodlev1.Course{},
+			},
+		}, nil
+	}
 
 	profile := verifier.ProfileFromContext(ctx)
 
+	// force the course list to be fetched from moodle again
+	s.userCourseCache.Remove(profile.Email)
+
 	dbCourses, err := s.getUserCourses(ctx, profile.Email)
 	if err != nil {
 		return nil, fmt.Errorf("getUserCourses: %w", err)
@@ -211,41 +262,24 @@ func (s Service) GetChapterContent(ctx context.Context, req *connect.Request[vcm
 	}, nil
 }
 
-func (s Service) GetFileContent(ctx context.Context, req *connect.Request[vcmoodlev1.GetFileContentRequest]) (*connect.Response[vcmoodlev1.GetFileContentResponse], error) {
-	profile := verifier.ProfileFromContext(ctx)
-
-	client, err := s.sessionCache.Get(ctx, profile.Email)
-	if err != nil {
-		return nil, err
-	}
-
-	fileUrl, err := scraper.ScrapeThroughWorkaroundLink(ctx, client, req.Msg.GetUrl())
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := client.Core.Http.R().Get(fileUrl)
-	if err != nil {
-		return nil, err
-	}
-
-	return &connect.Response[vcmoodlev1.GetFileContentResponse]{
-		Msg: &vcmoodlev1.GetFileContentResponse{
-			File: res.Body(),
-		},
-	}, nil
-}
-
 func (s Service) GetSession(ctx context.Context, req *connect.Request[vcmoodlev1.GetSessionRequest]) (*connect.Response[vcmoodlev1.GetSessionResponse], error) {
-	return &connect.Response[vcmoodlev1.GetSessionResponse]{
-		Msg: &vcmoodlev1.GetSessionResponse{
-			Cookies: "",
-		},
-	}, nil
+	if !s.enableCourses {
+		return &connect.Response[vcmoodlev1.GetSessionResponse]{
+			Msg: &vcmoodlev1.GetSessionResponse{
+				Cookies: "",
+			},
+		}, nil
+	}
 
 	profile := verifier.ProfileFromContext(ctx)
 
-	client, err := s.sessionCache.Get(ctx, profile.Email)
+	// the cookies are used outside of the service so the session has to be
+	// checked here, there is no later request that would notice it expired
+	var client view.Client
+	err := s.sessionCache.Do(ctx, profile.Email, func(c view.Client) error {
+		client = c
+		return c.Core.Touch(ctx)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	authdb "vcassist-backend/services/auth/db"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+type unavailableKeychain struct {
+	keychainv1connect.KeychainServiceClient
+}
+
+func (unavailableKeychain) GetUsernamePassword(context.Context, *connect.Request[keychainv1.GetUsernamePasswordRequest]) (*connect.Response[keychainv1.GetUsernamePasswordResponse], error) {
+	return nil, fmt.Errorf("keychain is unavailable")
+}
+
+func TestGetCoursesFallback(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	ctx = verifier.ContextWithProfile(ctx, authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+	})
+
+	// there is no last known course list, so the error should be surfaced
+	_, err = service.GetCourses(ctx, &connect.Request[vcmoodlev1.GetCoursesRequest]{
+		Msg: &vcmoodlev1.GetCoursesRequest{},
+	})
+	require.Error(t, err)
+
+	qry := db.New(sqlite)
+	for _, id := range []int64{1, 2, 3} {
+		err = qry.NoteCourse(ctx, db.NoteCourseParams{
+			ID:   id,
+			Name: fmt.Sprintf("Course %d", id),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := service.RefreshCourses(ctx, &connect.Request[vcmoodlev1.RefreshCoursesRequest]{
+		Msg: &vcmoodlev1.RefreshCoursesRequest{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, c := range res.Msg.GetCourses() {
+		names = append(names, c.GetName())
+	}
+	require.ElementsMatch(t, []string{"Course 1", "Course 3"}, names)
+}
+
+func TestCoursesDisabled(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := verifier.ContextWithProfile(context.Background(), authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain: unavailableKeychain{},
+		Database: sqlite,
+	})
+
+	res, err := service.GetCourses(ctx, &connect.Request[vcmoodlev1.GetCoursesRequest]{
+		Msg: &vcmoodlev1.GetCoursesRequest{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, res.Msg.GetCourses())
+}
+
+func TestSearchMoodle(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := verifier.ContextWithProfile(context.Background(), authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+	})
+
+	qry := db.New(sqlite)
+	for _, id := range []int64{1, 2} {
+		err = qry.NoteCourse(ctx, db.NoteCourseParams{
+			ID:   id,
+			Name: fmt.Sprintf("Course %d - Teacher", id),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries := []db.NoteSearchEntryParams{
+		{
+			Kind:        int64(db.SEARCH_SECTION),
+			CourseID:    1,
+			SectionIdx:  0,
+			ResourceIdx: 0,
+			ChapterID:   0,
+			Url:         "https://learn.vcs.net/course/view.php?id=1&section=0",
+			Title:       "Study Guides",
+		},
+		{
+			Kind:        int64(db.SEARCH_CHAPTER),
+			CourseID:    1,
+			SectionIdx:  1,
+			ResourceIdx: 2,
+			ChapterID:   30,
+			Url:         "https://learn.vcs.net/mod/book/view.php?id=5&chapterid=30",
+			Title:       "Monday",
+			Content:     "Finish the worksheet and start studying for the unit 4 test.",
+		},
+		{
+			Kind:        int64(db.SEARCH_CHAPTER),
+			CourseID:    2,
+			SectionIdx:  0,
+			ResourceIdx: 0,
+			ChapterID:   31,
+			Title:       "Tuesday",
+			Content:     "Study for the vocab quiz.",
+		},
+		{
+			Kind:        int64(db.SEARCH_CHAPTER),
+			CourseID:    1,
+			SectionIdx:  1,
+			ResourceIdx: 3,
+			ChapterID:   32,
+			Url:         "https://learn.vcs.net/mod/book/view.php?id=6&chapterid=32",
+			Title:       "Wednesday",
+			Content:     `Lab day <script>alert("lab")</script> <img src=x onerror=alert(1)> bring goggles & gloves`,
+		},
+	}
+	for _, e := range entries {
+		err = qry.NoteSearchEntry(ctx, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := service.SearchMoodle(ctx, &connect.Request[vcmoodlev1.SearchMoodleRequest]{
+		Msg: &vcmoodlev1.SearchMoodleRequest{Query: "study"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := res.Msg.GetResults()
+	require.Len(t, results, 2)
+	// title matches should be ranked above content matches
+	require.Equal(t, vcmoodlev1.SearchResultKind_SEARCH_RESULT_SECTION, results[0].GetKind())
+	require.Equal(t, "Course 1", results[0].GetCourseName())
+	require.Empty(t, results[0].GetSnippet())
+	require.Equal(t, int64(30), results[1].GetChapterId())
+	require.Contains(t, results[1].GetSnippet(), "<mark>studying</mark>")
+
+	// fts5 syntax in the query should be treated as plain text
+	res, err = service.SearchMoodle(ctx, &connect.Request[vcmoodlev1.SearchMoodleRequest]{
+		Msg: &vcmoodlev1.SearchMoodleRequest{Query: `(unit "4`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, res.Msg.GetResults(), 1)
+	require.Equal(t, int64(30), res.Msg.GetResults()[0].GetChapterId())
+
+	// markup in the indexed content should be escaped in the snippet
+	res, err = service.SearchMoodle(ctx, &connect.Request[vcmoodlev1.SearchMoodleRequest]{
+		Msg: &vcmoodlev1.SearchMoodleRequest{Query: "goggles"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, res.Msg.GetResults(), 1)
+	snippet := res.Msg.GetResults()[0].GetSnippet()
+	require.Contains(t, snippet, "<mark>goggles</mark>")
+	require.Contains(t, snippet, "&lt;script&gt;")
+	require.Contains(t, snippet, "&amp; gloves")
+	require.NotContains(t, snippet, "<script")
+	require.NotContains(t, snippet, "<img")
+}
+
+func TestGetCourseUpdates(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sqlite.Exec(db.Schema + db.SearchSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := verifier.ContextWithProfile(context.Background(), authdb.User{Email: "student@example.com"})
+
+	service := NewService(ServiceOptions{
+		Keychain:      unavailableKeychain{},
+		Database:      sqlite,
+		EnableCourses: true,
+	})
+
+	qry := db.New(sqlite)
+	for _, id := range []int64{1, 2} {
+		err = qry.NoteCourse(ctx, db.NoteCourseParams{
+			ID:   id,
+			Name: fmt.Sprintf("Course %d - Teacher", id),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = service.noteUserCourses(ctx, "student@example.com", []int64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes := []db.CreateContentChangeParams{
+		{
+			Time:      50,
+			Type:      int64(db.CONTENT_ADDED),
+			Kind:      int64(db.SEARCH_CHAPTER),
+			CourseID:  1,
+			ChapterID: 10,
+			Name:      "too old",
+		},
+		{
+			Time:            200,
+			Type:            int64(db.CONTENT_CHANGED),
+			Kind:            int64(db.SEARCH_CHAPTER),
+			CourseID:        1,
+			ChapterID:       11,
+			Name:            "Monday",
+			Content:         "<p>quiz on monday</p>",
+			PreviousContent: "<p>quiz on friday</p>",
+		},
+		{
+			Time:     150,
+			Type:     int64(db.CONTENT_REMOVED),
+			Kind:     int64(db.SEARCH_SECTION),
+			CourseID: 1,
+			Name:     "Old Unit",
+		},
+		{
+			Time:     150,
+			Type:     int64(db.CONTENT_ADDED),
+			Kind:     int64(db.SEARCH_SECTION),
+			CourseID: 2,
+			Name:     "not enrolled",
+		},
+	}
+	for _, c := range changes {
+		err = qry.CreateContentChange(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := service.GetCourseUpdates(ctx, &connect.Request[vcmoodlev1.GetCourseUpdatesRequest]{
+		Msg: &vcmoodlev1.GetCourseUpdatesRequest{Since: 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates := res.Msg.GetUpdates()
+	require.Len(t, updates, 2)
+
+	require.Equal(t, vcmoodlev1.UpdateType_UPDATE_REMOVED, updates[0].GetType())
+	require.Equal(t, vcmoodlev1.ContentKind_CONTENT_SECTION, updates[0].GetKind())
+	require.Equal(t, "Old Unit", updates[0].GetName())
+	require.Empty(t, updates[0].GetDiffHtml())
+
+	require.Equal(t, vcmoodlev1.UpdateType_UPDATE_CHANGED, updates[1].GetType())
+	require.Equal(t, "Course 1", updates[1].GetCourseName())
+	require.Equal(t, "<p>quiz on <del>friday</del><ins>monday</ins></p>", updates[1].GetDiffHtml())
+}
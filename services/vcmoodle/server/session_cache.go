@@ -2,63 +2,233 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 	"vcassist-backend/lib/scrapers/moodle/core"
 	"vcassist-backend/lib/scrapers/moodle/view"
+	"vcassist-backend/lib/telemetry"
 	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
 	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/golang-lru/v2/expirable"
+	"golang.org/x/sync/singleflight"
 )
 
+var meter = telemetry.Meter("vcassist.services.vcmoodle.server")
+
+var sessionLoginCounter, _ = meter.Int64Counter("vcmoodle.sessions.logins")
+var sessionLoginFailureCounter, _ = meter.Int64Counter("vcmoodle.sessions.login_failures")
+var sessionExpiredCounter, _ = meter.Int64Counter("vcmoodle.sessions.expired")
+var activeSessionsGauge, _ = meter.Int64Gauge("vcmoodle.sessions.active")
+
+// sessions are dropped once they haven't been logged into for this long,
+// the keepalive stops moodle from expiring them before then
+const sessionTtl = time.Hour
+
+// sessionCache holds a logged in moodle client for each user, at most one
+// login is made at a time for each user and sessions that moodle expired
+// early are logged into again when they are used.
 type sessionCache struct {
+	baseUrl  string
 	cache    *expirable.LRU[string, view.Client]
 	keychain keychainv1connect.KeychainServiceClient
+	logins   *singleflight.Group
+	// held while replacing an expired session so concurrent calls that
+	// saw the same session expire only replace it once
+	invalidateLock *sync.Mutex
 }
 
-func newSessionCache(keychain keychainv1connect.KeychainServiceClient) sessionCache {
+func newSessionCache(baseUrl string, keychain keychainv1connect.KeychainServiceClient) sessionCache {
 	return sessionCache{
-		cache:    expirable.NewLRU[string, view.Client](2048, nil, time.Minute*15),
-		keychain: keychain,
+		baseUrl:        baseUrl,
+		cache:          expirable.NewLRU[string, view.Client](2048, nil, sessionTtl),
+		keychain:       keychain,
+		logins:         &singleflight.Group{},
+		invalidateLock: &sync.Mutex{},
 	}
 }
 
-func (s sessionCache) Get(ctx context.Context, email string) (view.Client, error) {
-	cached, hit := s.cache.Get(email)
-	if hit {
-		return cached, nil
+// loginWith logs into moodle with the given credentials.
+func (s sessionCache) loginWith(ctx context.Context, username, password string) (view.Client, error) {
+	coreClient, err := core.NewClient(ctx, core.ClientOptions{
+		BaseUrl: s.baseUrl,
+	})
+	if err != nil {
+		return view.Client{}, err
 	}
+	sessionLoginCounter.Add(ctx, 1)
+	err = coreClient.LoginUsernamePassword(ctx, username, password)
+	if err != nil {
+		sessionLoginFailureCounter.Add(ctx, 1)
+		return view.Client{}, err
+	}
+	return view.NewClient(ctx, coreClient)
+}
 
-	res, err := s.keychain.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
-		Msg: &keychainv1.GetUsernamePasswordRequest{
+// reportCheck records the result of logging in with the stored credentials
+// of the user, failing to record it is only logged since the login itself
+// has already worked (or failed).
+func (s sessionCache) reportCheck(ctx context.Context, email string, validity keychainv1.KeyValidity, reason string) {
+	_, err := s.keychain.SetUsernamePasswordCheck(ctx, &connect.Request[keychainv1.SetUsernamePasswordCheckRequest]{
+		Msg: &keychainv1.SetUsernamePasswordCheckRequest{
 			Namespace: keychainNamespace,
 			Id:        email,
+			Check: &keychainv1.KeyCheck{
+				Validity: validity,
+				Reason:   reason,
+			},
 		},
 	})
 	if err != nil {
-		return view.Client{}, err
+		slog.WarnContext(ctx, "failed to record moodle credential check", "email", email, "err", err)
 	}
+}
 
-	coreClient, err := core.NewClient(ctx, core.ClientOptions{
-		BaseUrl: baseUrl,
+// login logs into moodle with the stored credentials of the user, it won't
+// try credentials that moodle has already rejected so that the account
+// doesn't get locked out.
+func (s sessionCache) login(ctx context.Context, email string) (view.Client, error) {
+	res, err := s.keychain.GetUsernamePassword(ctx, &connect.Request[keychainv1.GetUsernamePasswordRequest]{
+		Msg: &keychainv1.GetUsernamePasswordRequest{
+			Namespace: keychainNamespace,
+			Id:        email,
+		},
 	})
 	if err != nil {
 		return view.Client{}, err
 	}
-	err = coreClient.LoginUsernamePassword(
+	if res.Msg.GetKey() == nil {
+		return view.Client{}, credentialError(
+			connect.CodeFailedPrecondition,
+			reasonMissingCredentials,
+			fmt.Errorf("moodle credentials have not been provided"),
+		)
+	}
+	if res.Msg.GetCheck().GetValidity() == keychainv1.KeyValidity_KEY_INVALID {
+		return view.Client{}, credentialError(
+			connect.CodeFailedPrecondition,
+			reasonInvalidCredentials,
+			fmt.Errorf("%w: credentials must be provided again", core.LoginFailed),
+		)
+	}
+
+	client, err := s.loginWith(
 		ctx,
 		res.Msg.GetKey().GetUsername(),
 		res.Msg.GetKey().GetPassword(),
 	)
+	if errors.Is(err, core.LoginFailed) {
+		s.reportCheck(ctx, email, keychainv1.KeyValidity_KEY_INVALID, rejectedLoginReason)
+		return view.Client{}, credentialError(
+			connect.CodeFailedPrecondition,
+			reasonInvalidCredentials,
+			err,
+		)
+	}
 	if err != nil {
 		return view.Client{}, err
 	}
-	client, err := view.NewClient(ctx, coreClient)
+	s.reportCheck(ctx, email, keychainv1.KeyValidity_KEY_VALID, "")
+	return client, nil
+}
+
+// Get returns the session of the user, logging in if there isn't one.
+func (s sessionCache) Get(ctx context.Context, email string) (view.Client, error) {
+	cached, hit := s.cache.Get(email)
+	if hit {
+		return cached, nil
+	}
+
+	result, err, _ := s.logins.Do(email, func() (any, error) {
+		// another call may have finished logging in between the cache
+		// miss and this call starting
+		cached, hit := s.cache.Get(email)
+		if hit {
+			return cached, nil
+		}
+		// the login is shared with every caller waiting on it, so it
+		// shouldn't fail because the first of them went away
+		client, err := s.login(context.WithoutCancel(ctx), email)
+		if err != nil {
+			return nil, err
+		}
+		s.cache.Add(email, client)
+		return client, nil
+	})
 	if err != nil {
 		return view.Client{}, err
 	}
+	return result.(view.Client), nil
+}
 
-	s.cache.Add(email, client)
-	return client, nil
+// invalidate drops the session of the user if it is still the given one.
+func (s sessionCache) invalidate(ctx context.Context, email string, expired view.Client) {
+	s.invalidateLock.Lock()
+	defer s.invalidateLock.Unlock()
+
+	cached, hit := s.cache.Peek(email)
+	if !hit || cached.Core != expired.Core {
+		return
+	}
+	s.cache.Remove(email)
+	sessionExpiredCounter.Add(ctx, 1)
+	slog.DebugContext(ctx, "moodle session expired", "email", email)
+}
+
+// Do calls fn with the session of the user, if fn fails because the session
+// expired it is called once more with a new session.
+func (s sessionCache) Do(ctx context.Context, email string, fn func(client view.Client) error) error {
+	client, err := s.Get(ctx, email)
+	if err != nil {
+		return err
+	}
+	err = fn(client)
+	if !errors.Is(err, core.SessionExpired) {
+		return err
+	}
+
+	s.invalidate(ctx, email, client)
+	client, err = s.Get(ctx, email)
+	if err != nil {
+		return err
+	}
+	return fn(client)
+}
+
+// keepalive touches every session so moodle doesn't expire sessions that
+// are still cached, sessions that have already expired are dropped.
+func (s sessionCache) keepalive(ctx context.Context) {
+	for _, email := range s.cache.Keys() {
+		client, hit := s.cache.Peek(email)
+		if !hit {
+			continue
+		}
+		err := client.Core.Touch(ctx)
+		if errors.Is(err, core.SessionExpired) {
+			s.invalidate(ctx, email, client)
+			continue
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "touch moodle session", "email", email, "err", err)
+		}
+	}
+	activeSessionsGauge.Record(ctx, int64(s.cache.Len()))
+}
+
+// Keepalive runs keepalive every interval until ctx is canceled.
+func (s sessionCache) Keepalive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.keepalive(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
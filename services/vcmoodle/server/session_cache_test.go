@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"vcassist-backend/lib/scrapers/moodle/core"
+	"vcassist-backend/lib/scrapers/moodle/view"
+	"vcassist-backend/lib/telemetry"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+type staticKeychain struct {
+	keychainv1connect.KeychainServiceClient
+}
+
+func (staticKeychain) SetUsernamePasswordCheck(context.Context, *connect.Request[keychainv1.SetUsernamePasswordCheckRequest]) (*connect.Response[keychainv1.SetUsernamePasswordCheckResponse], error) {
+	return connect.NewResponse(&keychainv1.SetUsernamePasswordCheckResponse{}), nil
+}
+
+func (staticKeychain) GetUsernamePassword(context.Context, *connect.Request[keychainv1.GetUsernamePasswordRequest]) (*connect.Response[keychainv1.GetUsernamePasswordResponse], error) {
+	return connect.NewResponse(&keychainv1.GetUsernamePasswordResponse{
+		Key: &keychainv1.UsernamePasswordKey{
+			Username: "student",
+			Password: "password",
+		},
+	}), nil
+}
+
+// fakeMoodle implements just enough of moodle to login, load a page and
+// touch a session, sessions can be expired at any time with expire.
+type fakeMoodle struct {
+	lock     sync.Mutex
+	sessions map[string]bool
+	logins   int
+}
+
+func (m *fakeMoodle) expire() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.sessions = map[string]bool{}
+}
+
+func (m *fakeMoodle) loginCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.logins
+}
+
+func (m *fakeMoodle) loggedIn(r *http.Request) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	cookie, err := r.Cookie("MoodleSession")
+	return err == nil && m.sessions[cookie.Value]
+}
+
+func (m *fakeMoodle) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/login/index.php" && r.Method == http.MethodGet:
+		fmt.Fprint(w, `<form><input name="logintoken" value="token"></form>`)
+	case r.URL.Path == "/login/index.php" && r.Method == http.MethodPost:
+		if r.FormValue("password") != "password" {
+			http.Redirect(w, r, "/login/index.php", http.StatusSeeOther)
+			return
+		}
+
+		m.lock.Lock()
+		m.logins++
+		session := fmt.Sprintf("session-%d", m.logins)
+		m.sessions[session] = true
+		m.lock.Unlock()
+
+		http.SetCookie(w, &http.Cookie{Name: "MoodleSession", Value: session, Path: "/"})
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+	case r.URL.Path == "/lib/ajax/service.php":
+		if !m.loggedIn(r) {
+			fmt.Fprint(w, `[{"error":true,"exception":{"errorcode":"servicerequireslogin"}}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"error":false,"data":true}]`)
+	case !m.loggedIn(r), strings.HasPrefix(r.URL.Path, "/forbidden/"):
+		// moodle sends users to the login page for resources they can't
+		// access even when they are logged in
+		http.Redirect(w, r, "/login/index.php", http.StatusSeeOther)
+	case r.URL.Path == "/":
+		fmt.Fprint(w, `<script>//<![CDATA[
+M.cfg = {"sesskey":"sesskey"};
+//]]></script><span class="avatar current"></span>`)
+	default:
+		fmt.Fprint(w, "page")
+	}
+}
+
+func newFakeMoodle(t testing.TB, keychain keychainv1connect.KeychainServiceClient) (*fakeMoodle, sessionCache) {
+	cleanup := telemetry.SetupForTesting("test:services/vcmoodle")
+	t.Cleanup(cleanup)
+
+	moodle := &fakeMoodle{sessions: map[string]bool{}}
+	srv := httptest.NewServer(moodle)
+	t.Cleanup(srv.Close)
+	return moodle, newSessionCache(srv.URL, keychain)
+}
+
+func TestSessionSingleFlight(t *testing.T) {
+	moodle, sessions := newFakeMoodle(t, staticKeychain{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	clients := make([]view.Client, 5)
+	wg := sync.WaitGroup{}
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := sessions.Get(ctx, "student@example.com")
+			require.NoError(t, err)
+			clients[i] = client
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, moodle.loginCount())
+	for _, client := range clients {
+		require.Same(t, clients[0].Core, client.Core)
+	}
+}
+
+func TestSessionRelogin(t *testing.T) {
+	moodle, sessions := newFakeMoodle(t, staticKeychain{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	fetchPage := func(client view.Client) error {
+		res, err := client.Core.Http.R().
+			SetContext(ctx).
+			Get("/course/view.php")
+		if err != nil {
+			return err
+		}
+		require.Equal(t, "page", res.String())
+		return nil
+	}
+
+	err := sessions.Do(ctx, "student@example.com", fetchPage)
+	require.NoError(t, err)
+	require.Equal(t, 1, moodle.loginCount())
+
+	// the session is expired early, the request should be retried with
+	// a new session instead of failing
+	moodle.expire()
+	err = sessions.Do(ctx, "student@example.com", fetchPage)
+	require.NoError(t, err)
+	require.Equal(t, 2, moodle.loginCount())
+
+	// expired sessions are dropped by the keepalive
+	moodle.expire()
+	sessions.keepalive(ctx)
+	require.Equal(t, 0, sessions.cache.Len())
+
+	err = sessions.Do(ctx, "student@example.com", fetchPage)
+	require.NoError(t, err)
+	sessions.keepalive(ctx)
+	require.Equal(t, 1, sessions.cache.Len())
+	require.Equal(t, 3, moodle.loginCount())
+}
+
+func TestSessionInaccessibleResource(t *testing.T) {
+	moodle, sessions := newFakeMoodle(t, staticKeychain{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	fetchForbidden := func(client view.Client) error {
+		_, err := client.Core.Http.R().
+			SetContext(ctx).
+			Get("/forbidden/course/view.php")
+		return err
+	}
+	downloadForbidden := func(client view.Client) error {
+		res, err := client.Core.Http.R().
+			SetContext(ctx).
+			SetDoNotParseResponse(true).
+			Get("/forbidden/pluginfile.php/1/file.pdf")
+		if err != nil {
+			return err
+		}
+		defer res.RawBody().Close()
+		return client.Core.CheckLoginRedirect(res)
+	}
+
+	// the redirect to the login page isn't an expired session, so the
+	// session is kept instead of logging in again for every such resource
+	for i := 0; i < 3; i++ {
+		err := sessions.Do(ctx, "student@example.com", fetchForbidden)
+		require.ErrorIs(t, err, core.ResourceUnavailable)
+		err = sessions.Do(ctx, "student@example.com", downloadForbidden)
+		require.ErrorIs(t, err, core.ResourceUnavailable)
+	}
+	require.Equal(t, 1, moodle.loginCount())
+	require.Equal(t, 1, sessions.cache.Len())
+
+	// once the session really has expired the same redirect means a new
+	// session is needed
+	moodle.expire()
+	err := sessions.Do(ctx, "student@example.com", downloadForbidden)
+	require.ErrorIs(t, err, core.ResourceUnavailable)
+	require.Equal(t, 2, moodle.loginCount())
+}
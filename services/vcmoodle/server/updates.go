@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"vcassist-backend/lib/htmlutil"
+	vcmoodlev1 "vcassist-backend/proto/vcassist/services/vcmoodle/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcmoodle/db"
+
+	"connectrpc.com/connect"
+)
+
+// courseNames returns the ids of the given courses and their names
+// without the teacher
+func courseNames(dbCourses []db.Course) ([]int64, map[int64]string) {
+	ids := make([]int64, len(dbCourses))
+	names := make(map[int64]string, len(dbCourses))
+	for i, c := range dbCourses {
+		ids[i] = c.ID
+		names[c.ID] = strings.Split(c.Name, " - ")[0]
+	}
+	return ids, names
+}
+
+func pbContentKind(kind db.SearchKind) vcmoodlev1.ContentKind {
+	switch kind {
+	case db.SEARCH_SECTION:
+		return vcmoodlev1.ContentKind_CONTENT_SECTION
+	case db.SEARCH_RESOURCE:
+		return vcmoodlev1.ContentKind_CONTENT_RESOURCE
+	case db.SEARCH_CHAPTER:
+		return vcmoodlev1.ContentKind_CONTENT_CHAPTER
+	default:
+		return -1
+	}
+}
+
+func pbUpdateType(changeType db.ContentChangeType) vcmoodlev1.UpdateType {
+	switch changeType {
+	case db.CONTENT_ADDED:
+		return vcmoodlev1.UpdateType_UPDATE_ADDED
+	case db.CONTENT_CHANGED:
+		return vcmoodlev1.UpdateType_UPDATE_CHANGED
+	case db.CONTENT_REMOVED:
+		return vcmoodlev1.UpdateType_UPDATE_REMOVED
+	default:
+		return -1
+	}
+}
+
+func (s Service) GetCourseUpdates(ctx context.Context, req *connect.Request[vcmoodlev1.GetCourseUpdatesRequest]) (*connect.Response[vcmoodlev1.GetCourseUpdatesResponse], error) {
+	if !s.enableCourses {
+		return &connect.Response[vcmoodlev1.GetCourseUpdatesResponse]{
+			Msg: &vcmoodlev1.GetCourseUpdatesResponse{
+				Updates: []*vcmoodlev1.CourseUpdate{},
+			},
+		}, nil
+	}
+
+	profile := verifier.ProfileFromContext(ctx)
+	dbCourses, err := s.getUserCourses(ctx, profile.Email)
+	if err != nil {
+		return nil, fmt.Errorf("getUserCourses: %w", err)
+	}
+	courseIds, names := courseNames(dbCourses)
+
+	rows, err := s.qry.GetContentChanges(ctx, db.GetContentChangesParams{
+		Time:      req.Msg.GetSince(),
+		CourseIds: courseIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*vcmoodlev1.CourseUpdate, 0, len(rows))
+	for _, r := range rows {
+		kind := pbContentKind(db.SearchKind(r.Kind))
+		updateType := pbUpdateType(db.ContentChangeType(r.Type))
+		if kind < 0 || updateType < 0 {
+			continue
+		}
+		diff := ""
+		if updateType == vcmoodlev1.UpdateType_UPDATE_CHANGED && r.Content != "" {
+			diff = htmlutil.Diff(r.PreviousContent, r.Content)
+		}
+		updates = append(updates, &vcmoodlev1.CourseUpdate{
+			Time:        r.Time,
+			Type:        updateType,
+			Kind:        kind,
+			CourseId:    r.CourseID,
+			CourseName:  names[r.CourseID],
+			SectionIdx:  r.SectionIdx,
+			ResourceIdx: r.ResourceIdx,
+			ChapterId:   r.ChapterID,
+			Name:        r.Name,
+			Url:         r.Url,
+			DiffHtml:    diff,
+		})
+	}
+
+	return &connect.Response[vcmoodlev1.GetCourseUpdatesResponse]{
+		Msg: &vcmoodlev1.GetCourseUpdatesResponse{
+			Updates: updates,
+		},
+	}, nil
+}
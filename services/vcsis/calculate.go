@@ -0,0 +1,141 @@
+package vcsis
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"vcassist-backend/lib/gradecalc"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	"vcassist-backend/services/auth/verifier"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func optionalFloat(value float64) *float32 {
+	out := float32(value)
+	return &out
+}
+
+// matchCategory maps a category given by the user to one of the weighted
+// categories of a course the same way AddWeights does for scraped
+// assignments, otherwise a slightly different name would leave the
+// assignment out of the weighted grade.
+func matchCategory(course *sisv1.CourseData, category string) string {
+	names := make([]string, len(course.GetAssignmentCategories()))
+	for i, c := range course.GetAssignmentCategories() {
+		if c.GetName() == category {
+			return category
+		}
+		names[i] = c.GetName()
+	}
+	mostSimilar := closestCategory(category, names)
+	if mostSimilar == "" {
+		return category
+	}
+	return mostSimilar
+}
+
+// applyWhatIf returns the assignments of a course with the edited
+// assignments replaced and the hypothetical assignments added.
+func applyWhatIf(course *sisv1.CourseData, edited, hypothetical []*sisv1.AssignmentData) ([]*sisv1.AssignmentData, error) {
+	assignments := make([]*sisv1.AssignmentData, len(course.GetAssignments()))
+	copy(assignments, course.GetAssignments())
+
+	for _, edit := range edited {
+		found := false
+		for i, a := range assignments {
+			if a.GetTitle() == edit.GetTitle() && a.GetDueDate() == edit.GetDueDate() {
+				assignments[i] = edit
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find assignment '%s' in course", edit.GetTitle())
+		}
+	}
+
+	for _, h := range hypothetical {
+		category := matchCategory(course, h.GetCategory())
+		if category != h.GetCategory() {
+			h = proto.Clone(h).(*sisv1.AssignmentData)
+			h.Category = category
+		}
+		assignments = append(assignments, h)
+	}
+	return assignments, nil
+}
+
+func (s Service) CalculateGrade(ctx context.Context, req *connect.Request[sisv1.CalculateGradeRequest]) (*connect.Response[sisv1.CalculateGradeResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	// stale data is fine here since the user is the one editing it anyways
+	row, err := s.qry.GetStudentData(ctx, profile.Email)
+	if err == sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no data cached, refresh your data first"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	data := &sisv1.Data{}
+	err = proto.Unmarshal(row.Data, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var course *sisv1.CourseData
+	for _, c := range data.GetCourses() {
+		if c.GetGuid() == req.Msg.GetCourseGuid() {
+			course = c
+			break
+		}
+	}
+	if course == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("could not find course '%s'", req.Msg.GetCourseGuid()))
+	}
+
+	assignments, err := applyWhatIf(course, req.Msg.GetEditedAssignments(), req.Msg.GetHypotheticalAssignments())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result := gradecalc.Calculate(course.GetAssignmentCategories(), assignments)
+
+	categories := make([]*sisv1.CategoryGrade, len(result.Categories))
+	for i, c := range result.Categories {
+		categories[i] = &sisv1.CategoryGrade{
+			Name:           c.Name,
+			Weight:         float32(c.Weight),
+			PointsEarned:   float32(c.PointsEarned),
+			PointsPossible: float32(c.PointsPossible),
+		}
+		if c.Graded() {
+			categories[i].Grade = optionalFloat(c.Grade())
+		}
+	}
+	res := &sisv1.CalculateGradeResponse{
+		Categories: categories,
+	}
+	if result.Graded {
+		res.WeightedGrade = optionalFloat(result.Weighted)
+		res.UnweightedGrade = optionalFloat(result.Unweighted)
+	}
+
+	if req.Msg.Needed != nil {
+		needed := req.Msg.GetNeeded()
+		points, err := gradecalc.NeededPoints(
+			course.GetAssignmentCategories(),
+			assignments,
+			matchCategory(course, needed.GetCategory()),
+			float64(needed.GetPointsPossible()),
+			float64(needed.GetTargetGrade()),
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		res.NeededPoints = optionalFloat(points)
+	}
+
+	return &connect.Response[sisv1.CalculateGradeResponse]{Msg: res}, nil
+}
@@ -0,0 +1,50 @@
+package vcsis
+
+import (
+	"testing"
+	"vcassist-backend/lib/gradecalc"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyWhatIfCategories(t *testing.T) {
+	course := &sisv1.CourseData{
+		AssignmentCategories: []*sisv1.AssignmentCategory{
+			{Name: "Tests", Weight: 0.6},
+			{Name: "Homework", Weight: 0.4},
+		},
+		Assignments: []*sisv1.AssignmentData{
+			{Title: "Unit 1 Test", Category: "Tests", PointsEarned: points(80), PointsPossible: points(100)},
+			{Title: "Worksheet", Category: "Homework", PointsEarned: points(10), PointsPossible: points(10)},
+		},
+	}
+	hypothetical := &sisv1.AssignmentData{
+		Title:          "Unit 2 Test",
+		Category:       "Test",
+		PointsEarned:   points(100),
+		PointsPossible: points(100),
+	}
+
+	assignments, err := applyWhatIf(course, nil, []*sisv1.AssignmentData{hypothetical})
+	require.NoError(t, err)
+	require.Len(t, assignments, 3)
+	require.Equal(t, "Tests", assignments[2].GetCategory())
+	// the request shouldn't be modified
+	require.Equal(t, "Test", hypothetical.GetCategory())
+
+	// the hypothetical test counts towards the weighted grade instead of
+	// ending up in a category without a weight
+	result := gradecalc.Calculate(course.GetAssignmentCategories(), assignments)
+	require.Len(t, result.Categories, 2)
+	require.InDelta(t, 0.6*90+0.4*100, result.Weighted, 0.001)
+
+	require.Equal(t, "Homework", matchCategory(course, "homework"))
+	require.Equal(t, "Tests", matchCategory(course, "Tests"))
+
+	// without weights the category is left alone
+	require.Equal(t, "Test", matchCategory(&sisv1.CourseData{}, "Test"))
+
+	_, err = applyWhatIf(course, []*sisv1.AssignmentData{{Title: "Unit 3 Test"}}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,217 @@
+package vcsis
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcsis/db"
+
+	"connectrpc.com/connect"
+)
+
+// overall grade changes smaller than this are ignored, this prevents
+// floating point noise from showing up as a change
+const overallGradeEpsilon = 0.01
+
+// assignments don't have ids, so they are identified by a combination
+// of their fields that shouldn't change when they are graded or moved
+type assignmentKey struct {
+	title    string
+	category string
+}
+
+func keyOfAssignment(a *sisv1.AssignmentData) assignmentKey {
+	return assignmentKey{
+		title:    a.GetTitle(),
+		category: a.GetCategory(),
+	}
+}
+
+// matchAssignments returns the previous version of every assignment in
+// next that also exists in prev. assignments that share a title and
+// category are matched by due date first, the ones left over are matched
+// in order so a moved due date still matches.
+func matchAssignments(prev, next []*sisv1.AssignmentData) map[*sisv1.AssignmentData]*sisv1.AssignmentData {
+	unmatched := make(map[assignmentKey][]*sisv1.AssignmentData, len(prev))
+	for _, a := range prev {
+		key := keyOfAssignment(a)
+		unmatched[key] = append(unmatched[key], a)
+	}
+
+	matched := make(map[*sisv1.AssignmentData]*sisv1.AssignmentData, len(next))
+	take := func(a *sisv1.AssignmentData, i int) {
+		key := keyOfAssignment(a)
+		candidates := unmatched[key]
+		matched[a] = candidates[i]
+		unmatched[key] = append(candidates[:i:i], candidates[i+1:]...)
+	}
+	for _, a := range next {
+		for i, candidate := range unmatched[keyOfAssignment(a)] {
+			if candidate.GetDueDate() == a.GetDueDate() {
+				take(a, i)
+				break
+			}
+		}
+	}
+	for _, a := range next {
+		_, ok := matched[a]
+		if !ok && len(unmatched[keyOfAssignment(a)]) > 0 {
+			take(a, 0)
+		}
+	}
+	return matched
+}
+
+func newChange(now time.Time, changeType sisv1.ChangeType, course *sisv1.CourseData, assignment string) *sisv1.Change {
+	return &sisv1.Change{
+		Time:       now.Unix(),
+		Type:       changeType,
+		CourseGuid: course.GetGuid(),
+		CourseName: course.GetName(),
+		Assignment: assignment,
+	}
+}
+
+// DiffData computes the changes between the previously cached data and
+// newly scraped data of a student. courses that did not exist previously
+// are skipped so that the first scrape of a course doesn't report every
+// assignment in it as new.
+func DiffData(prev, next *sisv1.Data, now time.Time) []*sisv1.Change {
+	if prev == nil || next == nil {
+		return nil
+	}
+
+	prevCourses := make(map[string]*sisv1.CourseData, len(prev.GetCourses()))
+	for _, c := range prev.GetCourses() {
+		prevCourses[c.GetGuid()] = c
+	}
+
+	var changes []*sisv1.Change
+	for _, course := range next.GetCourses() {
+		prevCourse, ok := prevCourses[course.GetGuid()]
+		if !ok {
+			continue
+		}
+
+		prevAssignments := matchAssignments(prevCourse.GetAssignments(), course.GetAssignments())
+		for _, a := range course.GetAssignments() {
+			prevAssignment, ok := prevAssignments[a]
+			if !ok {
+				change := newChange(now, sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT, course, a.GetTitle())
+				change.Current = a.PointsEarned
+				changes = append(changes, change)
+				continue
+			}
+
+			switch {
+			case prevAssignment.PointsEarned == nil && a.PointsEarned != nil:
+				change := newChange(now, sisv1.ChangeType_CHANGE_SCORE_POSTED, course, a.GetTitle())
+				change.Current = a.PointsEarned
+				changes = append(changes, change)
+			case prevAssignment.PointsEarned != nil && a.PointsEarned != nil &&
+				prevAssignment.GetPointsEarned() != a.GetPointsEarned():
+				change := newChange(now, sisv1.ChangeType_CHANGE_SCORE_CHANGED, course, a.GetTitle())
+				change.Previous = prevAssignment.PointsEarned
+				change.Current = a.PointsEarned
+				changes = append(changes, change)
+			case prevAssignment.PointsEarned != nil && a.PointsEarned == nil:
+				change := newChange(now, sisv1.ChangeType_CHANGE_SCORE_REMOVED, course, a.GetTitle())
+				change.Previous = prevAssignment.PointsEarned
+				changes = append(changes, change)
+			}
+
+			if prevAssignment.GetDueDate() != a.GetDueDate() {
+				prevDueDate := prevAssignment.GetDueDate()
+				dueDate := a.GetDueDate()
+				change := newChange(now, sisv1.ChangeType_CHANGE_DUE_DATE_CHANGED, course, a.GetTitle())
+				change.PreviousDueDate = &prevDueDate
+				change.CurrentDueDate = &dueDate
+				changes = append(changes, change)
+			}
+
+			if !prevAssignment.GetIsMissing() && a.GetIsMissing() {
+				changes = append(changes, newChange(now, sisv1.ChangeType_CHANGE_MARKED_MISSING, course, a.GetTitle()))
+			}
+			if !prevAssignment.GetIsLate() && a.GetIsLate() {
+				changes = append(changes, newChange(now, sisv1.ChangeType_CHANGE_MARKED_LATE, course, a.GetTitle()))
+			}
+		}
+
+		if math.Abs(float64(course.GetOverallGrade()-prevCourse.GetOverallGrade())) >= overallGradeEpsilon {
+			prevGrade := prevCourse.GetOverallGrade()
+			grade := course.GetOverallGrade()
+			change := newChange(now, sisv1.ChangeType_CHANGE_OVERALL_GRADE, course, "")
+			change.Previous = &prevGrade
+			change.Current = &grade
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+func nullFloat(value *float32) sql.NullFloat64 {
+	if value == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: float64(*value), Valid: true}
+}
+
+func floatFromNull(value sql.NullFloat64) *float32 {
+	if !value.Valid {
+		return nil
+	}
+	out := float32(value.Float64)
+	return &out
+}
+
+func nullInt(value *int64) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *value, Valid: true}
+}
+
+func intFromNull(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+func (s Service) GetChanges(ctx context.Context, req *connect.Request[sisv1.GetChangesRequest]) (*connect.Response[sisv1.GetChangesResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	rows, err := s.qry.GetChangeEvents(ctx, db.GetChangeEventsParams{
+		StudentID: profile.Email,
+		Since:     req.Msg.GetSince(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get change events: %w", err)
+	}
+
+	changes := make([]*sisv1.Change, len(rows))
+	for i, r := range rows {
+		changes[i] = &sisv1.Change{
+			Time:            r.Time,
+			Type:            sisv1.ChangeType(r.Type),
+			CourseGuid:      r.CourseGuid,
+			CourseName:      r.CourseName,
+			Assignment:      r.Assignment,
+			Previous:        floatFromNull(r.PreviousValue),
+			Current:         floatFromNull(r.CurrentValue),
+			PreviousDueDate: intFromNull(r.PreviousDueDate),
+			CurrentDueDate:  intFromNull(r.CurrentDueDate),
+		}
+	}
+
+	return &connect.Response[sisv1.GetChangesResponse]{
+		Msg: &sisv1.GetChangesResponse{
+			Changes: changes,
+		},
+	}, nil
+}
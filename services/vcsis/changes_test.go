@@ -0,0 +1,219 @@
+package vcsis
+
+import (
+	"testing"
+	"time"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func points(value float32) *float32 {
+	return &value
+}
+
+func timestamp(value int64) *int64 {
+	return &value
+}
+
+func TestDiffData(t *testing.T) {
+	now := time.Unix(1727000000, 0)
+
+	course := func(grade float32, assignments ...*sisv1.AssignmentData) *sisv1.Data {
+		return &sisv1.Data{
+			Courses: []*sisv1.CourseData{
+				{
+					Guid:         "bio",
+					Name:         "Biology",
+					OverallGrade: grade,
+					Assignments:  assignments,
+				},
+			},
+		}
+	}
+	change := func(changeType sisv1.ChangeType, assignment string) *sisv1.Change {
+		return &sisv1.Change{
+			Time:       now.Unix(),
+			Type:       changeType,
+			CourseGuid: "bio",
+			CourseName: "Biology",
+			Assignment: assignment,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		prev     *sisv1.Data
+		next     *sisv1.Data
+		expected []*sisv1.Change
+	}{
+		{
+			name: "first scrape",
+			prev: nil,
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+		},
+		{
+			name: "new course",
+			prev: &sisv1.Data{},
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+		},
+		{
+			name: "no changes",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(9)}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(9)}),
+		},
+		{
+			name: "new assignment",
+			prev: course(90),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(9)}),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT, "Lab")
+					c.Current = points(9)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "score posted",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(9)}),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_SCORE_POSTED, "Lab")
+					c.Current = points(9)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "score changed",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(7)}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(9)}),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_SCORE_CHANGED, "Lab")
+					c.Previous = points(7)
+					c.Current = points(9)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "score removed",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, PointsEarned: points(7)}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_SCORE_REMOVED, "Lab")
+					c.Previous = points(7)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "marked missing",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, IsMissing: true}),
+			expected: []*sisv1.Change{
+				change(sisv1.ChangeType_CHANGE_MARKED_MISSING, "Lab"),
+			},
+		},
+		{
+			name: "already late",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, IsLate: true}),
+			next: course(90,
+				&sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, IsLate: true},
+				&sisv1.AssignmentData{Title: "Essay", Category: "Writing", DueDate: 100},
+			),
+			expected: []*sisv1.Change{
+				change(sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT, "Essay"),
+			},
+		},
+		{
+			name: "marked late",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100, IsLate: true}),
+			expected: []*sisv1.Change{
+				change(sisv1.ChangeType_CHANGE_MARKED_LATE, "Lab"),
+			},
+		},
+		{
+			name: "overall grade",
+			prev: course(90),
+			next: course(87.5),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_OVERALL_GRADE, "")
+					c.Previous = points(90)
+					c.Current = points(87.5)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "overall grade noise",
+			prev: course(90),
+			next: course(90.001),
+		},
+		{
+			name: "due date moved",
+			prev: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 100}),
+			next: course(90, &sisv1.AssignmentData{Title: "Lab", Category: "Labs", DueDate: 200}),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_DUE_DATE_CHANGED, "Lab")
+					c.PreviousDueDate = timestamp(100)
+					c.CurrentDueDate = timestamp(200)
+					return c
+				}(),
+			},
+		},
+		{
+			name: "same title different due dates",
+			prev: course(90,
+				&sisv1.AssignmentData{Title: "Quiz", Category: "Quizzes", DueDate: 100, PointsEarned: points(8)},
+				&sisv1.AssignmentData{Title: "Quiz", Category: "Quizzes", DueDate: 200},
+			),
+			next: course(90,
+				&sisv1.AssignmentData{Title: "Quiz", Category: "Quizzes", DueDate: 100, PointsEarned: points(8)},
+				&sisv1.AssignmentData{Title: "Quiz", Category: "Quizzes", DueDate: 200, PointsEarned: points(10)},
+				&sisv1.AssignmentData{Title: "Quiz", Category: "Quizzes", DueDate: 300},
+			),
+			expected: []*sisv1.Change{
+				func() *sisv1.Change {
+					c := change(sisv1.ChangeType_CHANGE_SCORE_POSTED, "Quiz")
+					c.Current = points(10)
+					return c
+				}(),
+				change(sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT, "Quiz"),
+			},
+		},
+		{
+			name: "same title in another category",
+			prev: course(90, &sisv1.AssignmentData{Title: "Chapter 4", Category: "Quizzes", DueDate: 100}),
+			next: course(90,
+				&sisv1.AssignmentData{Title: "Chapter 4", Category: "Quizzes", DueDate: 100},
+				&sisv1.AssignmentData{Title: "Chapter 4", Category: "Tests", DueDate: 100},
+			),
+			expected: []*sisv1.Change{
+				change(sisv1.ChangeType_CHANGE_NEW_ASSIGNMENT, "Chapter 4"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes := DiffData(c.prev, c.next, now)
+			require.Len(t, changes, len(c.expected))
+			for i, expected := range c.expected {
+				require.True(
+					t, proto.Equal(expected, changes[i]),
+					"expected %s, got %s", prototext.Format(expected), prototext.Format(changes[i]),
+				)
+			}
+		})
+	}
+}
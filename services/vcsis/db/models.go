@@ -5,11 +5,37 @@
 package db
 
 import (
+	"database/sql"
 	"time"
 )
 
+type ChangeEvent struct {
+	ID              int64
+	StudentID       string
+	Time            int64
+	Type            int64
+	CourseGuid      string
+	CourseName      string
+	Assignment      string
+	PreviousValue   sql.NullFloat64
+	CurrentValue    sql.NullFloat64
+	PreviousDueDate sql.NullInt64
+	CurrentDueDate  sql.NullInt64
+}
+
 type StudentDatum struct {
 	StudentID   string
 	Data        []byte
 	LastUpdated time.Time
 }
+
+type TranscriptTerm struct {
+	StudentID  string
+	CourseGuid string
+	CourseName string
+	SchoolYear int64
+	Start      int64
+	Stop       int64
+	Percent    float64
+	InProgress bool
+}
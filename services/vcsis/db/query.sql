@@ -11,3 +11,39 @@ where student_id = ?;
 -- name: GetAllStudents :many
 select student_id from StudentData;
 
+-- name: CreateChangeEvent :exec
+insert into ChangeEvent(
+    student_id, time, type,
+    course_guid, course_name, assignment,
+    previous_value, current_value,
+    previous_due_date, current_due_date
+) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetChangeEvents :many
+select * from ChangeEvent
+where student_id = sqlc.arg(student_id) and time > sqlc.arg(since)
+order by time asc, id asc;
+
+-- name: DeleteChangeEventsBefore :exec
+delete from ChangeEvent where time < ?;
+
+
+-- name: SaveTranscriptTerm :exec
+insert into TranscriptTerm(
+    student_id, course_guid, course_name, school_year,
+    start, stop, percent, in_progress
+) values (?, ?, ?, ?, ?, ?, ?, ?)
+on conflict do update
+    set course_name = excluded.course_name,
+        school_year = excluded.school_year,
+        percent = excluded.percent,
+        in_progress = excluded.in_progress;
+
+-- name: DeleteTranscriptTerm :exec
+delete from TranscriptTerm
+where student_id = ? and course_guid = ? and start = ? and stop = ?;
+
+-- name: GetTranscriptTerms :many
+select * from TranscriptTerm
+where student_id = ?
+order by school_year asc, start asc, course_name asc;
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
@@ -28,6 +29,75 @@ func (q *Queries) CacheStudentData(ctx context.Context, arg CacheStudentDataPara
 	return err
 }
 
+const createChangeEvent = `-- name: CreateChangeEvent :exec
+insert into ChangeEvent(
+    student_id, time, type,
+    course_guid, course_name, assignment,
+    previous_value, current_value,
+    previous_due_date, current_due_date
+) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateChangeEventParams struct {
+	StudentID       string
+	Time            int64
+	Type            int64
+	CourseGuid      string
+	CourseName      string
+	Assignment      string
+	PreviousValue   sql.NullFloat64
+	CurrentValue    sql.NullFloat64
+	PreviousDueDate sql.NullInt64
+	CurrentDueDate  sql.NullInt64
+}
+
+func (q *Queries) CreateChangeEvent(ctx context.Context, arg CreateChangeEventParams) error {
+	_, err := q.db.ExecContext(ctx, createChangeEvent,
+		arg.StudentID,
+		arg.Time,
+		arg.Type,
+		arg.CourseGuid,
+		arg.CourseName,
+		arg.Assignment,
+		arg.PreviousValue,
+		arg.CurrentValue,
+		arg.PreviousDueDate,
+		arg.CurrentDueDate,
+	)
+	return err
+}
+
+const deleteChangeEventsBefore = `-- name: DeleteChangeEventsBefore :exec
+delete from ChangeEvent where time < ?
+`
+
+func (q *Queries) DeleteChangeEventsBefore(ctx context.Context, time int64) error {
+	_, err := q.db.ExecContext(ctx, deleteChangeEventsBefore, time)
+	return err
+}
+
+const deleteTranscriptTerm = `-- name: DeleteTranscriptTerm :exec
+delete from TranscriptTerm
+where student_id = ? and course_guid = ? and start = ? and stop = ?
+`
+
+type DeleteTranscriptTermParams struct {
+	StudentID  string
+	CourseGuid string
+	Start      int64
+	Stop       int64
+}
+
+func (q *Queries) DeleteTranscriptTerm(ctx context.Context, arg DeleteTranscriptTermParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTranscriptTerm,
+		arg.StudentID,
+		arg.CourseGuid,
+		arg.Start,
+		arg.Stop,
+	)
+	return err
+}
+
 const getAllStudents = `-- name: GetAllStudents :many
 select student_id from StudentData
 `
@@ -55,6 +125,52 @@ func (q *Queries) GetAllStudents(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
+const getChangeEvents = `-- name: GetChangeEvents :many
+select id, student_id, time, type, course_guid, course_name, assignment, previous_value, current_value, previous_due_date, current_due_date from ChangeEvent
+where student_id = ?1 and time > ?2
+order by time asc, id asc
+`
+
+type GetChangeEventsParams struct {
+	StudentID string
+	Since     int64
+}
+
+func (q *Queries) GetChangeEvents(ctx context.Context, arg GetChangeEventsParams) ([]ChangeEvent, error) {
+	rows, err := q.db.QueryContext(ctx, getChangeEvents, arg.StudentID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeEvent
+	for rows.Next() {
+		var i ChangeEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.StudentID,
+			&i.Time,
+			&i.Type,
+			&i.CourseGuid,
+			&i.CourseName,
+			&i.Assignment,
+			&i.PreviousValue,
+			&i.CurrentValue,
+			&i.PreviousDueDate,
+			&i.CurrentDueDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStudentData = `-- name: GetStudentData :one
 select data, last_updated from StudentData
 where student_id = ?
@@ -71,3 +187,78 @@ func (q *Queries) GetStudentData(ctx context.Context, studentID string) (GetStud
 	err := row.Scan(&i.Data, &i.LastUpdated)
 	return i, err
 }
+
+const getTranscriptTerms = `-- name: GetTranscriptTerms :many
+select student_id, course_guid, course_name, school_year, start, stop, percent, in_progress from TranscriptTerm
+where student_id = ?
+order by school_year asc, start asc, course_name asc
+`
+
+func (q *Queries) GetTranscriptTerms(ctx context.Context, studentID string) ([]TranscriptTerm, error) {
+	rows, err := q.db.QueryContext(ctx, getTranscriptTerms, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TranscriptTerm
+	for rows.Next() {
+		var i TranscriptTerm
+		if err := rows.Scan(
+			&i.StudentID,
+			&i.CourseGuid,
+			&i.CourseName,
+			&i.SchoolYear,
+			&i.Start,
+			&i.Stop,
+			&i.Percent,
+			&i.InProgress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveTranscriptTerm = `-- name: SaveTranscriptTerm :exec
+insert into TranscriptTerm(
+    student_id, course_guid, course_name, school_year,
+    start, stop, percent, in_progress
+) values (?, ?, ?, ?, ?, ?, ?, ?)
+on conflict do update
+    set course_name = excluded.course_name,
+        school_year = excluded.school_year,
+        percent = excluded.percent,
+        in_progress = excluded.in_progress
+`
+
+type SaveTranscriptTermParams struct {
+	StudentID  string
+	CourseGuid string
+	CourseName string
+	SchoolYear int64
+	Start      int64
+	Stop       int64
+	Percent    float64
+	InProgress bool
+}
+
+func (q *Queries) SaveTranscriptTerm(ctx context.Context, arg SaveTranscriptTermParams) error {
+	_, err := q.db.ExecContext(ctx, saveTranscriptTerm,
+		arg.StudentID,
+		arg.CourseGuid,
+		arg.CourseName,
+		arg.SchoolYear,
+		arg.Start,
+		arg.Stop,
+		arg.Percent,
+		arg.InProgress,
+	)
+	return err
+}
@@ -3,3 +3,39 @@ create table StudentData (
     data blob not null,
     last_updated datetime not null
 );
+
+-- changes detected between the previously cached and newly scraped
+-- data of a student
+create table ChangeEvent (
+    id integer not null primary key autoincrement,
+    student_id text not null,
+    time integer not null,
+    -- this corresponds to the values of sisv1.ChangeType
+    type integer not null,
+    course_guid text not null,
+    course_name text not null,
+    -- this is empty for changes that aren't about an assignment
+    assignment text not null,
+    previous_value real,
+    current_value real,
+    -- these are only set for due date changes
+    previous_due_date integer,
+    current_due_date integer
+);
+
+create index ChangeEvent_student_time on ChangeEvent(student_id, time);
+
+-- the final grade of a course for a single term, these are kept across
+-- school years to build the transcript of a student
+create table TranscriptTerm (
+    student_id text not null,
+    course_guid text not null,
+    course_name text not null,
+    -- the year the school year containing this term starts in
+    school_year integer not null,
+    start integer not null,
+    stop integer not null,
+    percent real not null,
+    in_progress boolean not null,
+    primary key (student_id, course_guid, start, stop)
+);
@@ -0,0 +1,53 @@
+package vcsis
+
+import (
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+)
+
+// progressFunc is called at the start and end of every phase of a refresh,
+// a nil progressFunc can be used when progress does not need to be reported.
+type progressFunc func(event *sisv1.RefreshPhaseEvent)
+
+// phasePercent returns how much of a refresh is done at the start or end
+// of a phase, phases run in the order they are declared in.
+func phasePercent(phase sisv1.RefreshPhase, done bool) int32 {
+	completed := int32(phase)
+	if done {
+		completed++
+	}
+	return completed * 100 / int32(len(sisv1.RefreshPhase_name))
+}
+
+func (p progressFunc) start(phase sisv1.RefreshPhase) {
+	if p == nil {
+		return
+	}
+	p(&sisv1.RefreshPhaseEvent{
+		Phase:   phase,
+		Status:  sisv1.PhaseStatus_STATUS_STARTED,
+		Percent: phasePercent(phase, false),
+	})
+}
+
+// end reports the phase as completed or failed depending on err, it
+// returns err as-is so it can be used inline.
+func (p progressFunc) end(phase sisv1.RefreshPhase, err error) error {
+	if p == nil {
+		return err
+	}
+	if err != nil {
+		p(&sisv1.RefreshPhaseEvent{
+			Phase:   phase,
+			Status:  sisv1.PhaseStatus_STATUS_FAILED,
+			Error:   err.Error(),
+			Percent: phasePercent(phase, true),
+		})
+		return err
+	}
+	p(&sisv1.RefreshPhaseEvent{
+		Phase:   phase,
+		Status:  sisv1.PhaseStatus_STATUS_COMPLETED,
+		Percent: phasePercent(phase, true),
+	})
+	return nil
+}
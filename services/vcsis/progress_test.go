@@ -0,0 +1,87 @@
+package vcsis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	keychainv1 "vcassist-backend/proto/vcassist/services/keychain/v1"
+	"vcassist-backend/proto/vcassist/services/keychain/v1/keychainv1connect"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	var events []*sisv1.RefreshPhaseEvent
+	var progress progressFunc = func(event *sisv1.RefreshPhaseEvent) {
+		events = append(events, event)
+	}
+
+	failed := fmt.Errorf("no course meetings")
+	for phase := sisv1.RefreshPhase_PHASE_KEYCHAIN; phase <= sisv1.RefreshPhase_PHASE_CACHE; phase++ {
+		progress.start(phase)
+		var err error
+		if phase == sisv1.RefreshPhase_PHASE_GET_COURSE_MEETINGS {
+			err = failed
+		}
+		require.Equal(t, err, progress.end(phase, err))
+	}
+
+	require.Len(t, events, len(sisv1.RefreshPhase_name)*2)
+	require.Equal(t, int32(0), events[0].GetPercent())
+	require.Equal(t, int32(100), events[len(events)-1].GetPercent())
+	for i, event := range events {
+		require.Equal(t, sisv1.RefreshPhase(i/2), event.GetPhase())
+		if i%2 == 0 {
+			require.Equal(t, sisv1.PhaseStatus_STATUS_STARTED, event.GetStatus())
+		} else if event.GetPhase() == sisv1.RefreshPhase_PHASE_GET_COURSE_MEETINGS {
+			require.Equal(t, sisv1.PhaseStatus_STATUS_FAILED, event.GetStatus())
+			require.Equal(t, failed.Error(), event.GetError())
+		} else {
+			require.Equal(t, sisv1.PhaseStatus_STATUS_COMPLETED, event.GetStatus())
+			require.Empty(t, event.GetError())
+		}
+		if i > 0 {
+			require.GreaterOrEqual(t, event.GetPercent(), events[i-1].GetPercent())
+		}
+		if i%2 == 1 {
+			// a phase always moves the progress forward
+			require.Greater(t, event.GetPercent(), events[i-1].GetPercent())
+		}
+	}
+
+	// a nil progressFunc just passes errors through
+	var none progressFunc
+	none.start(sisv1.RefreshPhase_PHASE_KEYCHAIN)
+	require.Equal(t, failed, none.end(sisv1.RefreshPhase_PHASE_KEYCHAIN, failed))
+	require.NoError(t, none.end(sisv1.RefreshPhase_PHASE_KEYCHAIN, nil))
+}
+
+type missingKeychain struct {
+	keychainv1connect.KeychainServiceClient
+}
+
+func (missingKeychain) GetOAuth(context.Context, *connect.Request[keychainv1.GetOAuthRequest]) (*connect.Response[keychainv1.GetOAuthResponse], error) {
+	return connect.NewResponse(&keychainv1.GetOAuthResponse{}), nil
+}
+
+func TestProgressFailedPhase(t *testing.T) {
+	service := Service{keychain: missingKeychain{}}
+
+	var events []*sisv1.RefreshPhaseEvent
+	_, err := service.scrapeWithProgress(context.Background(), "student@example.com", func(event *sisv1.RefreshPhaseEvent) {
+		events = append(events, event)
+	})
+	require.Error(t, err)
+
+	// the refresh stops at the phase that failed
+	require.Len(t, events, 2)
+	require.Equal(t, sisv1.RefreshPhase_PHASE_KEYCHAIN, events[0].GetPhase())
+	require.Equal(t, sisv1.PhaseStatus_STATUS_STARTED, events[0].GetStatus())
+	require.Equal(t, int32(0), events[0].GetPercent())
+	require.Equal(t, sisv1.RefreshPhase_PHASE_KEYCHAIN, events[1].GetPhase())
+	require.Equal(t, sisv1.PhaseStatus_STATUS_FAILED, events[1].GetStatus())
+	require.NotEmpty(t, events[1].GetError())
+	require.Greater(t, events[1].GetPercent(), int32(0))
+}
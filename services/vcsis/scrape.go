@@ -22,21 +22,27 @@ import (
 const distinctionMarker = "​"
 
 func ScrapePowerschool(ctx context.Context, client *powerschool.Client) (*sisv1.Data, error) {
+	return scrapePowerschool(ctx, client, nil)
+}
+
+func scrapePowerschool(ctx context.Context, client *powerschool.Client, progress progressFunc) (*sisv1.Data, error) {
+	progress.start(sisv1.RefreshPhase_PHASE_GET_ALL_STUDENTS)
 	allStudents, err := client.GetAllStudents(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if len(allStudents.Profiles) == 0 {
-		return nil, fmt.Errorf(
+	if err == nil && len(allStudents.Profiles) == 0 {
+		err = fmt.Errorf(
 			"could not find student profile, are your credentials expired?",
 		)
 	}
+	if progress.end(sisv1.RefreshPhase_PHASE_GET_ALL_STUDENTS, err) != nil {
+		return nil, err
+	}
 
 	psStudent := allStudents.Profiles[0]
+	progress.start(sisv1.RefreshPhase_PHASE_GET_STUDENT_DATA)
 	studentData, err := client.GetStudentData(ctx, scraper.GetStudentDataRequest{
 		Guid: psStudent.Guid,
 	})
-	if err != nil {
+	if progress.end(sisv1.RefreshPhase_PHASE_GET_STUDENT_DATA, err) != nil {
 		return nil, err
 	}
 
@@ -72,12 +78,13 @@ func ScrapePowerschool(ctx context.Context, client *powerschool.Client) (*sisv1.
 	start, stop := timezone.GetCurrentWeek(timezone.Now())
 
 	slog.Debug("powerschool CourseMeeting range", "start", start, "stop", stop)
+	progress.start(sisv1.RefreshPhase_PHASE_GET_COURSE_MEETINGS)
 	res, err := client.GetCourseMeetingList(ctx, scraper.GetCourseMeetingListRequest{
 		CourseGuids: guids,
 		Start:       start.Format(time.RFC3339),
 		Stop:        stop.Format(time.RFC3339),
 	})
-	if err != nil {
+	if progress.end(sisv1.RefreshPhase_PHASE_GET_COURSE_MEETINGS, err) != nil {
 		slog.WarnContext(
 			ctx,
 			"fetch course meetings",
@@ -125,6 +132,23 @@ func AddGradeSnapshots(ctx context.Context, courseData []*sisv1.CourseData, seri
 // map[CourseName]map[CategoryName]<weight value: 0-1>
 type WeightData = map[string]map[string]float32
 
+// closestCategory returns the category out of categories that is the most
+// similar to name, the category names powerschool gives assignments don't
+// always match the names in the weight data exactly. an empty string is
+// returned if there are no categories.
+func closestCategory(name string, categories []string) string {
+	mostSimilar := ""
+	var similarity float64
+	for _, target := range categories {
+		sim := matchr.JaroWinkler(name, target, false)
+		if sim > similarity {
+			similarity = sim
+			mostSimilar = target
+		}
+	}
+	return mostSimilar
+}
+
 func AddWeights(
 	ctx context.Context,
 	courseData []*sisv1.CourseData,
@@ -157,12 +181,14 @@ func AddWeights(
 		}
 
 		out := make([]*sisv1.AssignmentCategory, len(categories))
+		names := make([]string, len(categories))
 		i := 0
 		for category, weight := range categories {
 			out[i] = &sisv1.AssignmentCategory{
 				Name:   category,
 				Weight: weight,
 			}
+			names[i] = category
 			i++
 		}
 		target.AssignmentCategories = out
@@ -172,16 +198,7 @@ func AddWeights(
 			if ok {
 				continue
 			}
-
-			mostSimilar := ""
-			var similarity float64
-			for target := range categories {
-				sim := matchr.JaroWinkler(a.GetCategory(), target, false)
-				if sim > similarity {
-					similarity = sim
-					mostSimilar = target
-				}
-			}
+			mostSimilar := closestCategory(a.GetCategory(), names)
 			if mostSimilar != "" {
 				a.Category = mostSimilar
 			}
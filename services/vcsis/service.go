@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 	"vcassist-backend/lib/gradestore"
 	scraper "vcassist-backend/lib/scrapers/powerschool"
 	"vcassist-backend/lib/timezone"
@@ -27,15 +28,24 @@ import (
 
 const keychainNamespace = "vcsis"
 
+// Notifier is told about the changes detected whenever new data is cached
+// for a student.
+type Notifier interface {
+	NotifyChanges(ctx context.Context, studentId string, changes []*sisv1.Change) error
+}
+
 type Service struct {
 	baseUrl           string
 	oauth             OAuthConfig
 	keychain          keychainv1connect.KeychainServiceClient
 	linker            linkerv1connect.LinkerServiceClient
 	gradestore        gradestore.Store
+	notifier          Notifier
+	db                *sql.DB
 	qry               *db.Queries
 	weightData        WeightData
 	weightCourseNames []string
+	gpaBumps          GPABumps
 }
 
 type ServiceOptions struct {
@@ -45,6 +55,9 @@ type ServiceOptions struct {
 	BaseUrl    string
 	OAuth      OAuthConfig
 	WeightData WeightData
+	GPABumps   GPABumps
+	// optional, if this is nil changes are still recorded but nobody is notified
+	Notifier Notifier
 }
 
 func NewService(opts ServiceOptions) Service {
@@ -69,6 +82,7 @@ func NewService(opts ServiceOptions) Service {
 	}
 
 	s := Service{
+		db:                opts.Database,
 		qry:               db.New(opts.Database),
 		gradestore:        gradestore.NewStore(opts.Database),
 		linker:            opts.Linker,
@@ -77,10 +91,13 @@ func NewService(opts ServiceOptions) Service {
 		keychain:          opts.Keychain,
 		weightData:        opts.WeightData,
 		weightCourseNames: weightCourseNames,
+		notifier:          opts.Notifier,
+		gpaBumps:          opts.GPABumps,
 	}
 
 	go s.gradeSnapshotDaemon(context.Background())
 	go s.preloadStudentDataDaemon(context.Background())
+	go s.deleteOldChangesDaemon(context.Background())
 
 	return s
 }
@@ -184,20 +201,107 @@ func (s Service) getCachedData(ctx context.Context, studentId string) (*sisv1.Da
 	return data, err
 }
 
+// cacheNewData replaces the cached data of a student, recording the
+// changes between the previously cached data and the new data. the
+// current GPA in the profile of data is replaced with the one computed
+// from the transcript.
 func (s Service) cacheNewData(ctx context.Context, studentId string, data *sisv1.Data) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	txqry := s.qry.WithTx(tx)
+
+	now := timezone.Now()
+
+	var changes []*sisv1.Change
+	row, err := txqry.GetStudentData(ctx, studentId)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		prev := &sisv1.Data{}
+		err = proto.Unmarshal(row.Data, prev)
+		if err != nil {
+			slog.WarnContext(ctx, "unmarshal previous data", "student_id", studentId, "err", err)
+			prev = nil
+		}
+
+		changes = DiffData(prev, data, now)
+		for _, change := range changes {
+			err = txqry.CreateChangeEvent(ctx, db.CreateChangeEventParams{
+				StudentID:       studentId,
+				Time:            change.GetTime(),
+				Type:            int64(change.GetType()),
+				CourseGuid:      change.GetCourseGuid(),
+				CourseName:      change.GetCourseName(),
+				Assignment:      change.GetAssignment(),
+				PreviousValue:   nullFloat(change.Previous),
+				CurrentValue:    nullFloat(change.Current),
+				PreviousDueDate: nullInt(change.PreviousDueDate),
+				CurrentDueDate:  nullInt(change.CurrentDueDate),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = saveTranscript(ctx, txqry, studentId, data, now)
+	if err != nil {
+		return err
+	}
+	gpa, err := s.currentGPA(ctx, txqry, studentId)
+	if err != nil {
+		return err
+	}
+	if gpa != nil && data.GetProfile() != nil {
+		data.Profile.CurrentGpa = *gpa
+	}
+
 	marshaled, err := proto.Marshal(data)
 	if err != nil {
 		return err
 	}
-	err = s.qry.CacheStudentData(ctx, db.CacheStudentDataParams{
+
+	err = txqry.CacheStudentData(ctx, db.CacheStudentDataParams{
 		StudentID:   studentId,
 		Data:        marshaled,
-		LastUpdated: timezone.Now(),
+		LastUpdated: now,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if s.notifier != nil && len(changes) > 0 {
+		// notifying can involve sending requests to push services, which
+		// shouldn't hold up the response
+		go s.notifyChanges(context.WithoutCancel(ctx), studentId, changes)
+	}
+	return nil
+}
+
+func (s Service) notifyChanges(ctx context.Context, studentId string, changes []*sisv1.Change) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	err := s.notifier.NotifyChanges(ctx, studentId, changes)
+	if err != nil {
+		slog.ErrorContext(ctx, "notify changes", "student_id", studentId, "err", err)
+	}
 }
 
 func (s Service) scrape(ctx context.Context, studentId string) (*sisv1.Data, error) {
+	return s.scrapeWithProgress(ctx, studentId, nil)
+}
+
+func (s Service) getOAuthToken(ctx context.Context, studentId string) (string, error) {
 	res, err := s.keychain.GetOAuth(ctx, &connect.Request[keychainv1.GetOAuthRequest]{
 		Msg: &keychainv1.GetOAuthRequest{
 			Namespace: keychainNamespace,
@@ -205,26 +309,42 @@ func (s Service) scrape(ctx context.Context, studentId string) (*sisv1.Data, err
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("keychain: %w", err)
+		return "", fmt.Errorf("keychain: %w", err)
 	}
 	if res.Msg.GetKey() == nil {
-		return nil, fmt.Errorf("no oauth credentials provided")
+		return "", fmt.Errorf("no oauth credentials provided")
 	}
+	return res.Msg.GetKey().GetToken(), nil
+}
 
+func (s Service) scrapeWithProgress(ctx context.Context, studentId string, progress progressFunc) (*sisv1.Data, error) {
+	progress.start(sisv1.RefreshPhase_PHASE_KEYCHAIN)
+	token, err := s.getOAuthToken(ctx, studentId)
+	if progress.end(sisv1.RefreshPhase_PHASE_KEYCHAIN, err) != nil {
+		return nil, err
+	}
+
+	progress.start(sisv1.RefreshPhase_PHASE_LOGIN)
 	client, err := scraper.NewClient(s.baseUrl)
 	if err != nil {
-		return nil, fmt.Errorf("powerschool client constructor: %w", err)
+		err = fmt.Errorf("powerschool client constructor: %w", err)
+		progress.end(sisv1.RefreshPhase_PHASE_LOGIN, err)
+		return nil, err
 	}
-	_, err = client.LoginOAuth(ctx, res.Msg.GetKey().GetToken())
+	_, err = client.LoginOAuth(ctx, token)
 	if err != nil {
-		return nil, fmt.Errorf("oauth login: %w", err)
+		err = fmt.Errorf("oauth login: %w", err)
+		progress.end(sisv1.RefreshPhase_PHASE_LOGIN, err)
+		return nil, err
 	}
+	progress.end(sisv1.RefreshPhase_PHASE_LOGIN, nil)
 
-	data, err := ScrapePowerschool(ctx, client)
+	data, err := scrapePowerschool(ctx, client, progress)
 	if err != nil {
 		return nil, fmt.Errorf("scraping: %w", err)
 	}
 
+	progress.start(sisv1.RefreshPhase_PHASE_GRADE_SNAPSHOTS)
 	series, err := s.gradestore.Pull(ctx, studentId)
 	if err != nil {
 		slog.WarnContext(ctx, "pull grade snapshots", "err", err)
@@ -232,6 +352,7 @@ func (s Service) scrape(ctx context.Context, studentId string) (*sisv1.Data, err
 	if len(series) > 0 {
 		AddGradeSnapshots(ctx, data.GetCourses(), series)
 	}
+	progress.end(sisv1.RefreshPhase_PHASE_GRADE_SNAPSHOTS, err)
 
 	courseNames := make([]string, len(data.GetCourses()))
 	for i, c := range data.GetCourses() {
@@ -243,6 +364,7 @@ func (s Service) scrape(ctx context.Context, studentId string) (*sisv1.Data, err
 		}
 		courseNames[i] = name
 	}
+	progress.start(sisv1.RefreshPhase_PHASE_LINK_WEIGHTS)
 	linkRes, err := s.linker.Link(ctx, &connect.Request[linkerv1.LinkRequest]{
 		Msg: &linkerv1.LinkRequest{
 			Src: &linkerv1.Set{
@@ -261,6 +383,7 @@ func (s Service) scrape(ctx context.Context, studentId string) (*sisv1.Data, err
 		slog.DebugContext(ctx, "linked powerschool -> weights", "mapping", linkRes.Msg.GetSrcToDst())
 		AddWeights(ctx, data.GetCourses(), s.weightData, linkRes.Msg.GetSrcToDst())
 	}
+	progress.end(sisv1.RefreshPhase_PHASE_LINK_WEIGHTS, err)
 
 	return data, nil
 }
@@ -313,3 +436,41 @@ func (s Service) RefreshData(ctx context.Context, req *connect.Request[sisv1.Ref
 		Data: data,
 	}}, nil
 }
+
+func (s Service) RefreshDataStream(ctx context.Context, req *connect.Request[sisv1.RefreshDataStreamRequest], stream *connect.ServerStream[sisv1.RefreshDataStreamResponse]) error {
+	profile := verifier.ProfileFromContext(ctx)
+	studentId := profile.Email
+
+	var sendErr error
+	var progress progressFunc = func(event *sisv1.RefreshPhaseEvent) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&sisv1.RefreshDataStreamResponse{
+			Event: &sisv1.RefreshDataStreamResponse_Phase{
+				Phase: event,
+			},
+		})
+		if sendErr != nil {
+			slog.WarnContext(ctx, "send refresh phase event", "err", sendErr)
+		}
+	}
+
+	data, err := s.scrapeWithProgress(ctx, studentId, progress)
+	if err != nil {
+		return err
+	}
+
+	progress.start(sisv1.RefreshPhase_PHASE_CACHE)
+	err = s.cacheNewData(ctx, studentId, data)
+	if err != nil {
+		slog.WarnContext(ctx, "cache student data response", "err", err)
+	}
+	progress.end(sisv1.RefreshPhase_PHASE_CACHE, err)
+
+	return stream.Send(&sisv1.RefreshDataStreamResponse{
+		Event: &sisv1.RefreshDataStreamResponse_Data{
+			Data: data,
+		},
+	})
+}
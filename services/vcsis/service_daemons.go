@@ -126,3 +126,22 @@ func (s Service) preloadStudentDataDaemon(ctx context.Context) {
 		}
 	}
 }
+
+func (s Service) deleteOldChangesDaemon(ctx context.Context) {
+	slog.InfoContext(ctx, "start daemon", "task", "delete change events older than 90 days every day")
+
+	ticker := time.NewTicker(time.Hour * 24)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cutoff := timezone.Now().Add(-time.Hour * 24 * 90)
+			err := s.qry.DeleteChangeEventsBefore(ctx, cutoff.Unix())
+			if err != nil {
+				slog.ErrorContext(ctx, "delete old change events", "err", err)
+			}
+		}
+	}
+}
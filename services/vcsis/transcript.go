@@ -0,0 +1,165 @@
+package vcsis
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"vcassist-backend/lib/gradecalc"
+	"vcassist-backend/lib/scrapers/vcsnet"
+	"vcassist-backend/lib/timezone"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	"vcassist-backend/services/auth/verifier"
+	"vcassist-backend/services/vcsis/db"
+
+	"connectrpc.com/connect"
+)
+
+// map[Keyword]<points added to the weighted GPA>, keywords are matched
+// against whole words in course names, ex. {"AP": 1, "Honors": 0.5}
+type GPABumps = map[string]float32
+
+// saveTranscript saves the final grades of every term that has started for
+// all the courses in data. terms without a final grade are left out since
+// they would otherwise count as a 0 in the GPA.
+func saveTranscript(ctx context.Context, txqry *db.Queries, studentId string, data *sisv1.Data, now time.Time) error {
+	for _, course := range data.GetCourses() {
+		for _, term := range course.GetTerms() {
+			if term.GetStart() > now.Unix() {
+				continue
+			}
+			if term.Percent == nil {
+				// the term may have been saved before it lost its grade
+				err := txqry.DeleteTranscriptTerm(ctx, db.DeleteTranscriptTermParams{
+					StudentID:  studentId,
+					CourseGuid: course.GetGuid(),
+					Start:      term.GetStart(),
+					Stop:       term.GetEnd(),
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			start := time.Unix(term.GetStart(), 0).In(timezone.Location)
+			schoolYear := vcsnet.GetSchoolYear(start, timezone.Location)
+
+			err := txqry.SaveTranscriptTerm(ctx, db.SaveTranscriptTermParams{
+				StudentID:  studentId,
+				CourseGuid: course.GetGuid(),
+				CourseName: course.GetName(),
+				SchoolYear: int64(schoolYear.StartYear),
+				Start:      term.GetStart(),
+				Stop:       term.GetEnd(),
+				Percent:    float64(term.GetPercent()),
+				InProgress: term.GetInProgress(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeContainingTerms removes terms that contain another term of the same
+// course, powerschool can report both the year-long term and the semesters
+// of a course which would otherwise count the course twice.
+func removeContainingTerms(terms []db.TranscriptTerm) []db.TranscriptTerm {
+	var out []db.TranscriptTerm
+	for i, t := range terms {
+		containing := false
+		for j, other := range terms {
+			if i == j || t.CourseGuid != other.CourseGuid {
+				continue
+			}
+			sameRange := t.Start == other.Start && t.Stop == other.Stop
+			if !sameRange && t.Start <= other.Start && t.Stop >= other.Stop {
+				containing = true
+				break
+			}
+		}
+		if !containing {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func computeGPA(terms []*sisv1.TranscriptTerm) (weighted, unweighted *float32) {
+	var gpaTerms []gradecalc.GPATerm
+	for _, t := range terms {
+		if t.GetInProgress() {
+			continue
+		}
+		gpaTerms = append(gpaTerms, gradecalc.GPATerm{
+			Percent: float64(t.GetPercent()),
+			Bump:    float64(t.GetGpaBump()),
+		})
+	}
+	if len(gpaTerms) == 0 {
+		return nil, nil
+	}
+	w, u := gradecalc.GPA(gpaTerms)
+	return optionalFloat(w), optionalFloat(u)
+}
+
+func (s Service) transcriptTerm(row db.TranscriptTerm) *sisv1.TranscriptTerm {
+	return &sisv1.TranscriptTerm{
+		CourseGuid: row.CourseGuid,
+		CourseName: row.CourseName,
+		Start:      row.Start,
+		End:        row.Stop,
+		Percent:    float32(row.Percent),
+		InProgress: row.InProgress,
+		GpaBump:    float32(gradecalc.MatchBump(s.gpaBumps, row.CourseName)),
+	}
+}
+
+// currentGPA returns the weighted GPA over the whole saved transcript of a
+// student, it is nil if no term on the transcript has finished.
+func (s Service) currentGPA(ctx context.Context, qry *db.Queries, studentId string) (*float32, error) {
+	rows, err := qry.GetTranscriptTerms(ctx, studentId)
+	if err != nil {
+		return nil, fmt.Errorf("get transcript terms: %w", err)
+	}
+	var terms []*sisv1.TranscriptTerm
+	for _, row := range removeContainingTerms(rows) {
+		terms = append(terms, s.transcriptTerm(row))
+	}
+	weighted, _ := computeGPA(terms)
+	return weighted, nil
+}
+
+func (s Service) GetTranscript(ctx context.Context, req *connect.Request[sisv1.GetTranscriptRequest]) (*connect.Response[sisv1.GetTranscriptResponse], error) {
+	profile := verifier.ProfileFromContext(ctx)
+
+	rows, err := s.qry.GetTranscriptTerms(ctx, profile.Email)
+	if err != nil {
+		return nil, fmt.Errorf("get transcript terms: %w", err)
+	}
+
+	var years []*sisv1.TranscriptYear
+	var all []*sisv1.TranscriptTerm
+	for _, row := range removeContainingTerms(rows) {
+		if len(years) == 0 || years[len(years)-1].GetStartYear() != int32(row.SchoolYear) {
+			years = append(years, &sisv1.TranscriptYear{
+				StartYear: int32(row.SchoolYear),
+				EndYear:   int32(row.SchoolYear) + 1,
+			})
+		}
+		year := years[len(years)-1]
+
+		term := s.transcriptTerm(row)
+		year.Terms = append(year.Terms, term)
+		all = append(all, term)
+	}
+
+	for _, year := range years {
+		year.WeightedGpa, year.UnweightedGpa = computeGPA(year.GetTerms())
+	}
+	res := &sisv1.GetTranscriptResponse{Years: years}
+	res.WeightedGpa, res.UnweightedGpa = computeGPA(all)
+
+	return &connect.Response[sisv1.GetTranscriptResponse]{Msg: res}, nil
+}
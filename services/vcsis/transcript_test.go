@@ -0,0 +1,93 @@
+package vcsis
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"vcassist-backend/lib/timezone"
+	sisv1 "vcassist-backend/proto/vcassist/services/sis/v1"
+	"vcassist-backend/services/vcsis/db"
+
+	"github.com/stretchr/testify/require"
+)
+
+func percent(value float32) *float32 {
+	return &value
+}
+
+func TestTranscriptGPA(t *testing.T) {
+	sqlite, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	_, err = sqlite.Exec(db.Schema)
+	require.NoError(t, err)
+
+	service := Service{
+		db:       sqlite,
+		qry:      db.New(sqlite),
+		gpaBumps: GPABumps{"AP": 1},
+	}
+	ctx := context.Background()
+	const studentId = "student@example.com"
+
+	now := timezone.Now()
+	fall := &sisv1.TermGrade{
+		Start:   now.Add(-time.Hour * 24 * 120).Unix(),
+		End:     now.Add(-time.Hour * 24 * 10).Unix(),
+		Percent: percent(95),
+	}
+	spring := &sisv1.TermGrade{
+		Start:      now.Add(-time.Hour * 24 * 9).Unix(),
+		End:        now.Add(time.Hour * 24 * 100).Unix(),
+		Percent:    percent(72),
+		InProgress: true,
+	}
+	data := &sisv1.Data{
+		Profile: &sisv1.StudentProfile{CurrentGpa: 3.2},
+		Courses: []*sisv1.CourseData{
+			{
+				Guid:  "bio",
+				Name:  "AP Biology",
+				Terms: []*sisv1.TermGrade{fall, spring},
+			},
+			{
+				Guid: "art",
+				Name: "Art",
+				// a finished term without a final grade shouldn't count
+				// as an F
+				Terms: []*sisv1.TermGrade{
+					{Start: fall.GetStart(), End: fall.GetEnd()},
+				},
+			},
+		},
+	}
+
+	err = service.cacheNewData(ctx, studentId, data)
+	require.NoError(t, err)
+
+	rows, err := service.qry.GetTranscriptTerms(ctx, studentId)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		require.Equal(t, "bio", row.CourseGuid)
+	}
+	// only the finished term of AP Biology counts, with its bump
+	require.Equal(t, float32(5), data.GetProfile().GetCurrentGpa())
+
+	cached, err := service.getCachedData(ctx, studentId)
+	require.NoError(t, err)
+	require.Equal(t, float32(5), cached.GetProfile().GetCurrentGpa())
+
+	// a term that loses its grade is removed from the transcript
+	fall.Percent = nil
+	err = service.cacheNewData(ctx, studentId, data)
+	require.NoError(t, err)
+	rows, err = service.qry.GetTranscriptTerms(ctx, studentId)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.True(t, rows[0].InProgress)
+
+	gpa, err := service.currentGPA(ctx, service.qry, studentId)
+	require.NoError(t, err)
+	require.Nil(t, gpa)
+}